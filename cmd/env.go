@@ -0,0 +1,96 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// envPrefix starts the name of every environment variable that sets a flag.
+const envPrefix = "GOREMOVE_"
+
+// envFlags lists the flags that can also be set from the environment, for
+// containers and CI jobs where passing flags is awkward.
+var envFlags = []string{
+	"verbose",
+	"goroot",
+	"dir-from-go-env",
+	"go-version",
+	"log-level",
+	"log-sink",
+	"dry-run",
+	"safe",
+	"symbols",
+	"audit-log",
+	"cursor",
+	"list-layout",
+	"inline",
+	"no-tui",
+}
+
+// envAnnotation marks a flag applyEnvFlags set from the environment rather
+// than the command line.
+const envAnnotation = "goremove_from_env"
+
+// envName returns the environment variable that sets the flag name, such as
+// GOREMOVE_LOG_LEVEL for log-level.
+func envName(name string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+// applyEnvFlags sets each flag in envFlags that was not given on the command
+// line from its environment variable.
+//
+// A flag set this way counts as changed, so it overrides the config file just
+// as a command-line flag would, while a flag on the command line still wins.
+// Flags the command does not define are ignored.
+//
+// Parameters:
+//   - flags: Flag set of the command being run
+//
+// Returns:
+//   - An error naming the variable if its value is invalid for the flag
+func applyEnvFlags(flags *pflag.FlagSet) error {
+	for _, name := range envFlags {
+		flag := flags.Lookup(name)
+		if flag == nil {
+			continue
+		}
+
+		delete(flag.Annotations, envAnnotation)
+
+		if flag.Changed {
+			continue
+		}
+
+		value, ok := os.LookupEnv(envName(name))
+		if !ok {
+			continue
+		}
+
+		if err := flags.Set(name, value); err != nil {
+			return fmt.Errorf("invalid %s: %w", envName(name), err)
+		}
+
+		if err := flags.SetAnnotation(name, envAnnotation, []string{envName(name)}); err != nil {
+			return fmt.Errorf("failed to mark %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// setFromEnv reports whether applyEnvFlags set the flag name from the
+// environment, so it yields to flags given on the command line.
+func setFromEnv(flags *pflag.FlagSet, name string) bool {
+	flag := flags.Lookup(name)
+
+	return flag != nil && len(flag.Annotations[envAnnotation]) > 0
+}
@@ -0,0 +1,78 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"github.com/nicholas-fedor/go-remove/internal/logger"
+	"github.com/nicholas-fedor/go-remove/internal/userconfig"
+)
+
+// addLogFlags registers the logging flags shared by every removing command.
+func addLogFlags(flags *pflag.FlagSet) {
+	flags.BoolP("verbose", "v", false, "Enable verbose output")
+	flags.StringP("log-level", "l", "info", "Set log level (debug, info, warn, error)")
+	flags.StringP("log-sink", "", logger.SinkStderr, "Send logs to stderr, syslog, or both")
+}
+
+// addTargetFlags registers the flags that choose the binary directory.
+func addTargetFlags(flags *pflag.FlagSet) {
+	flags.BoolP("goroot", "", false, "Target GOROOT/bin instead of GOBIN or GOPATH/bin")
+	flags.BoolP("dir-from-go-env", "", false, "Read GOBIN, GOPATH, and GOROOT from go env instead of the environment")
+	flags.StringP("go-version", "", "", "Target the bin directory of this installed Go version (e.g. 1.22.3)")
+}
+
+// addRemovalFlags registers the flags that govern how binaries are removed,
+// read back with resolveDryRun, resolveExitCode, resolveSymbols, and
+// resolveAllow, along with the flag aliases.
+func addRemovalFlags(flags *pflag.FlagSet) {
+	flags.BoolP("dry-run", "n", false, "Show what would be removed without deleting anything")
+	flags.BoolP("apply", "", false, "Remove for real when safe_mode is enabled (alias: --no-dry-run)")
+	flags.BoolP("exit-code", "", false, "With a dry run, exit 1 if anything would be removed, 0 if not, and 2 if the check fails")
+	flags.StringP("symbols", "", "", "Mark results with unicode (✓/✗) or ascii (OK/FAIL) symbols")
+	flags.StringP("allow-pattern", "", "", "Only remove binaries whose names match this regular expression, on top of allow_pattern")
+	flags.BoolP("force", "", false, "Ignore the allow_pattern config setting")
+	flags.BoolP("safe", "", false, "Refuse to remove anything outside GOROOT, GOPATH, GOBIN, or ~/go")
+	flags.BoolP("fail-fast", "", false, "Stop a batch at the first failed removal")
+	flags.BoolP("assume-bin-dir-writable", "", false, "Skip checking that the binary directory is writable before each removal")
+	flags.SetNormalizeFunc(applyFlagAlias)
+}
+
+// addRecordFlags registers the flags that report removals outside the
+// terminal: to integrations, audit and removal logs, and the desktop.
+func addRecordFlags(flags *pflag.FlagSet) {
+	flags.StringP("events", "", "", "Stream JSON progress events to this Unix socket")
+	flags.StringP("audit-log", "", "", "Append a line per removal to this file, rotating it at 1 MiB")
+	flags.StringP("output-dir", "", "", "Write a JSON log file per removed binary into this directory")
+	flags.BoolP("notify", "", false, "Show a desktop notification when removal finishes")
+	flags.DurationP("throttle", "", 0, "Pause this long between removals in a batch, e.g. 500ms")
+}
+
+// addBatchFlags registers the flags that shape a batch over the binary
+// directory and what it reports.
+func addBatchFlags(flags *pflag.FlagSet) {
+	flags.BoolP("include-non-executable", "", false, "Include files without an execute permission bit (Unix)")
+	flags.BoolP("show-diff", "", false, "Print the binaries a batch removed from the directory as a diff")
+	flags.StringP("report", "", "", "Write a JSON report of removed binaries to this file")
+}
+
+// loadSettings reads the config file once per invocation, so every setting a
+// command resolves comes from the same read.
+//
+// Returns:
+//   - The config file's settings; the zero value if there is no config file
+//   - An error if the config file cannot be read or is invalid
+func loadSettings() (userconfig.Settings, error) {
+	settings, err := userconfig.LoadDefault()
+	if err != nil {
+		return userconfig.Settings{}, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
+	"github.com/nicholas-fedor/go-remove/internal/cli"
+	"github.com/nicholas-fedor/go-remove/internal/format"
+	"github.com/nicholas-fedor/go-remove/internal/logger"
+)
+
+// listCmd defines the list subcommand for printing installed binaries.
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List Go binaries in the target directory",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		goroot, _ := cmd.Flags().GetBool("goroot")
+		dirFromGoEnv, _ := cmd.Flags().GetBool("dir-from-go-env")
+		goVersion, _ := cmd.Flags().GetString("go-version")
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		summary, _ := cmd.Flags().GetBool("summary")
+		pretty, _ := cmd.Flags().GetBool("pretty")
+		allFiles, _ := cmd.Flags().GetBool("all-files")
+		showSkipped, _ := cmd.Flags().GetBool("show-skipped")
+		long, _ := cmd.Flags().GetBool("long")
+		timeFormat, _ := cmd.Flags().GetString("time-format")
+		includeNonExecutable, _ := cmd.Flags().GetBool("include-non-executable")
+		checkPath, _ := cmd.Flags().GetBool("check-path")
+		checkCorrupt, _ := cmd.Flags().GetBool("check-corrupt")
+		groupByModule, _ := cmd.Flags().GetBool("group-by-module")
+		null, _ := cmd.Flags().GetBool("null")
+		profile, _ := cmd.Flags().GetString("profile")
+
+		if groupByModule && long {
+			return ErrGroupWithLong
+		}
+
+		if null && (jsonOutput || long || summary || groupByModule || showSkipped || checkPath || checkCorrupt) {
+			return ErrNullWithFormat
+		}
+
+		if err := format.ValidateTimeFormat(timeFormat); err != nil {
+			return err
+		}
+
+		match, err := compileRegex(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		minSize, maxSize, err := parseSizeFlags(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		olderThan, newerThan, err := parseAgeFlags(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		stopProfile, err := startProfile(profile, cmd.ErrOrStderr())
+		if err != nil {
+			return err
+		}
+
+		defer finishProfile(cmd.ErrOrStderr(), stopProfile)
+
+		log, err := logger.NewLogger()
+		if err != nil {
+			return fmt.Errorf("failed to initialize logger: %w", err)
+		}
+
+		filesystem, err := newFilesystem(dirFromGoEnv, goVersion)
+		if err != nil {
+			return err
+		}
+
+		deps := cli.Dependencies{
+			FS:     filesystem,
+			Logger: log,
+		}
+
+		// Grouping reads each binary's module path from its build info.
+		if groupByModule {
+			extractor, err := buildinfo.NewExtractor()
+			if err != nil {
+				return fmt.Errorf("initializing build info extractor: %w", err)
+			}
+
+			deps.Extractor = extractor
+		}
+
+		config := cli.Config{
+			Goroot:               goroot,
+			DirFromGoEnv:         dirFromGoEnv,
+			GoVersion:            goVersion,
+			JSON:                 jsonOutput,
+			Summary:              summary,
+			Pretty:               pretty,
+			ShowHidden:           allFiles,
+			ShowSkipped:          showSkipped,
+			Long:                 long,
+			TimeFormat:           timeFormat,
+			IncludeNonExecutable: includeNonExecutable,
+			CheckPath:            checkPath,
+			CheckCorrupt:         checkCorrupt,
+			GroupByModule:        groupByModule,
+			Null:                 null,
+			Match:                match,
+			MinSize:              minSize,
+			MaxSize:              maxSize,
+			OlderThan:            olderThan,
+			NewerThan:            newerThan,
+		}
+
+		cli.CheckGoToolchain(log, config)
+
+		return cli.RunList(deps, config)
+	},
+}
+
+// init registers the list subcommand and its flags.
+func init() {
+	listCmd.Flags().BoolP("goroot", "", false, "List GOROOT/bin instead of GOBIN or GOPATH/bin")
+	listCmd.Flags().BoolP("dir-from-go-env", "", false, "Read GOBIN, GOPATH, and GOROOT from go env instead of the environment")
+	listCmd.Flags().StringP("go-version", "", "", "List the bin directory of this installed Go version (e.g. 1.22.3)")
+	listCmd.Flags().BoolP("json", "", false, "Output as JSON")
+	listCmd.Flags().BoolP("summary", "", false, "Append a count and total size summary")
+	listCmd.Flags().BoolP("pretty", "", false, "Indent JSON output (use with --json)")
+	listCmd.Flags().BoolP("all-files", "", false, "Include hidden (dot-prefixed) files")
+	listCmd.Flags().BoolP("show-skipped", "", false, "List excluded files and why they were skipped")
+	listCmd.Flags().BoolP("long", "l", false, "Show size and time since last modification")
+	listCmd.Flags().StringP("time-format", "", format.TimeRelative, "Show --long modification times as relative, rfc3339, unix, or a Go layout such as 2006-01-02")
+	listCmd.Flags().BoolP("include-non-executable", "", false, "Include files without an execute permission bit (Unix)")
+	listCmd.Flags().StringP("regex", "", "", "Only list binaries whose names match this regular expression")
+	listCmd.Flags().StringP("min-size", "", "", "Only list binaries at least this large, e.g. 50MB")
+	listCmd.Flags().StringP("max-size", "", "", "Only list binaries at most this large, e.g. 1MiB")
+	listCmd.Flags().StringP("older-than", "", "", "Only list binaries last modified at least this long ago, e.g. 30d")
+	listCmd.Flags().StringP("newer-than", "", "", "Only list binaries last modified at most this long ago, e.g. 1w")
+	listCmd.Flags().BoolP("check-path", "", false, "Report binaries shadowed by an earlier PATH entry")
+	listCmd.Flags().BoolP("check-corrupt", "", false, "Report zero-byte, headerless, or truncated binaries")
+	listCmd.Flags().BoolP("group-by-module", "", false, "Group binaries by the module they were built from")
+	listCmd.Flags().BoolP("null", "0", false, "End each name with a NUL byte instead of a newline, for xargs -0")
+	profileFlag(listCmd.Flags())
+
+	rootCmd.AddCommand(listCmd)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/nicholas-fedor/go-remove/internal/cli"
+)
+
+// Output formats accepted by --print-config.
+const (
+	configFormatJSON = "json"
+	configFormatYAML = "yaml"
+)
+
+// configIndent is the indentation width of --print-config output.
+const configIndent = 2
+
+// ErrInvalidConfigFormat indicates --print-config named an unknown output format.
+var ErrInvalidConfigFormat = errors.New("--print-config must be json or yaml")
+
+// ErrPrintConfigWithHistory indicates --print-config was combined with --undo or --restore.
+var ErrPrintConfigWithHistory = errors.New("cannot use --print-config with --undo or --restore")
+
+// effectiveConfig is what --print-config prints: the configuration a run
+// would use once flags, the config file, and defaults are resolved, together
+// with the binary directory it would target.
+type effectiveConfig struct {
+	BinDir string     `json:"binDir"`
+	Config cli.Config `json:"config"`
+}
+
+// validateConfigFormat rejects --print-config formats other than json and yaml.
+func validateConfigFormat(format string) error {
+	switch format {
+	case "", configFormatJSON, configFormatYAML:
+		return nil
+	}
+
+	return fmt.Errorf("%w: %q", ErrInvalidConfigFormat, format)
+}
+
+// printConfig writes effective to w as indented JSON or YAML.
+//
+// YAML is produced from the JSON encoding so both formats use the same field
+// names and duration formatting.
+//
+// Parameters:
+//   - w: Destination for the output
+//   - format: "json" or "yaml"
+//   - effective: Resolved configuration and binary directory
+//
+// Returns:
+//   - An error if the format is unknown or encoding fails
+func printConfig(w io.Writer, format string, effective effectiveConfig) error {
+	if err := validateConfigFormat(format); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(effective, "", strings.Repeat(" ", configIndent))
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	if format == configFormatJSON {
+		if _, err := fmt.Fprintf(w, "%s\n", data); err != nil {
+			return fmt.Errorf("failed to write config: %w", err)
+		}
+
+		return nil
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return fmt.Errorf("failed to convert config to YAML: %w", err)
+	}
+
+	// JSON parses as flow-style YAML; reset the styles to print block YAML.
+	clearYAMLStyle(&node)
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(configIndent)
+
+	if err := encoder.Encode(&node); err != nil {
+		return fmt.Errorf("failed to encode config as YAML: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to encode config as YAML: %w", err)
+	}
+
+	return nil
+}
+
+// clearYAMLStyle resets node and its descendants to the default block style
+// with plain scalars, quoting only where YAML requires it.
+func clearYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+
+	for _, child := range node.Content {
+		clearYAMLStyle(child)
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/pprof"
+
+	"github.com/spf13/pflag"
+)
+
+// Profile kinds accepted by the hidden --profile flag.
+const (
+	profileCPU = "cpu"
+	profileMem = "mem"
+)
+
+// ErrInvalidProfile indicates --profile named an unknown profile kind.
+var ErrInvalidProfile = errors.New("--profile must be cpu or mem")
+
+// profilePath returns the file a profile of kind is written to, in the
+// current directory.
+func profilePath(kind string) string {
+	return "go-remove-" + kind + ".pprof"
+}
+
+// startProfile begins a pprof profile of kind around a command's main work.
+//
+// CPU profiles sample from now until stop is called; heap profiles are a
+// snapshot taken when stop is called. Either way stop writes the profile to
+// profilePath(kind) and reports its location on w.
+//
+// Parameters:
+//   - kind: "cpu", "mem", or empty for no profiling
+//   - w: Destination for the message naming the profile file
+//
+// Returns:
+//   - A function that finishes the profile; it does nothing when kind is empty
+//   - An error if kind is unknown or the profile file cannot be created
+func startProfile(kind string, w io.Writer) (func() error, error) {
+	if kind == "" {
+		return func() error { return nil }, nil
+	}
+
+	if kind != profileCPU && kind != profileMem {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidProfile, kind)
+	}
+
+	path := profilePath(kind)
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create profile: %w", err)
+	}
+
+	if kind == profileCPU {
+		if err := pprof.StartCPUProfile(file); err != nil {
+			_ = file.Close()
+
+			return nil, fmt.Errorf("failed to start CPU profile: %w", err)
+		}
+	}
+
+	return func() error {
+		if kind == profileCPU {
+			pprof.StopCPUProfile()
+		} else {
+			// Collect garbage first so the snapshot shows live allocations.
+			runtime.GC()
+
+			if err := pprof.WriteHeapProfile(file); err != nil {
+				_ = file.Close()
+
+				return fmt.Errorf("failed to write heap profile: %w", err)
+			}
+		}
+
+		if err := file.Close(); err != nil {
+			return fmt.Errorf("failed to write profile: %w", err)
+		}
+
+		fmt.Fprintf(w, "Wrote %s profile to %s\n", kind, path)
+
+		return nil
+	}, nil
+}
+
+// finishProfile calls stop and reports a failure to write the profile on w
+// without failing the command it measured.
+func finishProfile(w io.Writer, stop func() error) {
+	if err := stop(); err != nil {
+		fmt.Fprintf(w, "Warning: %v\n", err)
+	}
+}
+
+// profileFlag registers the hidden --profile flag on a command's flag set.
+func profileFlag(flags *pflag.FlagSet) {
+	flags.StringP("profile", "", "", "Write a cpu or mem pprof profile of the command to the current directory")
+	_ = flags.MarkHidden("profile")
+}
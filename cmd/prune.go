@@ -0,0 +1,105 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/nicholas-fedor/go-remove/internal/cli"
+)
+
+// pruneCmd defines the prune subcommand, which removes binaries a manifest does not list.
+var pruneCmd = &cobra.Command{
+	Use:   "prune --manifest file",
+	Short: "Remove binaries that are not listed in a manifest",
+	Long: `Remove every binary in the target directory that a manifest does not list.
+
+The manifest lists one binary name or go install-style package path per line;
+blank lines and lines starting with "#" are ignored. The binaries kept and
+removed are shown before anything is deleted, and removal waits for
+confirmation unless --yes is given.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		manifest, _ := cmd.Flags().GetString("manifest")
+		yes, _ := cmd.Flags().GetBool("yes")
+		verbose, _ := cmd.Flags().GetBool("verbose")
+		goroot, _ := cmd.Flags().GetBool("goroot")
+		logLevel, _ := cmd.Flags().GetString("log-level")
+		logSink, _ := cmd.Flags().GetString("log-sink")
+		includeNonExecutable, _ := cmd.Flags().GetBool("include-non-executable")
+		showDiff, _ := cmd.Flags().GetBool("show-diff")
+		report, _ := cmd.Flags().GetString("report")
+		safe, _ := cmd.Flags().GetBool("safe")
+		failFast, _ := cmd.Flags().GetBool("fail-fast")
+		assumeWritable, _ := cmd.Flags().GetBool("assume-bin-dir-writable")
+		dirFromGoEnv, _ := cmd.Flags().GetBool("dir-from-go-env")
+		goVersion, _ := cmd.Flags().GetString("go-version")
+
+		if manifest == "" {
+			return ErrPruneWithoutManifest
+		}
+
+		settings, err := loadSettings()
+		if err != nil {
+			return err
+		}
+
+		dryRun, err := resolveDryRun(cmd.Flags(), settings)
+		if err != nil {
+			return err
+		}
+
+		exitCode, err := resolveExitCode(cmd.Flags(), dryRun)
+		if err != nil {
+			return err
+		}
+
+		symbols, err := resolveSymbols(cmd.Flags(), settings)
+		if err != nil {
+			return err
+		}
+
+		allow, err := resolveAllow(cmd.Flags(), settings)
+		if err != nil {
+			return err
+		}
+
+		config := cli.Config{
+			Manifest:             manifest,
+			Yes:                  yes,
+			Verbose:              verbose,
+			Goroot:               goroot,
+			LogLevel:             logLevel,
+			LogSink:              logSink,
+			DryRun:               dryRun,
+			ExitCode:             exitCode,
+			Report:               report,
+			IncludeNonExecutable: includeNonExecutable,
+			ShowDiff:             showDiff,
+			Safe:                 safe,
+			FailFast:             failFast,
+			AssumeWritable:       assumeWritable,
+			DirFromGoEnv:         dirFromGoEnv,
+			GoVersion:            goVersion,
+			Symbols:              symbols,
+			Allow:                allow,
+		}
+
+		return silenceChangesPending(cmd, runDirect(cmd.OutOrStdout(), config, nil))
+	},
+}
+
+// init registers the prune subcommand and its flags.
+func init() {
+	pruneCmd.Flags().StringP("manifest", "", "", "File listing the binaries to keep, one per line")
+	pruneCmd.Flags().BoolP("yes", "y", false, "Remove without asking for confirmation")
+	addLogFlags(pruneCmd.Flags())
+	addTargetFlags(pruneCmd.Flags())
+	addRemovalFlags(pruneCmd.Flags())
+	addBatchFlags(pruneCmd.Flags())
+
+	rootCmd.AddCommand(pruneCmd)
+}
@@ -10,20 +10,31 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"syscall"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
+	"github.com/nicholas-fedor/go-remove/internal/audit"
 	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
 	"github.com/nicholas-fedor/go-remove/internal/cli"
+	"github.com/nicholas-fedor/go-remove/internal/events"
 	"github.com/nicholas-fedor/go-remove/internal/fs"
 	"github.com/nicholas-fedor/go-remove/internal/history"
 	"github.com/nicholas-fedor/go-remove/internal/logger"
+	"github.com/nicholas-fedor/go-remove/internal/notify"
+	"github.com/nicholas-fedor/go-remove/internal/proc"
 	"github.com/nicholas-fedor/go-remove/internal/storage"
 	"github.com/nicholas-fedor/go-remove/internal/trash"
+	"github.com/nicholas-fedor/go-remove/internal/userconfig"
 )
 
 // Common errors for CLI operations.
@@ -52,6 +63,110 @@ var (
 	// ErrRestoreCollision indicates a file already exists at the restore location.
 	ErrRestoreCollisionCLI = errors.New("a file already exists at the restore location")
 
+	// ErrModuleWithBinary indicates the user specified both --module flag and a binary name.
+	ErrModuleWithBinary = errors.New("cannot specify binary name with --module flag")
+
+	// ErrPathWithoutBinary indicates the user specified --path without a file path argument.
+	ErrPathWithoutBinary = errors.New("--path requires a file path argument")
+
+	// ErrPathWithModule indicates the user specified both --path and --module flags.
+	ErrPathWithModule = errors.New("cannot use --path and --module flags together")
+
+	// ErrAllWithBinary indicates the user specified --all together with binary names or --module.
+	ErrAllWithBinary = errors.New("cannot specify binary names or --module with --all")
+
+	// ErrInteractiveWithoutTargets indicates --interactive was used without --all or binary names.
+	ErrInteractiveWithoutTargets = errors.New(
+		"--interactive requires --all, --select-from-file, --find-duplicates, or binary names",
+	)
+
+	// ErrDuplicatesWithTargets indicates --find-duplicates was combined with other ways of choosing binaries.
+	ErrDuplicatesWithTargets = errors.New(
+		"cannot specify binary names, --module, --all, or --select-from-file with --find-duplicates",
+	)
+
+	// ErrSelectWithTargets indicates --select-from-file was combined with other ways of choosing binaries.
+	ErrSelectWithTargets = errors.New(
+		"cannot specify binary names, --module, --all, --regex, or size or age filters with --select-from-file",
+	)
+
+	// ErrDescribeWithoutInteractive indicates --describe was used without --interactive.
+	ErrDescribeWithoutInteractive = errors.New("--describe requires --interactive")
+
+	// ErrBuiltWithWithoutAll indicates --built-with was used without --all.
+	ErrBuiltWithWithoutAll = errors.New("--built-with requires --all")
+
+	// ErrSinceInstallWithoutAll indicates --since-install was used without --all.
+	ErrSinceInstallWithoutAll = errors.New("--since-install requires --all")
+
+	// ErrKeepRunningWithoutAll indicates --keep-running was used without --all.
+	ErrKeepRunningWithoutAll = errors.New("--keep-running requires --all")
+
+	// ErrNegativeThrottle indicates --throttle was given a negative duration.
+	ErrNegativeThrottle = errors.New("--throttle must not be negative")
+
+	// ErrThrottleWithInteractive indicates --throttle was combined with --interactive,
+	// where the prompts already pace removals.
+	ErrThrottleWithInteractive = errors.New("cannot use --throttle and --interactive together")
+
+	// ErrInvalidColumnPadding indicates --column-padding was not a positive number.
+	ErrInvalidColumnPadding = errors.New("--column-padding must be at least 1")
+
+	// ErrRegexWithBinary indicates --regex was combined with explicit targets.
+	ErrRegexWithBinary = errors.New("cannot specify binary names or --module with --regex")
+
+	// ErrSizeWithBinary indicates a size filter was combined with explicit targets.
+	ErrSizeWithBinary = errors.New("cannot specify binary names or --module with --min-size or --max-size")
+
+	// ErrMinSizeAboveMax indicates --min-size exceeds --max-size, which no binary can satisfy.
+	ErrMinSizeAboveMax = errors.New("--min-size must not exceed --max-size")
+
+	// ErrAgeWithBinary indicates an age filter was combined with explicit targets.
+	ErrAgeWithBinary = errors.New("cannot specify binary names or --module with --older-than or --newer-than")
+
+	// ErrEmptyAgeWindow indicates --older-than is at least --newer-than, which no binary can satisfy.
+	ErrEmptyAgeWindow = errors.New("--older-than must be less than --newer-than")
+
+	// ErrGroupWithLong indicates list --group-by-module was combined with --long.
+	ErrGroupWithLong = errors.New("cannot use --group-by-module and --long together")
+
+	// ErrNullWithFormat indicates list --null was combined with output that is not one name per entry.
+	ErrNullWithFormat = errors.New(
+		"cannot use --null with --json, --long, --summary, --group-by-module, --show-skipped, --check-path, or --check-corrupt",
+	)
+
+	// ErrApplyWithDryRun indicates --apply and --dry-run were both given.
+	ErrApplyWithDryRun = errors.New("cannot use --apply and --dry-run together")
+
+	// ErrReinstallWithoutBinary indicates --reinstall-version was used without exactly one binary or --module.
+	ErrReinstallWithoutBinary = errors.New("--reinstall-version requires one binary name or --module")
+
+	// ErrPruneWithoutManifest indicates prune was run without --manifest.
+	ErrPruneWithoutManifest = errors.New("prune requires --manifest")
+
+	// ErrTrashWithoutClean indicates trash was run without --clean.
+	ErrTrashWithoutClean = errors.New("trash requires --clean")
+
+	// ErrNoTrashLimit indicates trash --clean was run with no age or size limit to enforce.
+	ErrNoTrashLimit = errors.New(
+		"trash --clean requires --max-age, --max-size, or the trash_max_age or trash_max_size config setting",
+	)
+
+	// ErrExitCodeWithoutDryRun indicates --exit-code was used on a run that deletes binaries.
+	ErrExitCodeWithoutDryRun = errors.New("--exit-code requires --dry-run or safe_mode")
+
+	// ErrNoTUI indicates --no-tui was given without anything to remove, so the TUI would have launched.
+	ErrNoTUI = errors.New("no binary specified and --no-tui is set; name a binary, or run go-remove list to see them")
+
+	// ErrEventsWithTUI indicates --events was given for a TUI session, which does not stream events.
+	ErrEventsWithTUI = errors.New("--events requires a binary name, --module, or --all; the TUI does not stream events")
+
+	// ErrNotifyWithTUI indicates --notify was given for a TUI session, which reports removals on screen.
+	ErrNotifyWithTUI = errors.New("--notify requires a binary name, --module, or --all; the TUI reports removals on screen")
+
+	// ErrPrintBinDirWithMode indicates --print-bindir was combined with something else to do.
+	ErrPrintBinDirWithMode = errors.New("cannot use --print-bindir with binary names, --print-config, --undo, or --restore")
+
 	// ErrNoWritableStorage indicates no writable directory was found for storage.
 	ErrNoWritableStorage = errors.New("no writable directory found for storage")
 )
@@ -232,12 +347,13 @@ func initHistoryManager(log logger.Logger) (history.Manager, error) {
 //
 // Parameters:
 //   - verbose: Whether to enable verbose output
+//   - logSink: Log destination (stderr, syslog, or both)
 //
 // Returns:
 //   - An error if the undo operation fails
-func runUndo(verbose bool) error {
+func runUndo(verbose bool, logSink string) error {
 	// Initialize logger
-	log, err := logger.NewLogger()
+	log, err := logger.NewLoggerWithSink(logSink)
 	if err != nil {
 		return fmt.Errorf("failed to initialize logger: %w", err)
 	}
@@ -298,15 +414,229 @@ func runUndo(verbose bool) error {
 
 // rootCmd defines the root command for go-remove.
 var rootCmd = &cobra.Command{
-	Use:   "go-remove [binary]",
+	Use:   "go-remove [binary...]",
 	Short: "A tool to remove Go binaries",
+	Args:  cobra.ArbitraryArgs, // Binary names are positional; subcommands are matched first
+	// Fill in flags left off the command line from GOREMOVE_ variables; this
+	// runs for subcommands too.
+	PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+		return applyEnvFlags(cmd.Flags())
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Extract flag values to configure CLI behavior; defaults to TUI mode if no binary is given.
 		verbose, _ := cmd.Flags().GetBool("verbose")
 		goroot, _ := cmd.Flags().GetBool("goroot")
 		logLevel, _ := cmd.Flags().GetString("log-level")
+		logSink, _ := cmd.Flags().GetString("log-sink")
 		undo, _ := cmd.Flags().GetBool("undo")
 		restore, _ := cmd.Flags().GetBool("restore")
+		noTUI, _ := cmd.Flags().GetBool("no-tui")
+		module, _ := cmd.Flags().GetString("module")
+		report, _ := cmd.Flags().GetString("report")
+		pathMode, _ := cmd.Flags().GetBool("path")
+		stats, _ := cmd.Flags().GetBool("stats")
+		allFiles, _ := cmd.Flags().GetBool("all-files")
+		includeBundles, _ := cmd.Flags().GetBool("include-bundles")
+		includeNonExecutable, _ := cmd.Flags().GetBool("include-non-executable")
+		all, _ := cmd.Flags().GetBool("all")
+		yes, _ := cmd.Flags().GetBool("yes")
+		keepRunning, _ := cmd.Flags().GetBool("keep-running")
+		findDuplicates, _ := cmd.Flags().GetBool("find-duplicates")
+		skipParent, _ := cmd.Flags().GetBool("skip-parent")
+		showDiff, _ := cmd.Flags().GetBool("show-diff")
+		checkCorrupt, _ := cmd.Flags().GetBool("check-corrupt")
+		builtWith, _ := cmd.Flags().GetString("built-with")
+		sinceInstall, _ := cmd.Flags().GetString("since-install")
+		selectFile, _ := cmd.Flags().GetString("select-from-file")
+		interactive, _ := cmd.Flags().GetBool("interactive")
+		describe, _ := cmd.Flags().GetBool("describe")
+		cursor, _ := cmd.Flags().GetString("cursor")
+		columnPadding, _ := cmd.Flags().GetInt("column-padding")
+		listLayout, _ := cmd.Flags().GetBool("list-layout")
+		gridOrder, _ := cmd.Flags().GetString("grid-order")
+		inline, _ := cmd.Flags().GetBool("inline")
+		pruneEmpty, _ := cmd.Flags().GetBool("prune-empty")
+		cleanDangling, _ := cmd.Flags().GetBool("clean-dangling")
+		assumeWritable, _ := cmd.Flags().GetBool("assume-bin-dir-writable")
+		eventSocket, _ := cmd.Flags().GetString("events")
+		auditLog, _ := cmd.Flags().GetString("audit-log")
+		outputDir, _ := cmd.Flags().GetString("output-dir")
+		notifyDone, _ := cmd.Flags().GetBool("notify")
+		safe, _ := cmd.Flags().GetBool("safe")
+		failFast, _ := cmd.Flags().GetBool("fail-fast")
+		dirFromGoEnv, _ := cmd.Flags().GetBool("dir-from-go-env")
+		goVersion, _ := cmd.Flags().GetString("go-version")
+		printFormat, _ := cmd.Flags().GetString("print-config")
+		printBinDir, _ := cmd.Flags().GetBool("print-bindir")
+		profile, _ := cmd.Flags().GetString("profile")
+		reinstallVersion, _ := cmd.Flags().GetString("reinstall-version")
+
+		if columnPadding < 1 {
+			return ErrInvalidColumnPadding
+		}
+
+		if gridOrder != cli.GridOrderColumn && gridOrder != cli.GridOrderRow {
+			return fmt.Errorf("%w: %q", cli.ErrUnknownGridOrder, gridOrder)
+		}
+
+		if err := validateConfigFormat(printFormat); err != nil {
+			return err
+		}
+
+		if printFormat != "" && (undo || restore) {
+			return ErrPrintConfigWithHistory
+		}
+
+		// Print only the directory, for command substitution in scripts.
+		if printBinDir {
+			if len(args) > 0 || printFormat != "" || undo || restore {
+				return ErrPrintBinDirWithMode
+			}
+
+			return runPrintBinDir(cmd.OutOrStdout(), dirFromGoEnv, goVersion, goroot)
+		}
+
+		stopProfile, err := startProfile(profile, cmd.ErrOrStderr())
+		if err != nil {
+			return err
+		}
+
+		defer finishProfile(cmd.ErrOrStderr(), stopProfile)
+
+		settings, err := loadSettings()
+		if err != nil {
+			return err
+		}
+
+		dryRun, err := resolveDryRun(cmd.Flags(), settings)
+		if err != nil {
+			return err
+		}
+
+		exitCode, err := resolveExitCode(cmd.Flags(), dryRun)
+		if err != nil {
+			return err
+		}
+
+		symbols, err := resolveSymbols(cmd.Flags(), settings)
+		if err != nil {
+			return err
+		}
+
+		stateFile, err := resolveStateFile(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		allow, err := resolveAllow(cmd.Flags(), settings)
+		if err != nil {
+			return err
+		}
+
+		match, err := compileRegex(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		if match != nil && (len(args) > 0 || module != "") {
+			return ErrRegexWithBinary
+		}
+
+		minSize, maxSize, err := parseSizeFlags(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		if (minSize > 0 || maxSize > 0) && (len(args) > 0 || module != "") {
+			return ErrSizeWithBinary
+		}
+
+		olderThan, newerThan, err := parseAgeFlags(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		if (olderThan > 0 || newerThan > 0) && (len(args) > 0 || module != "") {
+			return ErrAgeWithBinary
+		}
+
+		if module != "" && len(args) > 0 {
+			return ErrModuleWithBinary
+		}
+
+		if all && (len(args) > 0 || module != "") {
+			return ErrAllWithBinary
+		}
+
+		if selectFile != "" && (len(args) > 0 || module != "" || all || match != nil ||
+			minSize > 0 || maxSize > 0 || olderThan > 0 || newerThan > 0) {
+			return ErrSelectWithTargets
+		}
+
+		if findDuplicates && (len(args) > 0 || module != "" || all || selectFile != "") {
+			return ErrDuplicatesWithTargets
+		}
+
+		if interactive && !all && selectFile == "" && !findDuplicates && len(args) == 0 {
+			return ErrInteractiveWithoutTargets
+		}
+
+		if describe && !interactive {
+			return ErrDescribeWithoutInteractive
+		}
+
+		if keepRunning && !all {
+			return ErrKeepRunningWithoutAll
+		}
+
+		if builtWith != "" {
+			if err := cli.ValidateBuiltWith(builtWith); err != nil {
+				return err
+			}
+
+			if !all {
+				return ErrBuiltWithWithoutAll
+			}
+		}
+
+		if sinceInstall != "" {
+			if err := cli.ValidateSinceInstall(sinceInstall); err != nil {
+				return err
+			}
+
+			if !all {
+				return ErrSinceInstallWithoutAll
+			}
+		}
+
+		throttle, err := throttleFlag(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		if throttle > 0 && interactive {
+			return ErrThrottleWithInteractive
+		}
+
+		if reinstallVersion != "" {
+			if err := cli.ValidateVersion(reinstallVersion); err != nil {
+				return err
+			}
+
+			if all || selectFile != "" || (len(args) != 1 && module == "") {
+				return ErrReinstallWithoutBinary
+			}
+		}
+
+		if pathMode {
+			if module != "" {
+				return ErrPathWithModule
+			}
+
+			if len(args) == 0 {
+				return ErrPathWithoutBinary
+			}
+		}
 
 		// Handle undo flag - mutually exclusive with binary argument
 		if undo {
@@ -318,7 +648,7 @@ var rootCmd = &cobra.Command{
 				return ErrUndoWithRestore
 			}
 
-			return runUndo(verbose)
+			return runUndo(verbose, logSink)
 		}
 
 		// Handle restore flag - opens TUI in history mode
@@ -327,13 +657,28 @@ var rootCmd = &cobra.Command{
 				return ErrRestoreWithBinary
 			}
 
+			if noTUI {
+				return ErrNoTUI
+			}
+
+			if eventSocket != "" {
+				return ErrEventsWithTUI
+			}
+
+			if notifyDone {
+				return ErrNotifyWithTUI
+			}
+
 			// Initialize filesystem
-			filesystem := fs.NewRealFS()
+			filesystem, err := newFilesystem(dirFromGoEnv, goVersion)
+			if err != nil {
+				return err
+			}
 
-			// Determine the binary directory
-			binDir, err := filesystem.DetermineBinDir(goroot)
+			// Determine the binary directory, asking for one if necessary
+			binDir, err := determineTUIBinDir(filesystem, goroot)
 			if err != nil {
-				return fmt.Errorf("failed to determine binary directory: %w", err)
+				return err
 			}
 
 			// Initialize the logger with capture support for TUI mode
@@ -361,70 +706,131 @@ var rootCmd = &cobra.Command{
 
 			// Configure for restore mode (TUI will handle history view)
 			config := cli.Config{
-				Binary:      "",
-				Verbose:     verbose,
-				Goroot:      goroot,
-				Help:        false,
-				LogLevel:    logLevel,
-				RestoreMode: true,
+				Binary:               "",
+				Verbose:              verbose,
+				Goroot:               goroot,
+				Help:                 false,
+				LogLevel:             logLevel,
+				RestoreMode:          true,
+				DryRun:               dryRun,
+				Report:               report,
+				ShowHidden:           allFiles,
+				IncludeBundles:       includeBundles,
+				IncludeNonExecutable: includeNonExecutable,
+				Cursor:               cursor,
+				ColumnPadding:        columnPadding,
+				ListLayout:           listLayout,
+				GridOrder:            gridOrder,
+				Inline:               inline,
+				StateFile:            stateFile,
+				Allow:                allow,
+				AuditLog:             auditLog,
 			}
 
-			return cli.RunTUI(binDir, config, log, filesystem, cli.DefaultRunner{}, manager)
+			return runTUI(binDir, config, log, filesystem, manager)
 		}
 
 		config := cli.Config{
-			Binary:   "",
-			Verbose:  verbose,
-			Goroot:   goroot,
-			Help:     false, // Cobra manages help output automatically
-			LogLevel: logLevel,
+			Binary:               "",
+			Verbose:              verbose,
+			Goroot:               goroot,
+			Help:                 false, // Cobra manages help output automatically
+			LogLevel:             logLevel,
+			LogSink:              logSink,
+			DryRun:               dryRun,
+			ExitCode:             exitCode,
+			Report:               report,
+			Stats:                stats,
+			ShowHidden:           allFiles,
+			IncludeBundles:       includeBundles,
+			IncludeNonExecutable: includeNonExecutable,
+			All:                  all,
+			Yes:                  yes,
+			KeepRunning:          keepRunning,
+			FindDuplicates:       findDuplicates,
+			SkipParent:           skipParent,
+			ShowDiff:             showDiff,
+			CheckCorrupt:         checkCorrupt,
+			BuiltWith:            builtWith,
+			SinceInstall:         sinceInstall,
+			SelectFile:           selectFile,
+			Interactive:          interactive,
+			Describe:             describe,
+			Throttle:             throttle,
+			Cursor:               cursor,
+			ColumnPadding:        columnPadding,
+			ListLayout:           listLayout,
+			GridOrder:            gridOrder,
+			Inline:               inline,
+			StateFile:            stateFile,
+			Allow:                allow,
+			PruneEmpty:           pruneEmpty,
+			CleanDangling:        cleanDangling,
+			AssumeWritable:       assumeWritable,
+			EventSocket:          eventSocket,
+			AuditLog:             auditLog,
+			OutputDir:            outputDir,
+			Notify:               notifyDone,
+			Safe:                 safe,
+			FailFast:             failFast,
+			DirFromGoEnv:         dirFromGoEnv,
+			GoVersion:            goVersion,
+			ReinstallVersion:     reinstallVersion,
+			Symbols:              symbols,
+			Match:                match,
+			MinSize:              minSize,
+			MaxSize:              maxSize,
+			OlderThan:            olderThan,
+			NewerThan:            newerThan,
 		}
 
-		// If a binary name is provided as an argument, run in direct removal mode.
-		if len(args) > 0 {
-			config.Binary = args[0]
+		// Show the resolved configuration instead of running.
+		if printFormat != "" {
+			config.Module = module
+			config.PathMode = pathMode
 
-			// Initialize the standard logger for direct removal mode.
-			log, err := logger.NewLogger()
-			if err != nil {
-				return fmt.Errorf("failed to initialize logger: %w", err)
+			if len(args) > 0 {
+				config.Binary = args[0]
 			}
 
-			// Set log level based on config if verbose mode is enabled.
-			if verbose {
-				level := logger.ParseLevel(logLevel)
-				log.Level(level)
-			}
+			return runPrintConfig(cmd.OutOrStdout(), printFormat, config)
+		}
 
-			// Initialize history manager for recording deletions
-			manager, err := initHistoryManager(log)
-			if err != nil {
-				return fmt.Errorf("failed to initialize history manager: %w", err)
-			}
+		// If a binary name, module path, selection file, --all, or
+		// --find-duplicates is provided, run in direct removal mode.
+		if len(args) > 0 || module != "" || all || selectFile != "" || findDuplicates {
+			config.Module = module
+			config.PathMode = pathMode
 
-			defer func() {
-				if closeErr := manager.Close(); closeErr != nil {
-					log.Warn().Err(closeErr).Msg("Failed to close history manager")
-				}
-			}()
+			return silenceChangesPending(cmd, runDirect(cmd.OutOrStdout(), config, args))
+		}
 
-			// Assemble dependencies with a real filesystem, logger, and history manager.
-			deps := cli.Dependencies{
-				FS:             fs.NewRealFS(),
-				Logger:         log,
-				HistoryManager: manager,
-			}
+		// Scripts that pass --no-tui must name what to remove rather than
+		// wait on an interface nobody will answer.
+		if noTUI {
+			return ErrNoTUI
+		}
+
+		// The TUI reports removals on screen only, so a listener would wait
+		// for events that never come and no notification would be shown.
+		if eventSocket != "" {
+			return ErrEventsWithTUI
+		}
 
-			return cli.Run(deps, config)
+		if notifyDone {
+			return ErrNotifyWithTUI
 		}
 
 		// Otherwise, determine the binary directory and launch the TUI for interactive selection.
 		// For TUI mode, we use a logger with capture support to display logs within the interface.
-		filesystem := fs.NewRealFS()
+		filesystem, err := newFilesystem(dirFromGoEnv, goVersion)
+		if err != nil {
+			return err
+		}
 
-		binDir, err := filesystem.DetermineBinDir(config.Goroot)
+		binDir, err := determineTUIBinDir(filesystem, config.Goroot)
 		if err != nil {
-			return fmt.Errorf("failed to determine binary directory: %w", err)
+			return err
 		}
 
 		// Initialize the logger with capture support for TUI mode.
@@ -439,6 +845,8 @@ var rootCmd = &cobra.Command{
 			log.Level(level)
 		}
 
+		cli.CheckGoToolchain(log, config)
+
 		// Initialize history manager for TUI mode
 		manager, err := initHistoryManager(log)
 		if err != nil {
@@ -451,25 +859,692 @@ var rootCmd = &cobra.Command{
 			}
 		}()
 
-		return cli.RunTUI(binDir, config, log, filesystem, cli.DefaultRunner{}, manager)
+		return runTUI(binDir, config, log, filesystem, manager)
 	},
 }
 
+// runDirect removes the named binaries without the TUI, recording each deletion to history.
+//
+// Parameters:
+//   - out: Destination for prompts and progress, normally the command's output
+//   - config: CLI configuration; Binary is set from names for single removals
+//   - names: Binary names (or paths in path mode) to remove; empty when config.Module or config.All is set
+//
+// Returns:
+//   - An error if initialization or any removal fails
+func runDirect(out io.Writer, config cli.Config, names []string) error {
+	if len(names) > 0 {
+		config.Binary = names[0]
+	}
+
+	// Initialize the logger for direct removal mode on the requested sink.
+	log, err := logger.NewLoggerWithSink(config.LogSink)
+	if err != nil {
+		return fmt.Errorf("failed to initialize logger: %w", err)
+	}
+
+	// Set log level based on config if verbose mode is enabled.
+	if config.Verbose {
+		level := logger.ParseLevel(config.LogLevel)
+		log.Level(level)
+	}
+
+	cli.CheckGoToolchain(log, config)
+
+	// Initialize history manager for recording deletions
+	manager, err := initHistoryManager(log)
+	if err != nil {
+		return fmt.Errorf("failed to initialize history manager: %w", err)
+	}
+
+	defer func() {
+		if closeErr := manager.Close(); closeErr != nil {
+			log.Warn().Err(closeErr).Msg("Failed to close history manager")
+		}
+	}()
+
+	filesystem, err := newFilesystem(config.DirFromGoEnv, config.GoVersion)
+	if err != nil {
+		return err
+	}
+
+	if config.AssumeWritable {
+		filesystem = fs.AssumeWritable(filesystem)
+	}
+
+	// Assemble dependencies with a real filesystem, logger, and history manager.
+	deps := cli.Dependencies{
+		FS:             filesystem,
+		Logger:         log,
+		HistoryManager: manager,
+		Output:         out,
+	}
+
+	// Stream progress events to an integration listening on a Unix socket.
+	if config.EventSocket != "" {
+		emitter, err := events.Dial(config.EventSocket)
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			if closeErr := emitter.Close(); closeErr != nil {
+				log.Warn().Err(closeErr).Msg("Failed to close event socket")
+			}
+		}()
+
+		deps.Events = emitter
+	}
+
+	// Append a line per removal to the audit trail.
+	if config.AuditLog != "" {
+		auditLog, err := audit.Open(config.AuditLog, audit.DefaultMaxSize, audit.DefaultKeep)
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			if closeErr := auditLog.Close(); closeErr != nil {
+				log.Warn().Err(closeErr).Msg("Failed to close audit log")
+			}
+		}()
+
+		deps.Audit = auditLog
+	}
+
+	// Check the removal log directory before removing anything.
+	if config.OutputDir != "" {
+		if err := cli.PrepareOutputDir(config.OutputDir); err != nil {
+			return err
+		}
+	}
+
+	if config.Notify {
+		deps.Notifier = notify.NewNotifier()
+	}
+
+	if config.KeepRunning || config.SkipParent {
+		deps.Processes = proc.NewFinder()
+	}
+
+	// Module lookups, reinstalls, and --built-with need a build info extractor
+	// to read each binary's module path or Go version; removal logs and
+	// --since-install use it when the platform supports extraction.
+	needsBuildInfo := config.Module != "" || config.ReinstallVersion != "" || config.BuiltWith != ""
+	if needsBuildInfo || config.OutputDir != "" || config.SinceInstall != "" {
+		extractor, err := buildinfo.NewExtractor()
+
+		switch {
+		case err == nil:
+			deps.Extractor = extractor
+		case needsBuildInfo:
+			return fmt.Errorf("initializing build info extractor: %w", err)
+		}
+	}
+
+	// Let Ctrl+C stop a throttled or reported batch between removals instead
+	// of killing the process mid-removal, so the report covers what finished.
+	ctx := context.Background()
+
+	if config.Throttle > 0 || config.Report != "" {
+		var stop context.CancelFunc
+
+		ctx, stop = signal.NotifyContext(ctx, os.Interrupt)
+		defer stop()
+	}
+
+	if config.All {
+		return cli.RunAll(ctx, deps, config)
+	}
+
+	if config.FindDuplicates {
+		return cli.RunDuplicates(ctx, deps, config)
+	}
+
+	if config.SelectFile != "" {
+		return cli.RunSelection(ctx, deps, config)
+	}
+
+	if config.Manifest != "" {
+		return cli.RunPrune(ctx, deps, config)
+	}
+
+	// Several names, interactive confirmation, timing stats, or a completion
+	// notification run as a batch.
+	if len(names) > 1 || ((config.Stats || config.Interactive || config.Notify) && len(names) > 0) {
+		return cli.RunBatch(ctx, deps, config, names)
+	}
+
+	return cli.Run(deps, config)
+}
+
+// runPrintConfig prints config and the binary directory it resolves to.
+//
+// Parameters:
+//   - w: Destination for the output
+//   - format: "json" or "yaml"
+//   - config: Configuration resolved from flags and the config file
+//
+// Returns:
+//   - An error if the binary directory cannot be determined or printing fails
+func runPrintConfig(w io.Writer, format string, config cli.Config) error {
+	filesystem, err := newFilesystem(config.DirFromGoEnv, config.GoVersion)
+	if err != nil {
+		return err
+	}
+
+	binDir, err := filesystem.DetermineBinDir(config.Goroot)
+	if err != nil {
+		return fmt.Errorf("failed to determine binary directory: %w", err)
+	}
+
+	return printConfig(w, format, effectiveConfig{BinDir: binDir, Config: config})
+}
+
+// runPrintBinDir prints the binary directory commands would target, and
+// nothing else, so scripts can use it in command substitution.
+func runPrintBinDir(w io.Writer, fromGoEnv bool, goVersion string, goroot bool) error {
+	filesystem, err := newFilesystem(fromGoEnv, goVersion)
+	if err != nil {
+		return err
+	}
+
+	binDir, err := filesystem.DetermineBinDir(goroot)
+	if err != nil {
+		return fmt.Errorf("failed to determine binary directory: %w", err)
+	}
+
+	if _, err := fmt.Fprintln(w, binDir); err != nil {
+		return fmt.Errorf("failed to write binary directory: %w", err)
+	}
+
+	return nil
+}
+
+// newFilesystem returns the filesystem used by commands, reading the binary
+// directory settings from `go env` when fromGoEnv is set, or from `go env` of
+// the goVersion toolchain when one is given.
+func newFilesystem(fromGoEnv bool, goVersion string) (fs.FS, error) {
+	switch {
+	case goVersion != "":
+		filesystem, err := fs.NewRealFSForGoVersion(goVersion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve --go-version: %w", err)
+		}
+
+		return filesystem, nil
+	case fromGoEnv:
+		return fs.NewRealFSFromGoEnv(), nil
+	}
+
+	return fs.NewRealFS(), nil
+}
+
+// determineTUIBinDir determines the binary directory for the TUI. When the
+// environment does not identify one, the user is prompted to choose it instead.
+//
+// Parameters:
+//   - filesystem: Filesystem used to determine the directory
+//   - goroot: Whether GOROOT/bin was requested
+//
+// Returns:
+//   - The binary directory
+//   - An error if it cannot be determined and none was chosen
+func determineTUIBinDir(filesystem fs.FS, goroot bool) (string, error) {
+	binDir, err := filesystem.DetermineBinDir(goroot)
+	if err == nil {
+		return binDir, nil
+	}
+
+	binDir, err = cli.PickBinDir(err, cli.DefaultRunner{})
+	if err != nil {
+		return "", fmt.Errorf("failed to determine binary directory: %w", err)
+	}
+
+	return binDir, nil
+}
+
+// runTUI launches the interactive TUI and writes the session report if one was requested.
+//
+// Parameters:
+//   - binDir: Directory containing the binaries to display
+//   - config: CLI configuration for the session
+//   - log: Logger with capture support for the TUI log panel
+//   - filesystem: Filesystem operations
+//   - manager: History manager for recording deletions
+//
+// Returns:
+//   - An error if the audit log cannot be opened, or the TUI or the report fails
+func runTUI(
+	binDir string,
+	config cli.Config,
+	log logger.Logger,
+	filesystem fs.FS,
+	manager history.Manager,
+) error {
+	// Append a line per removal made in the TUI to the audit trail.
+	var recorder audit.Recorder
+
+	if config.AuditLog != "" {
+		auditLog, err := audit.Open(config.AuditLog, audit.DefaultMaxSize, audit.DefaultKeep)
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			if closeErr := auditLog.Close(); closeErr != nil {
+				log.Warn().Err(closeErr).Msg("Failed to close audit log")
+			}
+		}()
+
+		recorder = auditLog
+	}
+
+	removals, err := cli.RunTUI(binDir, config, log, filesystem, cli.DefaultRunner{}, manager, recorder)
+	if err != nil {
+		return fmt.Errorf("failed to run TUI: %w", err)
+	}
+
+	if len(removals) > 0 {
+		fmt.Fprintln(os.Stdout, cli.FormatFreed(removals, config.DryRun))
+	}
+
+	if config.Report == "" {
+		return nil
+	}
+
+	if err := cli.WriteReport(config.Report, cli.Report{DryRun: config.DryRun, Removals: removals}); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	return nil
+}
+
 // init registers flags for the root command.
 func init() {
-	rootCmd.Flags().BoolP("verbose", "v", false, "Enable verbose output")
-	rootCmd.Flags().BoolP("goroot", "", false, "Target GOROOT/bin instead of GOBIN or GOPATH/bin")
-	rootCmd.Flags().StringP("log-level", "l", "info", "Set log level (debug, info, warn, error)")
+	addLogFlags(rootCmd.Flags())
+	addTargetFlags(rootCmd.Flags())
+	addRemovalFlags(rootCmd.Flags())
+	addRecordFlags(rootCmd.Flags())
+	addBatchFlags(rootCmd.Flags())
 	rootCmd.Flags().BoolP("undo", "u", false, "Undo the most recent deletion")
 	rootCmd.Flags().BoolP("restore", "r", false, "Open history view for restoration")
+	rootCmd.Flags().StringP("module", "m", "", "Remove the binary built from this module or package path (alias: --by-module)")
+	rootCmd.Flags().StringP("regex", "", "", "Only show binaries whose names match this regular expression (TUI and --all)")
+	rootCmd.Flags().StringP("min-size", "", "", "Only show binaries at least this large, e.g. 50MB (TUI and --all)")
+	rootCmd.Flags().StringP("max-size", "", "", "Only show binaries at most this large, e.g. 1MiB (TUI and --all)")
+	rootCmd.Flags().StringP("older-than", "", "", "Only show binaries last modified at least this long ago, e.g. 30d (TUI and --all)")
+	rootCmd.Flags().StringP("newer-than", "", "", "Only show binaries last modified at most this long ago, e.g. 1w (TUI and --all)")
+	rootCmd.Flags().BoolP("path", "", false, "Treat the argument as a file path instead of a binary name")
+	rootCmd.Flags().BoolP("prune-empty", "", false, "Remove a binary's directory once empty (never GOBIN, GOPATH, or GOROOT)")
+	rootCmd.Flags().BoolP("clean-dangling", "", false, "Remove symlinks left pointing at a removed binary")
+	rootCmd.Flags().StringP("reinstall-version", "", "", "After removing the binary, go install its package at this version (e.g. v1.2.3)")
+	rootCmd.Flags().BoolP("check-corrupt", "", false, "Mark zero-byte, headerless, or truncated binaries in the TUI")
+	rootCmd.Flags().BoolP("stats", "", false, "Print aggregate removal timing after a batch")
+	rootCmd.Flags().BoolP("all-files", "", false, "Show hidden (dot-prefixed) files in the TUI")
+	rootCmd.Flags().BoolP("all", "a", false, "Remove every binary in the target directory")
+	rootCmd.Flags().BoolP("yes", "y", false, "Remove without asking for confirmation (with --all)")
+	rootCmd.Flags().BoolP("keep-running", "", false, "Skip binaries that are currently running (with --all)")
+	rootCmd.Flags().BoolP("find-duplicates", "", false, "Report binaries with identical contents; remove copies with --interactive")
+	rootCmd.Flags().StringP("built-with", "", "", "Only remove binaries built with this Go version, e.g. go1.21 or \"<go1.22\" (with --all)")
+	rootCmd.Flags().StringP("since-install", "", "", "Only remove binaries built on or after this date, or before it with \"<\", e.g. 2024-01-31 (with --all)")
+	rootCmd.Flags().BoolP("skip-parent", "", false, "Skip a binary that is running go-remove, e.g. from a wrapper")
+	rootCmd.Flags().StringP("select-from-file", "", "", "Remove the binaries listed in this file, one name per line, after showing the plan")
+	rootCmd.Flags().BoolP("interactive", "i", false, "Prompt before each removal (y/n/a/q) (alias: --confirm-each)")
+	rootCmd.Flags().BoolP("describe", "", false, "Show each binary's executable format and architecture before prompting (with --interactive)")
+	rootCmd.Flags().BoolP("include-bundles", "", false, "Include macOS .app bundle directories (asks before removing)")
+	rootCmd.Flags().StringP("cursor", "", "", "Symbol used for the TUI cursor (default \"❯ \")")
+	rootCmd.Flags().IntP("column-padding", "", defaultColumnPadding, "Spaces between TUI grid columns")
+	rootCmd.Flags().BoolP("list-layout", "", false, "Show TUI binaries one per line instead of in a grid")
+	rootCmd.Flags().StringP("grid-order", "", cli.GridOrderColumn, "Fill the TUI grid down each column or across each row (column, row)")
+	rootCmd.Flags().BoolP("inline", "", false, "Render the TUI inline, keeping it in the scrollback after quitting")
+	rootCmd.Flags().BoolP("no-tui", "", false, "Fail instead of launching the TUI when no binary is specified")
+	rootCmd.Flags().BoolP("remember-state", "", false, "Reopen the TUI with the sort order and panels it was left with")
+	rootCmd.Flags().StringP("print-config", "", "", "Print the effective configuration as json or yaml and exit")
+	rootCmd.Flags().Lookup("print-config").NoOptDefVal = configFormatJSON
+	rootCmd.Flags().BoolP("print-bindir", "", false, "Print the target binary directory and exit")
+	profileFlag(rootCmd.Flags())
+}
+
+// defaultColumnPadding matches the TUI's built-in grid column padding.
+const defaultColumnPadding = 1
+
+// resolveDryRun decides whether a run only reports removals.
+//
+// An explicit --dry-run or --apply wins, and --apply also overrides a dry run
+// requested through GOREMOVE_DRY_RUN. Otherwise the config file's safe_mode
+// setting supplies the default, so safe-mode users must pass --apply to delete.
+//
+// Parameters:
+//   - flags: Flag set defining dry-run and apply
+//   - settings: Config file settings, from loadSettings
+//
+// Returns:
+//   - true if removals should only be reported
+//   - An error if both flags are given on the command line
+func resolveDryRun(flags *pflag.FlagSet, settings userconfig.Settings) (bool, error) {
+	dryRun, _ := flags.GetBool("dry-run")
+	apply, _ := flags.GetBool("apply")
+
+	switch {
+	case dryRun && apply && setFromEnv(flags, "dry-run"):
+		// --apply on the command line overrides GOREMOVE_DRY_RUN.
+		return false, nil
+	case dryRun && apply:
+		return false, ErrApplyWithDryRun
+	case dryRun || apply:
+		return dryRun, nil
+	}
+
+	return settings.SafeMode, nil
+}
+
+// resolveExitCode reads --exit-code, which reports whether a dry run would
+// remove anything and so needs the run to be a dry run, from --dry-run or
+// safe_mode.
+//
+// Parameters:
+//   - flags: Flag set defining exit-code
+//   - dryRun: Whether the run only reports removals, from resolveDryRun
+//
+// Returns:
+//   - true if a dry run that would remove binaries should exit non-zero
+//   - An error if --exit-code is set outside a dry run
+func resolveExitCode(flags *pflag.FlagSet, dryRun bool) (bool, error) {
+	exitCode, _ := flags.GetBool("exit-code")
+	if exitCode && !dryRun {
+		return false, ErrExitCodeWithoutDryRun
+	}
+
+	return exitCode, nil
+}
+
+// silenceChangesPending keeps Cobra from printing cli.ErrChangesPending and
+// the usage text with it, since --exit-code drift is a result, not a failure.
+func silenceChangesPending(cmd *cobra.Command, err error) error {
+	if errors.Is(err, cli.ErrChangesPending) {
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+	}
+
+	return err
+}
+
+// resolveStateFile returns the file the TUI remembers its view in when
+// --remember-state is set, or "" to leave the view unremembered.
+//
+// Parameters:
+//   - flags: Flag set defining remember-state
+//
+// Returns:
+//   - The state file path, or "" without --remember-state
+//   - An error if the user config directory cannot be located
+func resolveStateFile(flags *pflag.FlagSet) (string, error) {
+	if remember, _ := flags.GetBool("remember-state"); !remember {
+		return "", nil
+	}
+
+	path, err := userconfig.StatePath()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate TUI state file: %w", err)
+	}
+
+	return path, nil
+}
+
+// resolveAllow collects the patterns a binary's name must match to be removed.
+//
+// The config file's allow_pattern always applies unless --force is given, and
+// --allow-pattern adds a pattern on top of it, so the command line can narrow
+// the allowlist but only widen it with --force.
+//
+// Parameters:
+//   - flags: Flag set defining allow-pattern and force
+//   - settings: Config file settings, from loadSettings
+//
+// Returns:
+//   - The patterns to enforce; nil allows every name
+//   - An error if --allow-pattern is invalid
+func resolveAllow(flags *pflag.FlagSet, settings userconfig.Settings) ([]*regexp.Regexp, error) {
+	var allow []*regexp.Regexp
+
+	if force, _ := flags.GetBool("force"); !force && settings.AllowPattern != "" {
+		allow = append(allow, regexp.MustCompile(settings.AllowPattern)) // Validated by Load
+	}
+
+	if pattern, _ := flags.GetString("allow-pattern"); pattern != "" {
+		match, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --allow-pattern: %w", err)
+		}
+
+		allow = append(allow, match)
+	}
+
+	return allow, nil
+}
+
+// resolveSymbols picks the glyphs that mark removal results.
+//
+// An explicit --symbols wins; otherwise the config file's symbols setting
+// applies. With neither, results are printed without symbols.
+//
+// Parameters:
+//   - flags: Flag set defining symbols
+//   - settings: Config file settings, from loadSettings
+//
+// Returns:
+//   - The chosen symbol set, or the zero set for unmarked output
+//   - An error if the name is unknown
+func resolveSymbols(flags *pflag.FlagSet, settings userconfig.Settings) (cli.SymbolSet, error) {
+	name, _ := flags.GetString("symbols")
+
+	if !flags.Changed("symbols") {
+		name = settings.Symbols
+	}
+
+	if name == "" {
+		return cli.SymbolSet{}, nil
+	}
+
+	symbols, err := cli.ParseSymbols(name)
+	if err != nil {
+		return cli.SymbolSet{}, fmt.Errorf("failed to resolve symbols: %w", err)
+	}
+
+	return symbols, nil
+}
+
+// compileRegex compiles the --regex pattern once for all listings.
+//
+// Parameters:
+//   - flags: Flag set defining regex
+//
+// Returns:
+//   - The compiled expression, or nil if no pattern was given
+//   - An error if the pattern is not a valid regular expression
+func compileRegex(flags *pflag.FlagSet) (*regexp.Regexp, error) {
+	pattern, _ := flags.GetString("regex")
+	if pattern == "" {
+		return nil, nil //nolint:nilnil // No pattern means no filtering
+	}
+
+	match, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --regex: %w", err)
+	}
+
+	return match, nil
+}
+
+// parseSizeFlags parses the --min-size and --max-size bounds.
+//
+// Parameters:
+//   - flags: Flag set defining min-size and max-size
+//
+// Returns:
+//   - The minimum and maximum sizes in bytes; 0 when a bound is not given
+//   - An error if a size cannot be parsed or the minimum exceeds the maximum
+func parseSizeFlags(flags *pflag.FlagSet) (int64, int64, error) {
+	minSize, err := parseSizeFlag(flags, "min-size")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	maxSize, err := parseSizeFlag(flags, "max-size")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if maxSize > 0 && minSize > maxSize {
+		return 0, 0, ErrMinSizeAboveMax
+	}
+
+	return minSize, maxSize, nil
+}
+
+// parseAgeFlags parses the --older-than and --newer-than bounds.
+//
+// Parameters:
+//   - flags: Flag set defining older-than and newer-than
+//
+// Returns:
+//   - The --older-than age, or 0 when unset
+//   - The --newer-than age, or 0 when unset
+//   - An error if an age cannot be parsed or the window they form is empty
+func parseAgeFlags(flags *pflag.FlagSet) (time.Duration, time.Duration, error) {
+	olderThan, err := parseAgeFlag(flags, "older-than")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	newerThan, err := parseAgeFlag(flags, "newer-than")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if newerThan > 0 && olderThan >= newerThan {
+		return 0, 0, ErrEmptyAgeWindow
+	}
+
+	return olderThan, newerThan, nil
+}
+
+// parseAgeFlag parses the age flag name, returning 0 when it is not set.
+func parseAgeFlag(flags *pflag.FlagSet, name string) (time.Duration, error) {
+	value, _ := flags.GetString(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	age, err := cli.ParseAge(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --%s: %w", name, err)
+	}
+
+	return age, nil
+}
+
+// throttleFlag returns the --throttle duration, rejecting negative values.
+func throttleFlag(flags *pflag.FlagSet) (time.Duration, error) {
+	throttle, _ := flags.GetDuration("throttle")
+	if throttle < 0 {
+		return 0, ErrNegativeThrottle
+	}
+
+	return throttle, nil
 }
 
+// parseSizeFlag parses the size flag name, returning 0 when it is not set.
+func parseSizeFlag(flags *pflag.FlagSet, name string) (int64, error) {
+	value, _ := flags.GetString(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	size, err := cli.ParseSize(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --%s: %w", name, err)
+	}
+
+	return size, nil
+}
+
+// flagAliases maps alternative flag spellings to the flags they stand for.
+var flagAliases = map[string]string{
+	"no-dry-run":   "apply",
+	"by-module":    "module",
+	"confirm-each": "interactive",
+}
+
+// applyFlagAlias lets each alias in flagAliases be spelled in place of its
+// flag, on flag sets that define that flag.
+func applyFlagAlias(flags *pflag.FlagSet, name string) pflag.NormalizedName {
+	if target, ok := flagAliases[name]; ok && flags.Lookup(target) != nil {
+		name = target
+	}
+
+	return pflag.NormalizedName(name)
+}
+
+// Process exit codes.
+const (
+	exitFailure     = 1 // A command failed
+	exitChanges     = 1 // A dry run with --exit-code found binaries to remove, as git diff --exit-code does
+	exitCheckFailed = 2 // A dry run with --exit-code failed, so drift could not be determined, as diff does
+)
+
 // Execute runs the root command and handles any execution errors.
 func Execute() {
+	// Make writes to a closed or broken stderr fail with an error instead of
+	// terminating the process with SIGPIPE, so the intended exit code survives.
+	signal.Ignore(syscall.SIGPIPE)
+
+	if code := execute(os.Stderr, os.Stdout); code != 0 {
+		os.Exit(code)
+	}
+}
+
+// execute runs the root command and reports any error, returning the exit code.
+//
+// Parameters:
+//   - stderr: Preferred destination for the error message
+//   - stdout: Fallback destination used when stderr cannot be written
+//
+// Returns:
+//   - 0 on success, exitChanges if a dry run with --exit-code would remove
+//     binaries, exitCheckFailed if such a dry run failed, or exitFailure if
+//     the command failed
+func execute(stderr, stdout io.Writer) int {
 	// Execute the command, capturing any errors for reporting and exit handling.
-	if err := rootCmd.Execute(); err != nil {
-		// Report errors to stderr and exit with a non-zero status to signal failure.
-		os.Stderr.WriteString("Error: " + err.Error() + "\n")
-		os.Exit(1)
+	if cmd, err := rootCmd.ExecuteC(); err != nil {
+		// Drift is already described by the dry run's own output.
+		if errors.Is(err, cli.ErrChangesPending) {
+			return exitChanges
+		}
+
+		// Report errors and exit with a non-zero status to signal failure.
+		reportError(stderr, stdout, err)
+
+		// A drift check that failed, such as for a binary that is not
+		// installed, must not read as drift.
+		if exitCode, _ := cmd.Flags().GetBool("exit-code"); exitCode {
+			return exitCheckFailed
+		}
+
+		return exitFailure
+	}
+
+	return 0
+}
+
+// reportError writes err to stderr, falling back to stdout if stderr is not
+// writable. If neither can be written the message is dropped; the exit code
+// still signals the failure.
+func reportError(stderr, stdout io.Writer, err error) {
+	msg := "Error: " + err.Error() + "\n"
+
+	if _, writeErr := io.WriteString(stderr, msg); writeErr != nil {
+		_, _ = io.WriteString(stdout, msg)
 	}
 }
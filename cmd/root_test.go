@@ -7,9 +7,23 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicholas-fedor/go-remove/internal/cli"
+	"github.com/nicholas-fedor/go-remove/internal/format"
+	"github.com/nicholas-fedor/go-remove/internal/history"
+	historymocks "github.com/nicholas-fedor/go-remove/internal/history/mocks"
+	"github.com/nicholas-fedor/go-remove/internal/userconfig"
 )
 
 // TestRootCommand verifies the behavior of the root command.
@@ -23,7 +37,7 @@ func TestRootCommand(t *testing.T) {
 		{
 			name:       "help flag",
 			args:       []string{"-h"},
-			wantStderr: "A tool to remove Go binaries\n\nUsage:\n  go-remove [binary] [flags]\n\nFlags:\n      --goroot             Target GOROOT/bin instead of GOBIN or GOPATH/bin\n  -h, --help               help for go-remove\n  -l, --log-level string   Set log level (debug, info, warn, error) (default \"info\")\n  -r, --restore            Open history view for restoration\n  -u, --undo               Undo the most recent deletion\n  -v, --verbose            Enable verbose output\n",
+			wantStderr: "A tool to remove Go binaries\n\nUsage:\n  go-remove [binary...] [flags]\n  go-remove [command]\n\nAvailable Commands:\n  completion  Generate the autocompletion script for the specified shell\n  help        Help about any command\n  list        List Go binaries in the target directory\n  prune       Remove binaries that are not listed in a manifest\n  trash       Permanently delete old binaries from the trash\n  uninstall   Remove binaries using go install-style package paths\n\nFlags:\n  -a, --all                            Remove every binary in the target directory\n      --all-files                      Show hidden (dot-prefixed) files in the TUI\n      --allow-pattern string           Only remove binaries whose names match this regular expression, on top of allow_pattern\n      --apply                          Remove for real when safe_mode is enabled (alias: --no-dry-run)\n      --assume-bin-dir-writable        Skip checking that the binary directory is writable before each removal\n      --audit-log string               Append a line per removal to this file, rotating it at 1 MiB\n      --built-with string              Only remove binaries built with this Go version, e.g. go1.21 or \"<go1.22\" (with --all)\n      --check-corrupt                  Mark zero-byte, headerless, or truncated binaries in the TUI\n      --clean-dangling                 Remove symlinks left pointing at a removed binary\n      --column-padding int             Spaces between TUI grid columns (default 1)\n      --cursor string                  Symbol used for the TUI cursor (default \"❯ \")\n      --describe                       Show each binary's executable format and architecture before prompting (with --interactive)\n      --dir-from-go-env                Read GOBIN, GOPATH, and GOROOT from go env instead of the environment\n  -n, --dry-run                        Show what would be removed without deleting anything\n      --events string                  Stream JSON progress events to this Unix socket\n      --exit-code                      With a dry run, exit 1 if anything would be removed, 0 if not, and 2 if the check fails\n      --fail-fast                      Stop a batch at the first failed removal\n      --find-duplicates                Report binaries with identical contents; remove copies with --interactive\n      --force                          Ignore the allow_pattern config setting\n      --go-version string              Target the bin directory of this installed Go version (e.g. 1.22.3)\n      --goroot                         Target GOROOT/bin instead of GOBIN or GOPATH/bin\n      --grid-order string              Fill the TUI grid down each column or across each row (column, row) (default \"column\")\n  -h, --help                           help for go-remove\n      --include-bundles                Include macOS .app bundle directories (asks before removing)\n      --include-non-executable         Include files without an execute permission bit (Unix)\n      --inline                         Render the TUI inline, keeping it in the scrollback after quitting\n  -i, --interactive                    Prompt before each removal (y/n/a/q) (alias: --confirm-each)\n      --keep-running                   Skip binaries that are currently running (with --all)\n      --list-layout                    Show TUI binaries one per line instead of in a grid\n  -l, --log-level string               Set log level (debug, info, warn, error) (default \"info\")\n      --log-sink string                Send logs to stderr, syslog, or both (default \"stderr\")\n      --max-size string                Only show binaries at most this large, e.g. 1MiB (TUI and --all)\n      --min-size string                Only show binaries at least this large, e.g. 50MB (TUI and --all)\n  -m, --module string                  Remove the binary built from this module or package path (alias: --by-module)\n      --newer-than string              Only show binaries last modified at most this long ago, e.g. 1w (TUI and --all)\n      --no-tui                         Fail instead of launching the TUI when no binary is specified\n      --notify                         Show a desktop notification when removal finishes\n      --older-than string              Only show binaries last modified at least this long ago, e.g. 30d (TUI and --all)\n      --output-dir string              Write a JSON log file per removed binary into this directory\n      --path                           Treat the argument as a file path instead of a binary name\n      --print-bindir                   Print the target binary directory and exit\n      --print-config string[=\"json\"]   Print the effective configuration as json or yaml and exit\n      --prune-empty                    Remove a binary's directory once empty (never GOBIN, GOPATH, or GOROOT)\n      --regex string                   Only show binaries whose names match this regular expression (TUI and --all)\n      --reinstall-version string       After removing the binary, go install its package at this version (e.g. v1.2.3)\n      --remember-state                 Reopen the TUI with the sort order and panels it was left with\n      --report string                  Write a JSON report of removed binaries to this file\n  -r, --restore                        Open history view for restoration\n      --safe                           Refuse to remove anything outside GOROOT, GOPATH, GOBIN, or ~/go\n      --select-from-file string        Remove the binaries listed in this file, one name per line, after showing the plan\n      --show-diff                      Print the binaries a batch removed from the directory as a diff\n      --since-install string           Only remove binaries built on or after this date, or before it with \"<\", e.g. 2024-01-31 (with --all)\n      --skip-parent                    Skip a binary that is running go-remove, e.g. from a wrapper\n      --stats                          Print aggregate removal timing after a batch\n      --symbols string                 Mark results with unicode (✓/✗) or ascii (OK/FAIL) symbols\n      --throttle duration              Pause this long between removals in a batch, e.g. 500ms\n  -u, --undo                           Undo the most recent deletion\n  -v, --verbose                        Enable verbose output\n  -y, --yes                            Remove without asking for confirmation (with --all)\n\nUse \"go-remove [command] --help\" for more information about a command.\n",
 			wantErr:    false,
 		},
 	}
@@ -84,3 +98,994 @@ func TestGetStoragePath(t *testing.T) {
 		t.Errorf("getStoragePath() = %q, expected to contain 'go-remove'", path)
 	}
 }
+
+// TestExecute_ClosedStderr verifies a failing command still reports its error
+// and exit code when stderr has been closed.
+func TestExecute_ClosedStderr(t *testing.T) {
+	stderr, err := os.CreateTemp(t.TempDir(), "stderr")
+	if err != nil {
+		t.Fatalf("failed to create stderr file: %v", err)
+	}
+
+	stderr.Close()
+
+	var stdout bytes.Buffer
+
+	// Flag values persist between executions; clear the help flag an earlier test may have set.
+	if err := rootCmd.Flags().Set("help", "false"); err != nil {
+		t.Fatalf("failed to reset help flag: %v", err)
+	}
+
+	// Cobra's own output goes to the closed file as well.
+	rootCmd.SetOut(stderr)
+	rootCmd.SetErr(stderr)
+	rootCmd.SetArgs([]string{"--undo", "vhs"})
+
+	t.Cleanup(func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+		rootCmd.SetArgs(nil)
+		_ = rootCmd.Flags().Set("undo", "false")
+	})
+
+	if code := execute(stderr, &stdout); code != exitFailure {
+		t.Errorf("execute() = %d, want %d", code, exitFailure)
+	}
+
+	if want := "Error: " + ErrUndoWithBinary.Error() + "\n"; stdout.String() != want {
+		t.Errorf("execute() stdout = %q, want %q", stdout.String(), want)
+	}
+}
+
+// TestExecute_ExitCodeMissingBinary verifies a drift check for a binary that
+// is not installed exits with exitCheckFailed rather than reporting drift.
+func TestExecute_ExitCodeMissingBinary(t *testing.T) {
+	dataHome := t.TempDir()
+
+	t.Setenv(userconfig.EnvPath, filepath.Join(t.TempDir(), "missing.yaml"))
+	t.Setenv("GOBIN", t.TempDir())
+	t.Setenv("HOME", dataHome)
+	t.Setenv("XDG_DATA_HOME", dataHome)
+
+	var stderr, stdout bytes.Buffer
+
+	// Flag values persist between executions; clear the help flag an earlier
+	// test may have set. Cobra only adds it on the first execution.
+	if help := rootCmd.Flags().Lookup("help"); help != nil {
+		_ = help.Value.Set("false")
+	}
+
+	rootCmd.SetOut(&stdout)
+	rootCmd.SetErr(&stderr)
+	rootCmd.SetArgs([]string{"--dry-run", "--exit-code", "doesnotexist"})
+
+	t.Cleanup(func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+		rootCmd.SetArgs(nil)
+		_ = rootCmd.Flags().Set("dry-run", "false")
+		_ = rootCmd.Flags().Set("exit-code", "false")
+	})
+
+	if code := execute(&stderr, &stdout); code != exitCheckFailed {
+		t.Errorf("execute() = %d, want %d", code, exitCheckFailed)
+	}
+
+	if !strings.Contains(stderr.String(), "binary not found") {
+		t.Errorf("execute() stderr = %q, want a binary not found error", stderr.String())
+	}
+}
+
+// testSettings loads the config file the test points userconfig.EnvPath at.
+func testSettings(t *testing.T) userconfig.Settings {
+	t.Helper()
+
+	settings, err := loadSettings()
+	if err != nil {
+		t.Fatalf("loadSettings() error = %v", err)
+	}
+
+	return settings
+}
+
+// Test_resolveDryRun verifies safe_mode makes dry runs the default and that
+// --apply, or its --no-dry-run alias, overrides it.
+func Test_resolveDryRun(t *testing.T) {
+	safeConfig := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(safeConfig, []byte("safe_mode: true\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		safeMode bool
+		env      string
+		args     []string
+		want     bool
+		wantErr  error
+	}{
+		{name: "default", want: false},
+		{name: "dry run flag", args: []string{"--dry-run"}, want: true},
+		{name: "safe mode", safeMode: true, want: true},
+		{name: "safe mode with apply", safeMode: true, args: []string{"--apply"}, want: false},
+		{name: "safe mode with no-dry-run", safeMode: true, args: []string{"--no-dry-run"}, want: false},
+		{name: "apply and dry run", args: []string{"--apply", "-n"}, wantErr: ErrApplyWithDryRun},
+		{name: "dry run env", env: "true", want: true},
+		{name: "apply overrides dry run env", env: "true", args: []string{"--apply"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "missing.yaml")
+			if tt.safeMode {
+				configPath = safeConfig
+			}
+
+			t.Setenv(userconfig.EnvPath, configPath)
+			t.Setenv("GOREMOVE_DRY_RUN", tt.env)
+
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.BoolP("dry-run", "n", false, "")
+			flags.Bool("apply", false, "")
+			flags.SetNormalizeFunc(applyFlagAlias)
+
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if tt.env != "" {
+				if err := applyEnvFlags(flags); err != nil {
+					t.Fatalf("applyEnvFlags() error = %v", err)
+				}
+			}
+
+			got, err := resolveDryRun(flags, testSettings(t))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("resolveDryRun() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("resolveDryRun() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_resolveExitCode verifies --exit-code is accepted only for dry runs.
+func Test_resolveExitCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		dryRun  bool
+		want    bool
+		wantErr error
+	}{
+		{name: "default", want: false},
+		{name: "dry run", args: []string{"--exit-code"}, dryRun: true, want: true},
+		{name: "without dry run", args: []string{"--exit-code"}, wantErr: ErrExitCodeWithoutDryRun},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.Bool("exit-code", false, "")
+
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			got, err := resolveExitCode(flags, tt.dryRun)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("resolveExitCode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("resolveExitCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_resolveAllow verifies the config file's allow_pattern always applies,
+// --allow-pattern narrows it, and only --force lifts it.
+func Test_resolveAllow(t *testing.T) {
+	allowConfig := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(allowConfig, []byte("allow_pattern: ^go\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		fromConfig bool
+		args       []string
+		allowed    []string
+		refused    []string
+		wantErr    bool
+	}{
+		{name: "default", allowed: []string{"gopls", "vhs"}},
+		{name: "config", fromConfig: true, allowed: []string{"gopls"}, refused: []string{"vhs"}},
+		{
+			name:       "flag narrows config",
+			fromConfig: true,
+			args:       []string{"--allow-pattern", "lint$"},
+			allowed:    []string{"golangci-lint"},
+			refused:    []string{"gopls", "revive-lint"},
+		},
+		{
+			name:       "flag cannot widen config",
+			fromConfig: true,
+			args:       []string{"--allow-pattern", "."},
+			refused:    []string{"vhs"},
+		},
+		{
+			name:       "force replaces config",
+			fromConfig: true,
+			args:       []string{"--force", "--allow-pattern", "^vhs$"},
+			allowed:    []string{"vhs"},
+			refused:    []string{"gopls"},
+		},
+		{name: "invalid flag", args: []string{"--allow-pattern", "("}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "missing.yaml")
+			if tt.fromConfig {
+				configPath = allowConfig
+			}
+
+			t.Setenv(userconfig.EnvPath, configPath)
+
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.String("allow-pattern", "", "")
+			flags.Bool("force", false, "")
+
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			allow, err := resolveAllow(flags, testSettings(t))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveAllow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			matchesAll := func(name string) bool {
+				for _, pattern := range allow {
+					if !pattern.MatchString(name) {
+						return false
+					}
+				}
+
+				return true
+			}
+
+			for _, name := range tt.allowed {
+				if !matchesAll(name) {
+					t.Errorf("resolveAllow() refuses %s, want it allowed", name)
+				}
+			}
+
+			for _, name := range tt.refused {
+				if matchesAll(name) {
+					t.Errorf("resolveAllow() allows %s, want it refused", name)
+				}
+			}
+		})
+	}
+}
+
+// Test_applyFlagAlias verifies aliases resolve to their flags, and only on
+// flag sets that define the target flag.
+func Test_applyFlagAlias(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.StringP("module", "m", "", "")
+	flags.SetNormalizeFunc(applyFlagAlias)
+
+	if err := flags.Parse([]string{"--by-module", "github.com/charmbracelet/vhs"}); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if got, _ := flags.GetString("module"); got != "github.com/charmbracelet/vhs" {
+		t.Errorf("module = %q, want the --by-module value", got)
+	}
+
+	interactive := pflag.NewFlagSet("interactive", pflag.ContinueOnError)
+	interactive.BoolP("interactive", "i", false, "")
+	interactive.SetNormalizeFunc(applyFlagAlias)
+
+	if err := interactive.Parse([]string{"--confirm-each"}); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if got, _ := interactive.GetBool("interactive"); !got {
+		t.Error("interactive = false, want --confirm-each to set it")
+	}
+
+	other := pflag.NewFlagSet("other", pflag.ContinueOnError)
+	other.SetOutput(io.Discard)
+	other.SetNormalizeFunc(applyFlagAlias)
+
+	err := other.Parse([]string{"--by-module", "x"})
+	if err == nil || !strings.Contains(err.Error(), "--by-module") {
+		t.Errorf("Parse() error = %v, want unknown flag --by-module", err)
+	}
+}
+
+// Test_resolveSymbols verifies --symbols overrides the config file's symbols
+// setting and that unknown names are rejected.
+func Test_resolveSymbols(t *testing.T) {
+	asciiConfig := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(asciiConfig, []byte("symbols: ascii\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		fromConfig  bool
+		args        []string
+		wantSuccess string
+		wantErr     error
+	}{
+		{name: "default", wantSuccess: ""},
+		{name: "flag", args: []string{"--symbols", "unicode"}, wantSuccess: "✓"},
+		{name: "config", fromConfig: true, wantSuccess: "OK"},
+		{name: "flag overrides config", fromConfig: true, args: []string{"--symbols=unicode"}, wantSuccess: "✓"},
+		{name: "unknown", args: []string{"--symbols", "emoji"}, wantErr: cli.ErrUnknownSymbols},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "missing.yaml")
+			if tt.fromConfig {
+				configPath = asciiConfig
+			}
+
+			t.Setenv(userconfig.EnvPath, configPath)
+
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.String("symbols", "", "")
+
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			got, err := resolveSymbols(flags, testSettings(t))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("resolveSymbols() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got.Success != tt.wantSuccess {
+				t.Errorf("resolveSymbols() success = %q, want %q", got.Success, tt.wantSuccess)
+			}
+		})
+	}
+}
+
+// Test_compileRegex verifies an empty pattern disables filtering and an
+// invalid one is rejected.
+func Test_compileRegex(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "no pattern", wantNil: true},
+		{name: "valid pattern", args: []string{"--regex", "^go"}},
+		{name: "invalid pattern", args: []string{"--regex", "go("}, wantNil: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.String("regex", "", "")
+
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			got, err := compileRegex(flags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("compileRegex() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if (got == nil) != tt.wantNil {
+				t.Errorf("compileRegex() = %v, want nil %v", got, tt.wantNil)
+			}
+		})
+	}
+}
+
+// TestRootCommand_RegexWithBinary verifies --regex cannot be combined with
+// explicit binary names.
+func TestRootCommand_RegexWithBinary(t *testing.T) {
+	t.Setenv(userconfig.EnvPath, filepath.Join(t.TempDir(), "missing.yaml"))
+
+	if err := rootCmd.Flags().Set("regex", "^go"); err != nil {
+		t.Fatalf("failed to set regex flag: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = rootCmd.Flags().Set("regex", "")
+	})
+
+	if err := rootCmd.RunE(rootCmd, []string{"vhs"}); !errors.Is(err, ErrRegexWithBinary) {
+		t.Errorf("RunE() error = %v, want %v", err, ErrRegexWithBinary)
+	}
+}
+
+// TestRootCommand_ReinstallWithoutBinary verifies --reinstall-version needs
+// exactly one binary to reinstall.
+func TestRootCommand_ReinstallWithoutBinary(t *testing.T) {
+	t.Setenv(userconfig.EnvPath, filepath.Join(t.TempDir(), "missing.yaml"))
+
+	if err := rootCmd.Flags().Set("reinstall-version", "v1.2.3"); err != nil {
+		t.Fatalf("failed to set reinstall-version flag: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = rootCmd.Flags().Set("reinstall-version", "")
+	})
+
+	err := rootCmd.RunE(rootCmd, []string{"vhs", "gopls"})
+	if !errors.Is(err, ErrReinstallWithoutBinary) {
+		t.Errorf("RunE() error = %v, want %v", err, ErrReinstallWithoutBinary)
+	}
+}
+
+// TestRootCommand_NoTUI verifies --no-tui refuses to launch the TUI when no
+// binary is named, while a named binary is still removed.
+func TestRootCommand_NoTUI(t *testing.T) {
+	binDir := t.TempDir()
+	dataHome := t.TempDir()
+
+	t.Setenv(userconfig.EnvPath, filepath.Join(t.TempDir(), "missing.yaml"))
+	t.Setenv("GOBIN", binDir)
+	t.Setenv("HOME", dataHome)
+	t.Setenv("XDG_DATA_HOME", dataHome)
+
+	if err := rootCmd.Flags().Set("no-tui", "true"); err != nil {
+		t.Fatalf("failed to set no-tui flag: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = rootCmd.Flags().Set("no-tui", "false")
+	})
+
+	if err := rootCmd.RunE(rootCmd, nil); !errors.Is(err, ErrNoTUI) {
+		t.Errorf("RunE() error = %v, want %v", err, ErrNoTUI)
+	}
+
+	// Removal records build info, so stand the test binary in for vhs.
+	self, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(self)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binary := filepath.Join(binDir, "vhs")
+	if err := os.WriteFile(binary, data, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rootCmd.RunE(rootCmd, []string{"vhs"}); err != nil {
+		t.Fatalf("RunE() with a binary error = %v", err)
+	}
+
+	if _, err := os.Stat(binary); !os.IsNotExist(err) {
+		t.Errorf("RunE() left %s in place, stat error = %v", binary, err)
+	}
+}
+
+// TestRootCommand_EventsWithTUI verifies --events is refused instead of being
+// ignored when no binary is named and the TUI would launch.
+func TestRootCommand_EventsWithTUI(t *testing.T) {
+	t.Setenv(userconfig.EnvPath, filepath.Join(t.TempDir(), "missing.yaml"))
+	t.Setenv("GOBIN", t.TempDir())
+
+	if err := rootCmd.Flags().Set("events", filepath.Join(t.TempDir(), "events.sock")); err != nil {
+		t.Fatalf("failed to set events flag: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = rootCmd.Flags().Set("events", "")
+	})
+
+	if err := rootCmd.RunE(rootCmd, nil); !errors.Is(err, ErrEventsWithTUI) {
+		t.Errorf("RunE() error = %v, want %v", err, ErrEventsWithTUI)
+	}
+}
+
+// TestRootCommand_NotifyWithTUI verifies --notify is refused instead of being
+// ignored when no binary is named and the TUI would launch.
+func TestRootCommand_NotifyWithTUI(t *testing.T) {
+	t.Setenv(userconfig.EnvPath, filepath.Join(t.TempDir(), "missing.yaml"))
+	t.Setenv("GOBIN", t.TempDir())
+
+	if err := rootCmd.Flags().Set("notify", "true"); err != nil {
+		t.Fatalf("failed to set notify flag: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = rootCmd.Flags().Set("notify", "false")
+	})
+
+	if err := rootCmd.RunE(rootCmd, nil); !errors.Is(err, ErrNotifyWithTUI) {
+		t.Errorf("RunE() error = %v, want %v", err, ErrNotifyWithTUI)
+	}
+}
+
+// TestPruneCommand_WithoutManifest verifies prune refuses to run without a manifest.
+func TestPruneCommand_WithoutManifest(t *testing.T) {
+	err := pruneCmd.RunE(pruneCmd, nil)
+	if !errors.Is(err, ErrPruneWithoutManifest) {
+		t.Errorf("RunE() error = %v, want %v", err, ErrPruneWithoutManifest)
+	}
+}
+
+// TestListCommand_NullWithJSON verifies --null is refused with output that is
+// not one name per entry.
+func TestListCommand_NullWithJSON(t *testing.T) {
+	for name, value := range map[string]string{"null": "true", "json": "true"} {
+		if err := listCmd.Flags().Set(name, value); err != nil {
+			t.Fatalf("failed to set %s flag: %v", name, err)
+		}
+
+		t.Cleanup(func() {
+			_ = listCmd.Flags().Set(name, "false")
+		})
+	}
+
+	err := listCmd.RunE(listCmd, nil)
+	if !errors.Is(err, ErrNullWithFormat) {
+		t.Errorf("RunE() error = %v, want %v", err, ErrNullWithFormat)
+	}
+}
+
+// TestListCommand_InvalidTimeFormat verifies --time-format is checked before
+// anything is listed.
+func TestListCommand_InvalidTimeFormat(t *testing.T) {
+	if err := listCmd.Flags().Set("time-format", "yesterday"); err != nil {
+		t.Fatalf("failed to set time-format flag: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = listCmd.Flags().Set("time-format", format.TimeRelative)
+	})
+
+	err := listCmd.RunE(listCmd, nil)
+	if !errors.Is(err, format.ErrInvalidTimeFormat) {
+		t.Errorf("RunE() error = %v, want %v", err, format.ErrInvalidTimeFormat)
+	}
+}
+
+// TestRootCommand_PrintBinDir verifies --print-bindir prints only the
+// resolved directory and refuses to be combined with binary names.
+func TestRootCommand_PrintBinDir(t *testing.T) {
+	binDir := t.TempDir()
+
+	t.Setenv("GOBIN", binDir)
+
+	if err := rootCmd.Flags().Set("print-bindir", "true"); err != nil {
+		t.Fatalf("failed to set print-bindir flag: %v", err)
+	}
+
+	var stdout bytes.Buffer
+
+	rootCmd.SetOut(&stdout)
+
+	t.Cleanup(func() {
+		rootCmd.SetOut(nil)
+
+		flag := rootCmd.Flags().Lookup("print-bindir")
+		_ = flag.Value.Set("false")
+		flag.Changed = false
+	})
+
+	if err := rootCmd.RunE(rootCmd, nil); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+
+	if got, want := stdout.String(), binDir+"\n"; got != want {
+		t.Errorf("stdout = %q, want %q", got, want)
+	}
+
+	if err := rootCmd.RunE(rootCmd, []string{"age"}); !errors.Is(err, ErrPrintBinDirWithMode) {
+		t.Errorf("RunE() with a binary name error = %v, want %v", err, ErrPrintBinDirWithMode)
+	}
+}
+
+// TestRootCommand_PrintConfig verifies --print-config prints the resolved
+// configuration, with a flag taking precedence over the config file named by
+// the environment.
+func TestRootCommand_PrintConfig(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configPath, []byte("symbols: ascii\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	binDir := t.TempDir()
+
+	t.Setenv(userconfig.EnvPath, configPath)
+	t.Setenv("GOBIN", binDir)
+
+	for name, value := range map[string]string{"print-config": "json", "symbols": "unicode"} {
+		if err := rootCmd.Flags().Set(name, value); err != nil {
+			t.Fatalf("failed to set %s flag: %v", name, err)
+		}
+	}
+
+	var stdout bytes.Buffer
+
+	rootCmd.SetOut(&stdout)
+
+	t.Cleanup(func() {
+		rootCmd.SetOut(nil)
+
+		for _, name := range []string{"print-config", "symbols"} {
+			flag := rootCmd.Flags().Lookup(name)
+			_ = flag.Value.Set("")
+			flag.Changed = false
+		}
+	})
+
+	if err := rootCmd.RunE(rootCmd, nil); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+
+	var got struct {
+		BinDir string `json:"binDir"`
+		Config struct {
+			Symbols  cli.SymbolSet `json:"symbols"`
+			Throttle string        `json:"throttle"`
+		} `json:"config"`
+	}
+
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, stdout.String())
+	}
+
+	if got.BinDir != binDir {
+		t.Errorf("binDir = %q, want %q", got.BinDir, binDir)
+	}
+
+	if got.Config.Symbols.Success != "✓" {
+		t.Errorf("symbols.success = %q, want the --symbols value over the config file", got.Config.Symbols.Success)
+	}
+
+	if got.Config.Throttle != "0s" {
+		t.Errorf("throttle = %q, want %q", got.Config.Throttle, "0s")
+	}
+}
+
+// Test_applyEnvFlags verifies the precedence of flags over GOREMOVE_
+// variables, of those over the config file, and of the config file over
+// defaults.
+func Test_applyEnvFlags(t *testing.T) {
+	asciiConfig := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(asciiConfig, []byte("symbols: ascii\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		fromConfig  bool
+		env         string
+		args        []string
+		wantSuccess string
+		wantErr     bool
+	}{
+		{name: "default", wantSuccess: ""},
+		{name: "config", fromConfig: true, wantSuccess: "OK"},
+		{name: "env overrides config", fromConfig: true, env: "unicode", wantSuccess: "✓"},
+		{name: "flag overrides env", env: "unicode", args: []string{"--symbols", "ascii"}, wantSuccess: "OK"},
+		{name: "invalid env", env: "emoji", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "missing.yaml")
+			if tt.fromConfig {
+				configPath = asciiConfig
+			}
+
+			t.Setenv(userconfig.EnvPath, configPath)
+
+			if tt.env != "" {
+				t.Setenv("GOREMOVE_SYMBOLS", tt.env)
+			}
+
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.String("symbols", "", "")
+
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if err := applyEnvFlags(flags); err != nil {
+				t.Fatalf("applyEnvFlags() error = %v", err)
+			}
+
+			got, err := resolveSymbols(flags, testSettings(t))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveSymbols() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got.Success != tt.wantSuccess {
+				t.Errorf("resolveSymbols() success = %q, want %q", got.Success, tt.wantSuccess)
+			}
+		})
+	}
+}
+
+// Test_applyEnvFlags_Invalid verifies a value the flag cannot parse is
+// reported with the variable's name.
+func Test_applyEnvFlags_Invalid(t *testing.T) {
+	t.Setenv("GOREMOVE_GOROOT", "maybe")
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.Bool("goroot", false, "")
+
+	err := applyEnvFlags(flags)
+	if err == nil || !strings.Contains(err.Error(), "GOREMOVE_GOROOT") {
+		t.Errorf("applyEnvFlags() error = %v, want one naming GOREMOVE_GOROOT", err)
+	}
+}
+
+// Test_printConfig verifies the YAML output uses block style and the same
+// field names as JSON, and that unknown formats are rejected.
+func Test_printConfig(t *testing.T) {
+	effective := effectiveConfig{
+		BinDir: "/home/user/go/bin",
+		Config: cli.Config{LogLevel: "debug", Throttle: 500 * time.Millisecond},
+	}
+
+	var out bytes.Buffer
+	if err := printConfig(&out, configFormatYAML, effective); err != nil {
+		t.Fatalf("printConfig() error = %v", err)
+	}
+
+	for _, want := range []string{"binDir: /home/user/go/bin\n", "config:\n", "  logLevel: debug\n", "  throttle: 500ms\n"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("printConfig() output missing %q:\n%s", want, out.String())
+		}
+	}
+
+	if err := printConfig(io.Discard, "toml", effective); !errors.Is(err, ErrInvalidConfigFormat) {
+		t.Errorf("printConfig() error = %v, want %v", err, ErrInvalidConfigFormat)
+	}
+}
+
+// Test_parseSizeFlags verifies both bounds are parsed and that an inverted
+// range or an unparsable size is rejected.
+func Test_parseSizeFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantMin int64
+		wantMax int64
+		wantErr error
+	}{
+		{name: "no bounds"},
+		{name: "both bounds", args: []string{"--min-size", "1MB", "--max-size", "1GiB"}, wantMin: 1_000_000, wantMax: 1 << 30},
+		{name: "equal bounds", args: []string{"--min-size=5MB", "--max-size=5MB"}, wantMin: 5_000_000, wantMax: 5_000_000},
+		{name: "inverted range", args: []string{"--min-size", "2MB", "--max-size", "1MB"}, wantErr: ErrMinSizeAboveMax},
+		{name: "invalid size", args: []string{"--max-size", "big"}, wantErr: cli.ErrInvalidSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.String("min-size", "", "")
+			flags.String("max-size", "", "")
+
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			gotMin, gotMax, err := parseSizeFlags(flags)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("parseSizeFlags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if gotMin != tt.wantMin || gotMax != tt.wantMax {
+				t.Errorf("parseSizeFlags() = %d, %d, want %d, %d", gotMin, gotMax, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+// Test_parseAgeFlags verifies --newer-than alone and together with
+// --older-than, rejecting a window no binary can fall in.
+func Test_parseAgeFlags(t *testing.T) {
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name      string
+		args      []string
+		wantOlder time.Duration
+		wantNewer time.Duration
+		wantErr   error
+	}{
+		{name: "no bounds"},
+		{name: "newer than", args: []string{"--newer-than", "1w"}, wantNewer: 7 * day},
+		{name: "invalid age", args: []string{"--older-than=7d", "--newer-than=1y"}, wantErr: cli.ErrInvalidAge},
+		{name: "valid window", args: []string{"--older-than", "7d", "--newer-than", "90d"}, wantOlder: 7 * day, wantNewer: 90 * day},
+		{name: "empty window", args: []string{"--older-than", "90d", "--newer-than", "7d"}, wantErr: ErrEmptyAgeWindow},
+		{name: "equal bounds", args: []string{"--older-than", "7d", "--newer-than", "1w"}, wantErr: ErrEmptyAgeWindow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.String("older-than", "", "")
+			flags.String("newer-than", "", "")
+
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			gotOlder, gotNewer, err := parseAgeFlags(flags)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("parseAgeFlags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if gotOlder != tt.wantOlder || gotNewer != tt.wantNewer {
+				t.Errorf("parseAgeFlags() = %s, %s, want %s, %s", gotOlder, gotNewer, tt.wantOlder, tt.wantNewer)
+			}
+		})
+	}
+}
+
+// Test_throttleFlag verifies --throttle durations are parsed and negative ones rejected.
+func Test_throttleFlag(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    time.Duration
+		wantErr error
+	}{
+		{name: "unset"},
+		{name: "duration", args: []string{"--throttle", "250ms"}, want: 250 * time.Millisecond},
+		{name: "negative", args: []string{"--throttle=-1s"}, wantErr: ErrNegativeThrottle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.Duration("throttle", 0, "")
+
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			got, err := throttleFlag(flags)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("throttleFlag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("throttleFlag() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_startProfile verifies each profile kind writes a file in the current
+// directory and that unknown kinds are rejected.
+func Test_startProfile(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	for _, kind := range []string{profileCPU, profileMem} {
+		var out bytes.Buffer
+
+		stop, err := startProfile(kind, &out)
+		if err != nil {
+			t.Fatalf("startProfile(%q) error = %v", kind, err)
+		}
+
+		if err := stop(); err != nil {
+			t.Fatalf("stop() for %q error = %v", kind, err)
+		}
+
+		info, err := os.Stat(profilePath(kind))
+		if err != nil || info.Size() == 0 {
+			t.Errorf("profile %s missing or empty: %v", profilePath(kind), err)
+		}
+
+		if !strings.Contains(out.String(), profilePath(kind)) {
+			t.Errorf("startProfile(%q) output = %q, want the profile path", kind, out.String())
+		}
+	}
+
+	if _, err := startProfile("trace", io.Discard); !errors.Is(err, ErrInvalidProfile) {
+		t.Errorf("startProfile() error = %v, want %v", err, ErrInvalidProfile)
+	}
+}
+
+// Test_resolveTrashPolicy verifies --max-age and --max-size win over the
+// config file's trash limits, and that trash --clean needs at least one limit.
+func Test_resolveTrashPolicy(t *testing.T) {
+	trashConfig := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(trashConfig, []byte("trash_max_age: 30d\ntrash_max_size: 1GB\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		fromConfig bool
+		args       []string
+		want       history.PurgePolicy
+		wantErr    error
+	}{
+		{name: "config", fromConfig: true, want: history.PurgePolicy{MaxAge: 30 * 24 * time.Hour, MaxSize: 1_000_000_000}},
+		{
+			name:       "flag overrides config",
+			fromConfig: true,
+			args:       []string{"--max-age", "1w", "--dry-run"},
+			want:       history.PurgePolicy{MaxAge: 7 * 24 * time.Hour, MaxSize: 1_000_000_000, DryRun: true},
+		},
+		{name: "flag only", args: []string{"--max-size", "500MB"}, want: history.PurgePolicy{MaxSize: 500_000_000}},
+		{name: "no limit", wantErr: ErrNoTrashLimit},
+		{name: "invalid age", args: []string{"--max-age", "soon"}, wantErr: cli.ErrInvalidAge},
+		{name: "invalid size", args: []string{"--max-size", "big"}, wantErr: cli.ErrInvalidSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "missing.yaml")
+			if tt.fromConfig {
+				configPath = trashConfig
+			}
+
+			t.Setenv(userconfig.EnvPath, configPath)
+
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.String("max-age", "", "")
+			flags.String("max-size", "", "")
+			flags.Bool("dry-run", false, "")
+
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			got, err := resolveTrashPolicy(flags, testSettings(t))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("resolveTrashPolicy() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("resolveTrashPolicy() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_runTrashClean verifies each purged binary is reported with its size
+// and reason, followed by the space freed.
+func Test_runTrashClean(t *testing.T) {
+	policy := history.PurgePolicy{MaxAge: time.Hour}
+
+	manager := historymocks.NewMockManager(t)
+	manager.EXPECT().PurgeTrash(mock.Anything, policy).Return([]history.PurgedEntry{
+		{Entry: &history.HistoryEntry{BinaryName: "age"}, Size: 1_500_000, Reason: history.PurgeReasonExpired},
+		{Entry: &history.HistoryEntry{BinaryName: "gopls"}, Size: 30_000_000, Reason: history.PurgeReasonOverCap},
+	}, nil)
+
+	var stdout bytes.Buffer
+
+	if err := runTrashClean(&stdout, manager, policy); err != nil {
+		t.Fatalf("runTrashClean() error = %v", err)
+	}
+
+	want := "Purged age (1.5 MB, expired)\n" +
+		"Purged gopls (30.0 MB, over size cap)\n" +
+		"Freed 31.5 MB across 2 binaries\n"
+	if got := stdout.String(); got != want {
+		t.Errorf("runTrashClean() output = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/nicholas-fedor/go-remove/internal/cli"
+	"github.com/nicholas-fedor/go-remove/internal/format"
+	"github.com/nicholas-fedor/go-remove/internal/history"
+	"github.com/nicholas-fedor/go-remove/internal/logger"
+	"github.com/nicholas-fedor/go-remove/internal/userconfig"
+)
+
+// trashCmd defines the trash subcommand, which keeps deleted binaries in the
+// trash from growing without bound.
+var trashCmd = &cobra.Command{
+	Use:   "trash --clean",
+	Short: "Permanently delete old binaries from the trash",
+	Long: `Permanently delete binaries go-remove moved to the trash once they are too
+old or the trash holds too much.
+
+Binaries trashed longer ago than --max-age are deleted first; then, while the
+rest take up more than --max-size, the oldest are deleted. The limits default
+to the trash_max_age and trash_max_size config settings. Deleted binaries stay
+in the history, with their module path and version, but can no longer be
+restored.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		clean, _ := cmd.Flags().GetBool("clean")
+		verbose, _ := cmd.Flags().GetBool("verbose")
+		logLevel, _ := cmd.Flags().GetString("log-level")
+		logSink, _ := cmd.Flags().GetString("log-sink")
+
+		if !clean {
+			return ErrTrashWithoutClean
+		}
+
+		settings, err := loadSettings()
+		if err != nil {
+			return err
+		}
+
+		policy, err := resolveTrashPolicy(cmd.Flags(), settings)
+		if err != nil {
+			return err
+		}
+
+		log, err := logger.NewLoggerWithSink(logSink)
+		if err != nil {
+			return fmt.Errorf("failed to initialize logger: %w", err)
+		}
+
+		// Verbose cleaning logs each purge at debug level unless --log-level says otherwise.
+		if verbose {
+			level := zerolog.DebugLevel
+			if cmd.Flags().Changed("log-level") {
+				level = logger.ParseLevel(logLevel)
+			}
+
+			log.Level(level)
+		}
+
+		manager, err := initHistoryManager(log)
+		if err != nil {
+			return fmt.Errorf("failed to initialize history manager: %w", err)
+		}
+
+		defer func() {
+			if closeErr := manager.Close(); closeErr != nil {
+				log.Warn().Err(closeErr).Msg("Failed to close history manager")
+			}
+		}()
+
+		return runTrashClean(cmd.OutOrStdout(), manager, policy)
+	},
+}
+
+// resolveTrashPolicy reads the limits trash --clean enforces. --max-age and
+// --max-size win over the trash_max_age and trash_max_size config settings.
+//
+// Parameters:
+//   - flags: Flag set defining max-age, max-size, and dry-run
+//   - settings: Config file settings, from loadSettings
+//
+// Returns:
+//   - The purge policy
+//   - An error if a limit is malformed or none is set
+func resolveTrashPolicy(flags *pflag.FlagSet, settings userconfig.Settings) (history.PurgePolicy, error) {
+	maxAge, _ := flags.GetString("max-age")
+	maxSize, _ := flags.GetString("max-size")
+	dryRun, _ := flags.GetBool("dry-run")
+
+	if !flags.Changed("max-age") {
+		maxAge = settings.TrashMaxAge
+	}
+
+	if !flags.Changed("max-size") {
+		maxSize = settings.TrashMaxSize
+	}
+
+	policy := history.PurgePolicy{DryRun: dryRun}
+
+	if maxAge != "" {
+		age, err := cli.ParseAge(maxAge)
+		if err != nil {
+			return history.PurgePolicy{}, fmt.Errorf("invalid trash age limit: %w", err)
+		}
+
+		policy.MaxAge = age
+	}
+
+	if maxSize != "" {
+		size, err := cli.ParseSize(maxSize)
+		if err != nil {
+			return history.PurgePolicy{}, fmt.Errorf("invalid trash size limit: %w", err)
+		}
+
+		policy.MaxSize = size
+	}
+
+	if policy.MaxAge == 0 && policy.MaxSize == 0 {
+		return history.PurgePolicy{}, ErrNoTrashLimit
+	}
+
+	return policy, nil
+}
+
+// runTrashClean purges the trash according to policy and reports each
+// binary permanently deleted, or that would be with policy.DryRun.
+//
+// Parameters:
+//   - w: Destination for the report
+//   - manager: History manager that owns the trashed binaries
+//   - policy: The age and size limits to enforce
+//
+// Returns:
+//   - An error if purging fails; binaries purged before the failure are still reported
+func runTrashClean(w io.Writer, manager history.Manager, policy history.PurgePolicy) error {
+	purged, err := manager.PurgeTrash(context.Background(), policy)
+
+	verb := "Purged"
+	if policy.DryRun {
+		verb = "Would purge"
+	}
+
+	var freed int64
+
+	for _, entry := range purged {
+		fmt.Fprintf(w, "%s %s (%s, %s)\n", verb, entry.Entry.BinaryName, format.Bytes(entry.Size), entry.Reason)
+
+		freed += entry.Size
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to clean trash: %w", err)
+	}
+
+	if len(purged) == 0 {
+		fmt.Fprintln(w, "Nothing to purge from the trash")
+
+		return nil
+	}
+
+	noun := "binaries"
+	if len(purged) == 1 {
+		noun = "binary"
+	}
+
+	if policy.DryRun {
+		fmt.Fprintf(w, "Would free %s across %d %s\n", format.Bytes(freed), len(purged), noun)
+	} else {
+		fmt.Fprintf(w, "Freed %s across %d %s\n", format.Bytes(freed), len(purged), noun)
+	}
+
+	return nil
+}
+
+// init registers the trash subcommand and its flags.
+func init() {
+	trashCmd.Flags().BoolP("clean", "", false, "Permanently delete binaries past the age or size limit")
+	trashCmd.Flags().StringP("max-age", "", "", "Delete binaries trashed longer ago than this, e.g. 30d (default trash_max_age)")
+	trashCmd.Flags().StringP("max-size", "", "", "Delete the oldest binaries while the trash holds more than this, e.g. 1GB (default trash_max_size)")
+	trashCmd.Flags().BoolP("dry-run", "n", false, "Show what would be deleted without deleting anything")
+	addLogFlags(trashCmd.Flags())
+
+	rootCmd.AddCommand(trashCmd)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/nicholas-fedor/go-remove/internal/cli"
+)
+
+// uninstallCmd defines the uninstall subcommand, which accepts go install-style arguments.
+var uninstallCmd = &cobra.Command{
+	Use:   "uninstall package[@version]...",
+	Short: "Remove binaries using go install-style package paths",
+	Long: `Remove binaries using the same arguments passed to go install.
+
+Each package path is reduced to the binary name go install would have produced,
+so "go-remove uninstall github.com/foo/bar/cmd/baz@latest" removes "baz".`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		verbose, _ := cmd.Flags().GetBool("verbose")
+		goroot, _ := cmd.Flags().GetBool("goroot")
+		logLevel, _ := cmd.Flags().GetString("log-level")
+		logSink, _ := cmd.Flags().GetString("log-sink")
+		eventSocket, _ := cmd.Flags().GetString("events")
+		auditLog, _ := cmd.Flags().GetString("audit-log")
+		outputDir, _ := cmd.Flags().GetString("output-dir")
+		notifyDone, _ := cmd.Flags().GetBool("notify")
+		safe, _ := cmd.Flags().GetBool("safe")
+		failFast, _ := cmd.Flags().GetBool("fail-fast")
+		assumeWritable, _ := cmd.Flags().GetBool("assume-bin-dir-writable")
+		dirFromGoEnv, _ := cmd.Flags().GetBool("dir-from-go-env")
+		goVersion, _ := cmd.Flags().GetString("go-version")
+
+		settings, err := loadSettings()
+		if err != nil {
+			return err
+		}
+
+		dryRun, err := resolveDryRun(cmd.Flags(), settings)
+		if err != nil {
+			return err
+		}
+
+		exitCode, err := resolveExitCode(cmd.Flags(), dryRun)
+		if err != nil {
+			return err
+		}
+
+		symbols, err := resolveSymbols(cmd.Flags(), settings)
+		if err != nil {
+			return err
+		}
+
+		allow, err := resolveAllow(cmd.Flags(), settings)
+		if err != nil {
+			return err
+		}
+
+		throttle, err := throttleFlag(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		names := make([]string, 0, len(args))
+		for _, arg := range args {
+			names = append(names, cli.BinaryNameFromPackage(arg))
+		}
+
+		config := cli.Config{
+			Verbose:        verbose,
+			Goroot:         goroot,
+			LogLevel:       logLevel,
+			LogSink:        logSink,
+			DryRun:         dryRun,
+			ExitCode:       exitCode,
+			EventSocket:    eventSocket,
+			AuditLog:       auditLog,
+			OutputDir:      outputDir,
+			Notify:         notifyDone,
+			Safe:           safe,
+			FailFast:       failFast,
+			AssumeWritable: assumeWritable,
+			DirFromGoEnv:   dirFromGoEnv,
+			GoVersion:      goVersion,
+			Symbols:        symbols,
+			Allow:          allow,
+			Throttle:       throttle,
+		}
+
+		return silenceChangesPending(cmd, runDirect(cmd.OutOrStdout(), config, names))
+	},
+}
+
+// init registers the uninstall subcommand and its flags.
+func init() {
+	addLogFlags(uninstallCmd.Flags())
+	addTargetFlags(uninstallCmd.Flags())
+	addRemovalFlags(uninstallCmd.Flags())
+	addRecordFlags(uninstallCmd.Flags())
+
+	rootCmd.AddCommand(uninstallCmd)
+}
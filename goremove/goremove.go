@@ -0,0 +1,132 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+// Package goremove lets other Go programs remove installed Go binaries the
+// way the go-remove command does, without depending on its internal packages.
+//
+// Usage:
+//
+//	result, err := goremove.Remove(goremove.Options{Binary: "vhs"})
+//	if errors.Is(err, goremove.ErrBinaryNotFound) {
+//		// Nothing named vhs is installed.
+//	}
+//
+// Removals made through this package delete the binary directly; they do not
+// move it to the trash or record it in go-remove's undo history. Nothing is
+// printed: the outcome is returned in Result, and only logs go to stderr.
+package goremove
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
+	"github.com/nicholas-fedor/go-remove/internal/cli"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	"github.com/nicholas-fedor/go-remove/internal/logger"
+)
+
+// Errors returned by Remove, for use with errors.Is.
+var (
+	// ErrNoBinary indicates neither Options.Binary nor Options.Module was set.
+	ErrNoBinary = cli.ErrNoBinary
+
+	// ErrBinaryNotFound indicates the named binary is not in the binary directory.
+	ErrBinaryNotFound = fs.ErrBinaryNotFound
+
+	// ErrOutsideGoRoots indicates Options.Safe refused a directory outside the Go roots.
+	ErrOutsideGoRoots = cli.ErrOutsideGoRoots
+)
+
+// Options selects the binary to remove and how to remove it.
+type Options struct {
+	Binary       string // Binary name, matched exactly unless AllowPrefix is set
+	AllowPrefix  bool   // Let Binary be an unambiguous prefix of the name, as on the command line
+	Module       string // Module or package path whose binary should be removed; replaces Binary
+	Goroot       bool   // Use GOROOT/bin instead of GOBIN or GOPATH/bin
+	DirFromGoEnv bool   // Read GOBIN, GOPATH, and GOROOT from go env instead of the environment
+	DryRun       bool   // Report the removal without deleting anything
+	Safe         bool   // Refuse to remove anything outside GOROOT, GOPATH, GOBIN, or ~/go
+	PruneEmpty   bool   // Remove the binary's directory once empty, unless it is a standard Go directory
+	Verbose      bool   // Log each step at debug level
+}
+
+// Result describes a removed binary.
+type Result struct {
+	Name       string        // Binary file name
+	Path       string        // Full path to the binary
+	Size       int64         // Size in bytes before removal, when measured
+	BytesFreed int64         // Bytes reclaimed; zero for dry runs or when the size is unknown
+	Checksum   string        // SHA-256 of the binary, when computed
+	Module     string        // Module path from the binary's build info, when read
+	Version    string        // Module version from the binary's build info, when read
+	Duration   time.Duration // Time spent deleting the binary; zero for dry runs
+}
+
+// Remove removes the binary selected by opts from the Go binary directory.
+//
+// Parameters:
+//   - opts: The binary to remove and how to remove it
+//
+// Returns:
+//   - The removed binary; with DryRun, the binary that would be removed
+//   - An error if no binary was named, it cannot be found, or removal fails
+func Remove(opts Options) (Result, error) {
+	log, err := logger.NewLogger()
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to initialize logger: %w", err)
+	}
+
+	if opts.Verbose {
+		log.Level(zerolog.DebugLevel)
+	}
+
+	filesystem := fs.NewRealFS()
+	if opts.DirFromGoEnv {
+		filesystem = fs.NewRealFSFromGoEnv()
+	}
+
+	// The caller reports the outcome from Result, so progress messages are dropped.
+	deps := cli.Dependencies{FS: filesystem, Logger: log, Output: io.Discard}
+
+	// Module lookups read each binary's module path from its build info.
+	if opts.Module != "" {
+		extractor, err := buildinfo.NewExtractor()
+		if err != nil {
+			return Result{}, fmt.Errorf("initializing build info extractor: %w", err)
+		}
+
+		deps.Extractor = extractor
+	}
+
+	removal, err := cli.Remove(deps, cli.Config{
+		Binary:       opts.Binary,
+		ExactName:    !opts.AllowPrefix,
+		Module:       opts.Module,
+		Goroot:       opts.Goroot,
+		DirFromGoEnv: opts.DirFromGoEnv,
+		DryRun:       opts.DryRun,
+		Safe:         opts.Safe,
+		PruneEmpty:   opts.PruneEmpty,
+		Verbose:      opts.Verbose,
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		Name:       removal.Name,
+		Path:       removal.Path,
+		Size:       removal.Size,
+		BytesFreed: removal.BytesFreed,
+		Checksum:   removal.Checksum,
+		Module:     removal.Module,
+		Version:    removal.Version,
+		Duration:   time.Duration(removal.DurationMs * float64(time.Millisecond)),
+	}, nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package goremove
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// binaryName returns name as it is installed on the current platform.
+func binaryName(name string) string {
+	if runtime.GOOS == "windows" {
+		return name + ".exe"
+	}
+
+	return name
+}
+
+// TestRemove verifies a binary in GOBIN is removed and described in the result.
+func TestRemove(t *testing.T) {
+	binDir := t.TempDir()
+	t.Setenv("GOBIN", binDir)
+
+	binaryPath := filepath.Join(binDir, binaryName("vhs"))
+	if err := os.WriteFile(binaryPath, []byte("binary"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Remove(Options{Binary: "vhs"})
+	if err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+
+	if result.Path != binaryPath || result.Size != 6 || result.BytesFreed != 6 {
+		t.Errorf("Remove() = %+v, want path %s and 6 bytes freed", result, binaryPath)
+	}
+
+	if _, err := os.Stat(binaryPath); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("binary still exists after Remove(): %v", err)
+	}
+}
+
+// TestRemove_DryRun verifies a dry run leaves the binary in place.
+func TestRemove_DryRun(t *testing.T) {
+	binDir := t.TempDir()
+	t.Setenv("GOBIN", binDir)
+
+	binaryPath := filepath.Join(binDir, binaryName("vhs"))
+	if err := os.WriteFile(binaryPath, []byte("binary"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Remove(Options{Binary: "vhs", DryRun: true})
+	if err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+
+	if result.BytesFreed != 0 {
+		t.Errorf("Remove() BytesFreed = %d, want 0 for a dry run", result.BytesFreed)
+	}
+
+	if _, err := os.Stat(binaryPath); err != nil {
+		t.Errorf("binary removed during a dry run: %v", err)
+	}
+}
+
+// TestRemove_Missing verifies a missing binary and an empty request are
+// reported with the exported errors.
+func TestRemove_Missing(t *testing.T) {
+	t.Setenv("GOBIN", t.TempDir())
+
+	if _, err := Remove(Options{Binary: "vhs"}); !errors.Is(err, ErrBinaryNotFound) {
+		t.Errorf("Remove() error = %v, want %v", err, ErrBinaryNotFound)
+	}
+
+	if _, err := Remove(Options{}); !errors.Is(err, ErrNoBinary) {
+		t.Errorf("Remove() error = %v, want %v", err, ErrNoBinary)
+	}
+}
+
+// TestRemove_Prefix verifies a prefix is only expanded with AllowPrefix, and
+// that nothing is printed to stdout either way.
+func TestRemove_Prefix(t *testing.T) {
+	binDir := t.TempDir()
+	t.Setenv("GOBIN", binDir)
+
+	binaryPath := filepath.Join(binDir, binaryName("toolbox"))
+	if err := os.WriteFile(binaryPath, []byte("binary"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := os.Stdout
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	os.Stdout = writer
+
+	t.Cleanup(func() { os.Stdout = stdout })
+
+	_, exactErr := Remove(Options{Binary: "tool"})
+	result, prefixErr := Remove(Options{Binary: "tool", AllowPrefix: true})
+
+	os.Stdout = stdout
+
+	_ = writer.Close()
+
+	printed, _ := io.ReadAll(reader)
+
+	if !errors.Is(exactErr, ErrBinaryNotFound) {
+		t.Errorf("Remove() error = %v, want %v without AllowPrefix", exactErr, ErrBinaryNotFound)
+	}
+
+	if prefixErr != nil || result.Path != binaryPath {
+		t.Errorf("Remove() = %+v, %v, want %s removed with AllowPrefix", result, prefixErr, binaryPath)
+	}
+
+	if len(printed) > 0 {
+		t.Errorf("Remove() printed %q, want nothing on stdout", printed)
+	}
+}
@@ -0,0 +1,255 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+// Package audit keeps an append-only record of removals for compliance.
+//
+// Each removal is written as one timestamped line naming who removed what and
+// whether it succeeded. Once the log reaches its size limit it is rotated:
+// the current file becomes path.1, path.1 becomes path.2, and so on, with the
+// oldest file beyond the retention count deleted.
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Rotation defaults used when opening an audit log.
+const (
+	DefaultMaxSize = 1 << 20 // Bytes a log file may reach before it is rotated
+	DefaultKeep    = 3       // Rotated files kept alongside the current log
+	fileMode       = 0o600   // Audit logs may name private paths; keep them owner-only
+)
+
+// Results recorded for a removal.
+const (
+	ResultRemoved     = "removed"      // The binary was deleted
+	ResultWouldRemove = "would remove" // Dry run; nothing was deleted
+	ResultFailed      = "failed"       // The removal failed; the error follows
+)
+
+// ErrInvalidMaxSize indicates an audit log was opened without a positive size limit.
+var ErrInvalidMaxSize = errors.New("audit log size limit must be positive")
+
+// Entry describes one removal attempt.
+type Entry struct {
+	Time   time.Time // When the removal happened; zero uses the current time
+	Name   string    // Binary name
+	Path   string    // Full path of the binary
+	DryRun bool      // Whether the removal was only simulated
+	Err    error     // Why the removal failed; nil on success
+}
+
+// Recorder records removal attempts.
+type Recorder interface {
+	Record(entry Entry) error
+}
+
+// Log is a Recorder appending entries to a size-rotated file.
+type Log struct {
+	mu      sync.Mutex
+	path    string
+	maxSize int64
+	keep    int
+	user    string
+	file    *os.File
+	size    int64
+}
+
+// Open opens the audit log at path for appending, creating it if needed.
+//
+// Parameters:
+//   - path: File the audit trail is appended to
+//   - maxSize: Size in bytes at which the file is rotated
+//   - keep: Number of rotated files to retain; 0 discards the old log on rotation
+//
+// Returns:
+//   - The opened audit log
+//   - An error if maxSize is not positive or the file cannot be opened
+func Open(path string, maxSize int64, keep int) (*Log, error) {
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMaxSize, maxSize)
+	}
+
+	log := &Log{
+		path:    path,
+		maxSize: maxSize,
+		keep:    max(keep, 0),
+		user:    currentUser(),
+	}
+
+	if err := log.open(); err != nil {
+		return nil, err
+	}
+
+	return log, nil
+}
+
+// Record appends entry as a single line, rotating the file first if the line
+// would push it past the size limit. A failed rotation is reported, but the
+// entry is still appended to the current log so no removal goes unrecorded.
+func (l *Log) Record(entry Entry) error {
+	line := l.format(entry)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var rotateErr error
+	if l.size > 0 && l.size+int64(len(line)) > l.maxSize {
+		rotateErr = l.rotate()
+	}
+
+	// A rotation that could not reopen the log leaves no file; try again here.
+	if l.file == nil {
+		if err := l.open(); err != nil {
+			return errors.Join(rotateErr, err)
+		}
+	}
+
+	written, err := l.file.WriteString(line)
+	l.size += int64(written)
+
+	if err != nil {
+		return errors.Join(rotateErr, fmt.Errorf("failed to write audit log %s: %w", l.path, err))
+	}
+
+	return rotateErr
+}
+
+// Close closes the current log file.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit log %s: %w", l.path, err)
+	}
+
+	l.file = nil
+
+	return nil
+}
+
+// format renders entry as a line of space-separated key=value fields, quoting
+// values that may contain spaces, e.g.
+// `2026-10-16T09:30:00Z user="nick" action=remove name="age" path="/home/nick/go/bin/age" result="removed"`.
+func (l *Log) format(entry Entry) string {
+	when := entry.Time
+	if when.IsZero() {
+		when = time.Now()
+	}
+
+	result := ResultRemoved
+
+	switch {
+	case entry.Err != nil:
+		result = ResultFailed + ": " + entry.Err.Error()
+	case entry.DryRun:
+		result = ResultWouldRemove
+	}
+
+	return fmt.Sprintf(
+		"%s user=%s action=remove name=%s path=%s result=%s\n",
+		when.UTC().Format(time.RFC3339),
+		strconv.Quote(l.user),
+		strconv.Quote(entry.Name),
+		strconv.Quote(entry.Path),
+		strconv.Quote(result),
+	)
+}
+
+// open opens the log file for appending and records its current size.
+func (l *Log) open() error {
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, fileMode)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log %s: %w", l.path, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return fmt.Errorf("failed to stat audit log %s: %w", l.path, err)
+	}
+
+	l.file = file
+	l.size = info.Size()
+
+	return nil
+}
+
+// rotate shifts each retained file up one generation, moves the current log
+// to path.1, and reopens an empty log. If a file cannot be shifted, the
+// current log is reopened in place so later entries still reach it.
+func (l *Log) rotate() error {
+	closeErr := l.file.Close()
+	l.file = nil
+
+	if closeErr != nil {
+		return fmt.Errorf("failed to close audit log %s: %w", l.path, closeErr)
+	}
+
+	if err := l.shift(); err != nil {
+		return errors.Join(fmt.Errorf("failed to rotate audit log %s: %w", l.path, err), l.open())
+	}
+
+	return l.open()
+}
+
+// shift moves the current log to path.1 and each retained generation up one,
+// dropping the oldest, or deletes the current log when none are kept.
+func (l *Log) shift() error {
+	if l.keep == 0 {
+		if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	// The oldest generation falls off the end.
+	if err := os.Remove(l.rotated(l.keep)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	for generation := l.keep - 1; generation >= 1; generation-- {
+		err := os.Rename(l.rotated(generation), l.rotated(generation+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return os.Rename(l.path, l.rotated(1))
+}
+
+// rotated returns the path of the given rotated generation, e.g. path.1.
+func (l *Log) rotated(generation int) string {
+	return l.path + "." + strconv.Itoa(generation)
+}
+
+// currentUser returns the name of the user running go-remove, falling back to
+// the environment when the account database cannot be read.
+func currentUser() string {
+	if account, err := user.Current(); err == nil && account.Username != "" {
+		return account.Username
+	}
+
+	for _, key := range []string{"USER", "USERNAME"} {
+		if name := os.Getenv(key); name != "" {
+			return name
+		}
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,197 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package audit
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// readLines returns the lines of the file at path, or nil if it does not exist.
+func readLines(t *testing.T, path string) []string {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", path, err)
+	}
+
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+}
+
+// TestLog_Record verifies entries are appended one per line, across reopens,
+// with the result of each removal.
+func TestLog_Record(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	when := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+
+	log, err := Open(path, DefaultMaxSize, DefaultKeep)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	log.user = "nick"
+
+	entries := []Entry{
+		{Time: when, Name: "age", Path: "/bin/age"},
+		{Time: when, Name: "my tool", Path: "/bin/my tool", DryRun: true},
+	}
+	for _, entry := range entries {
+		if err := log.Record(entry); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	if err := log.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// Reopening appends rather than truncating.
+	log, err = Open(path, DefaultMaxSize, DefaultKeep)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	log.user = "nick"
+
+	if err := log.Record(Entry{Time: when, Name: "vhs", Path: "/bin/vhs", Err: errors.New("permission denied")}); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	if err := log.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	want := []string{
+		`2026-10-16T09:30:00Z user="nick" action=remove name="age" path="/bin/age" result="removed"`,
+		`2026-10-16T09:30:00Z user="nick" action=remove name="my tool" path="/bin/my tool" result="would remove"`,
+		`2026-10-16T09:30:00Z user="nick" action=remove name="vhs" path="/bin/vhs" result="failed: permission denied"`,
+	}
+
+	got := readLines(t, path)
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("audit log =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// TestLog_Rotation verifies the log rotates before a line would exceed the
+// size limit and that only the configured number of rotated files is kept.
+func TestLog_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	entry := Entry{Time: time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC), Name: "age", Path: "/bin/age"}
+
+	log, err := Open(path, 1, 2)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	log.user = "nick"
+	lineSize := int64(len(log.format(entry)))
+
+	// Two lines fit exactly; the third starts a new file.
+	log.maxSize = 2 * lineSize
+
+	for range 7 {
+		if err := log.Record(entry); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	if err := log.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// Seven lines fill four files of 2, 2, 2, and 1; the oldest is dropped.
+	for file, want := range map[string]int{
+		path:        1,
+		path + ".1": 2,
+		path + ".2": 2,
+		path + ".3": 0,
+	} {
+		if got := len(readLines(t, file)); got != want {
+			t.Errorf("%s has %d lines, want %d", filepath.Base(file), got, want)
+		}
+	}
+}
+
+// TestLog_RotationKeepNone verifies a retention count of zero discards the old log.
+func TestLog_RotationKeepNone(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	log, err := Open(path, 1, 0)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	for _, name := range []string{"age", "vhs"} {
+		if err := log.Record(Entry{Name: name, Path: "/bin/" + name}); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	if err := log.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	got := readLines(t, path)
+	if len(got) != 1 || !strings.Contains(got[0], `name="vhs"`) {
+		t.Errorf("audit log = %q, want only the vhs entry", got)
+	}
+
+	if _, err := os.Stat(path + ".1"); !os.IsNotExist(err) {
+		t.Errorf("rotated file exists with keep 0, stat error = %v", err)
+	}
+}
+
+// TestLog_RotationFailure verifies a rotation that fails is reported but
+// leaves the log open, so the entry and later ones are still appended.
+func TestLog_RotationFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	// A non-empty directory where the oldest generation goes cannot be removed.
+	if err := os.MkdirAll(filepath.Join(path+".1", "keep"), 0o700); err != nil {
+		t.Fatalf("failed to create blocking directory: %v", err)
+	}
+
+	log, err := Open(path, 1, 1)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	if err := log.Record(Entry{Name: "age", Path: "/bin/age"}); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	for _, name := range []string{"vhs", "gum"} {
+		if err := log.Record(Entry{Name: name, Path: "/bin/" + name}); err == nil {
+			t.Errorf("Record(%s) error = nil, want a rotation error", name)
+		}
+	}
+
+	if err := log.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if got := readLines(t, path); len(got) != 3 {
+		t.Errorf("audit log has %d lines, want all 3 entries", len(got))
+	}
+}
+
+// TestOpen_InvalidMaxSize verifies a non-positive size limit is rejected.
+func TestOpen_InvalidMaxSize(t *testing.T) {
+	_, err := Open(filepath.Join(t.TempDir(), "audit.log"), 0, DefaultKeep)
+	if !errors.Is(err, ErrInvalidMaxSize) {
+		t.Errorf("Open() error = %v, want %v", err, ErrInvalidMaxSize)
+	}
+}
@@ -53,6 +53,9 @@ type Extractor interface {
 
 // BuildInfoData holds structured build information extracted from a Go binary.
 type BuildInfoData struct {
+	// PackagePath is the main package import path (e.g., "github.com/user/repo/cmd/tool").
+	PackagePath string `json:"package_path"`
+
 	// ModulePath is the Go module path (e.g., "github.com/user/repo").
 	ModulePath string `json:"module_path"`
 
@@ -120,8 +123,9 @@ func (e *DefaultExtractor) Extract(ctx context.Context, binaryPath string) (*Bui
 
 	// Build the structured data
 	data := &BuildInfoData{
-		GoVersion: info.GoVersion,
-		Settings:  make(map[string]string),
+		PackagePath: info.Path,
+		GoVersion:   info.GoVersion,
+		Settings:    make(map[string]string),
 	}
 
 	// Extract main module information
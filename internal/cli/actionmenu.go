@@ -0,0 +1,164 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/nicholas-fedor/go-remove/internal/format"
+)
+
+// Actions offered by the per-binary action menu, in display order.
+const (
+	actionRemove   = "Remove"    // Remove the binary
+	actionInspect  = "Inspect"   // Show the binary's path and size
+	actionCopyPath = "Copy path" // Copy the binary's path to the clipboard
+	actionCancel   = "Cancel"    // Close the menu
+)
+
+// menuActions lists the actions in the order they are shown.
+var menuActions = []string{actionRemove, actionInspect, actionCopyPath, actionCancel}
+
+// actionMenu is the modal menu of operations for a single binary.
+type actionMenu struct {
+	binary  string   // Binary the actions apply to
+	cursor  int      // Index of the highlighted action
+	details []string // Lines shown after Inspect; empty until requested
+}
+
+// openActionMenu opens the action menu for the binary under the cursor.
+func (m *model) openActionMenu() {
+	if name, ok := m.currentChoice(); ok {
+		m.menu = &actionMenu{binary: name}
+	}
+}
+
+// updateActionMenu processes key events while the action menu is open.
+func (m *model) updateActionMenu(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc", "q":
+		m.menu = nil
+
+	case "up", "k":
+		if m.menu.cursor > 0 {
+			m.menu.cursor--
+		}
+
+	case "down", "j":
+		if m.menu.cursor < len(menuActions)-1 {
+			m.menu.cursor++
+		}
+
+	case "enter":
+		return m.runMenuAction(menuActions[m.menu.cursor])
+	}
+
+	return m, nil
+}
+
+// runMenuAction performs action on the menu's binary.
+func (m *model) runMenuAction(action string) (tea.Model, tea.Cmd) {
+	name := m.menu.binary
+
+	switch action {
+	case actionRemove:
+		// removalTargets returns the menu's binary while the menu is open, so a
+		// pending bundle confirmation keeps the menu until it is answered.
+		model, cmd := m.handleRemove()
+		if m.confirmation == confirmNone {
+			m.menu = nil
+		}
+
+		return model, cmd
+
+	case actionInspect:
+		m.menu.details = m.inspectBinary(m.fs.AdjustBinaryPath(m.dir, name))
+
+		return m, nil
+
+	case actionCopyPath:
+		m.menu = nil
+		m.status = "Copied path of " + name
+
+		return m, tea.SetClipboard(m.fs.AdjustBinaryPath(m.dir, name))
+	}
+
+	m.menu = nil
+
+	return m, nil
+}
+
+// inspectBinary describes the binary at binaryPath for the Inspect action:
+// its path, size, and executable format.
+func (m *model) inspectBinary(binaryPath string) []string {
+	size := "unknown"
+	if bytes, err := m.fs.BinarySize(binaryPath); err == nil {
+		size = format.Bytes(bytes)
+	}
+
+	kind, err := m.fs.DescribeBinary(binaryPath)
+	if err != nil {
+		kind = "unknown"
+	}
+
+	return []string{
+		"Path: " + binaryPath,
+		"Size: " + size,
+		"Type: " + kind,
+	}
+}
+
+// viewActionMenu renders the action menu for the menu's binary.
+func (m *model) viewActionMenu() tea.View {
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(m.styles.TitleColor))
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.CursorColor))
+	footerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.FooterColor))
+	detailStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.StatusColor))
+
+	prefixWidth := m.prefixWidth()
+
+	var s strings.Builder
+
+	s.WriteString(titleStyle.Render(fmt.Sprintf("Actions for %s:", m.menu.binary)))
+	s.WriteString("\n\n")
+
+	for i, action := range menuActions {
+		prefix := strings.Repeat(" ", prefixWidth)
+		if i == m.menu.cursor {
+			prefix = cursorStyle.Render(padRight(m.styles.Cursor, prefixWidth))
+		}
+
+		s.WriteString(prefix + action + "\n")
+	}
+
+	if len(m.menu.details) > 0 {
+		s.WriteString("\n")
+
+		for _, line := range m.menu.details {
+			s.WriteString(detailStyle.Render(line))
+			s.WriteString("\n")
+		}
+	}
+
+	s.WriteString("\n")
+	s.WriteString(footerStyle.Render("↑↓/jk: move  Enter: choose  Esc: close"))
+	s.WriteString("\n")
+
+	content := lipgloss.NewStyle().
+		PaddingLeft(leftPadding).
+		Width(m.width - leftPadding).
+		Render(s.String())
+
+	return m.newView(content)
+}
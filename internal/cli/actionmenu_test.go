@@ -0,0 +1,127 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// newMenuModel creates a model with three binaries and the cursor on "gopls".
+func newMenuModel(filesystem fs.FS) *model {
+	return &model{
+		choices:  []string{"age", "gopls", "vhs"},
+		selected: map[string]bool{},
+		dir:      "/bin",
+		fs:       filesystem,
+		logger:   &tuiMockLogger{},
+		styles:   defaultStyleConfig(),
+		cursorY:  1,
+		cols:     1,
+		rows:     3,
+		width:    80,
+		height:   24,
+	}
+}
+
+// Test_model_ActionMenu_Remove verifies the menu removes only its own binary,
+// leaving an existing selection untouched.
+func Test_model_ActionMenu_Remove(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t)
+	fsMock.On("AdjustBinaryPath", "/bin", "gopls").Return("/bin/gopls")
+	fsMock.On("BinarySize", "/bin/gopls").Return(int64(1500), nil)
+	fsMock.On("RemoveBinary", "/bin/gopls", "gopls", false, mock.Anything).Return(nil)
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"age", "vhs"})
+
+	m := newMenuModel(fsMock)
+	m.selected["vhs"] = true
+
+	m.Update(keyPress('m'))
+
+	if assert.NotNil(t, m.menu) {
+		assert.Equal(t, "gopls", m.menu.binary)
+		assert.Contains(t, stripANSI(m.View().Content), "Actions for gopls:")
+	}
+
+	m.Update(keyPressString(keyEnter))
+
+	assert.Nil(t, m.menu)
+	assert.Equal(t, "Removed gopls", m.status)
+	assert.Equal(t, map[string]bool{"vhs": true}, m.selected)
+}
+
+// Test_model_ActionMenu_Inspect verifies Inspect shows the path, size, and type
+// while keeping the menu open.
+func Test_model_ActionMenu_Inspect(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t)
+	fsMock.On("AdjustBinaryPath", "/bin", "gopls").Return("/bin/gopls")
+	fsMock.On("BinarySize", "/bin/gopls").Return(int64(30_000_000), nil)
+	fsMock.On("DescribeBinary", "/bin/gopls").Return("ELF 64-bit executable, amd64", nil)
+
+	m := newMenuModel(fsMock)
+
+	m.Update(keyPress('m'))
+	m.Update(keyPress('j'))
+	m.Update(keyPressString(keyEnter))
+
+	if assert.NotNil(t, m.menu) {
+		assert.Equal(t, []string{
+			"Path: /bin/gopls",
+			"Size: 30.0 MB",
+			"Type: ELF 64-bit executable, amd64",
+		}, m.menu.details)
+		assert.Contains(t, stripANSI(m.View().Content), "Type: ELF 64-bit executable, amd64")
+	}
+}
+
+// Test_model_ActionMenu_CopyPath verifies Copy path closes the menu and
+// returns a clipboard command.
+func Test_model_ActionMenu_CopyPath(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t)
+	fsMock.On("AdjustBinaryPath", "/bin", "gopls").Return("/bin/gopls")
+
+	m := newMenuModel(fsMock)
+
+	m.Update(keyPress('m'))
+	m.Update(keyPress('j'))
+	m.Update(keyPress('j'))
+
+	_, cmd := m.Update(keyPressString(keyEnter))
+
+	assert.Nil(t, m.menu)
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "Copied path of gopls", m.status)
+}
+
+// Test_model_ActionMenu_Close verifies Esc and the Cancel action close the
+// menu without touching the filesystem.
+func Test_model_ActionMenu_Close(t *testing.T) {
+	m := newMenuModel(mockFS.NewMockFS(t))
+
+	m.Update(keyPress('m'))
+	m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	assert.Nil(t, m.menu)
+
+	m.Update(keyPress('m'))
+
+	// Moving past the last action stays on Cancel.
+	for range len(menuActions) + 1 {
+		m.Update(keyPress('j'))
+	}
+
+	assert.Equal(t, len(menuActions)-1, m.menu.cursor)
+
+	// Cancel does not look up the binary, so the mock needs no expectations.
+	m.Update(keyPressString(keyEnter))
+	assert.Nil(t, m.menu)
+}
@@ -0,0 +1,27 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotAllowed indicates a binary's name does not match the removal allowlist.
+var ErrNotAllowed = errors.New("not in the allowed set")
+
+// checkAllowed returns ErrNotAllowed, e.g. "vhs is not in the allowed set",
+// unless name matches every pattern in config.Allow. No patterns allow
+// everything.
+func checkAllowed(config Config, name string) error {
+	for _, pattern := range config.Allow {
+		if !pattern.MatchString(name) {
+			return fmt.Errorf("%s is %w", name, ErrNotAllowed)
+		}
+	}
+
+	return nil
+}
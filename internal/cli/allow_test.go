@@ -0,0 +1,67 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// Test_checkAllowed verifies a name must match every allowlist pattern, and
+// that no patterns allow everything.
+func Test_checkAllowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		allow   []string
+		binary  string
+		wantErr bool
+	}{
+		{name: "no allowlist", binary: "vhs"},
+		{name: "matches", allow: []string{"^go"}, binary: "gopls"},
+		{name: "does not match", allow: []string{"^go"}, binary: "vhs", wantErr: true},
+		{name: "matches only one pattern", allow: []string{"^go", "lint$"}, binary: "gopls", wantErr: true},
+		{name: "matches both patterns", allow: []string{"^go", "lint$"}, binary: "golangci-lint"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var config Config
+			for _, pattern := range tt.allow {
+				config.Allow = append(config.Allow, regexp.MustCompile(pattern))
+			}
+
+			err := checkAllowed(config, tt.binary)
+			if tt.wantErr != errors.Is(err, ErrNotAllowed) {
+				t.Errorf("checkAllowed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestRun_NotAllowed verifies a binary outside the allowlist is refused
+// before anything is measured or removed.
+func TestRun_NotAllowed(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", mock.Anything).Return([]string{"vhs"})
+	filesystem.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+
+	captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	err := Run(deps, Config{Binary: "vhs", Allow: []*regexp.Regexp{regexp.MustCompile("^go")}})
+
+	require.ErrorIs(t, err, ErrNotAllowed)
+	assert.EqualError(t, err, "vhs is not in the allowed set")
+	filesystem.AssertNotCalled(t, "RemoveBinary", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,39 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"github.com/nicholas-fedor/go-remove/internal/audit"
+)
+
+// recordAudit appends the outcome of removing config.Binary to the audit
+// trail, if one is configured. An audit write that fails is logged as a
+// warning; it never undoes or interrupts the removal itself.
+func recordAudit(deps Dependencies, config Config, removal Removal, err error) {
+	if deps.Audit == nil {
+		return
+	}
+
+	entry := audit.Entry{
+		Name:   config.Binary,
+		Path:   removal.Path,
+		DryRun: config.DryRun,
+		Err:    err,
+	}
+
+	if auditErr := deps.Audit.Record(entry); auditErr != nil {
+		deps.Logger.Warn().Err(auditErr).Msg("Failed to write audit log")
+	}
+}
+
+// auditChoice appends the outcome of removing name from the TUI to the audit
+// trail, if one is configured.
+func (m *model) auditChoice(name, binaryPath string, err error) {
+	deps := Dependencies{Logger: m.logger, Audit: m.audit}
+	config := Config{Binary: name, DryRun: m.config.DryRun}
+
+	recordAudit(deps, config, Removal{Path: binaryPath}, err)
+}
@@ -0,0 +1,460 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nicholas-fedor/go-remove/internal/format"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+)
+
+// removalTiming records how long a single binary took to remove.
+type removalTiming struct {
+	Name    string        // Binary name
+	Elapsed time.Duration // Time spent removing the binary
+}
+
+// batchFailure records a binary a batch could not remove.
+type batchFailure struct {
+	Name    string  // Binary name
+	Err     error   // Why the removal failed
+	Removal Removal // What the removal returned with Err, such as its path and timing
+}
+
+// RunBatch removes each of the named binaries in turn.
+//
+// A failure to remove one binary does not stop the batch; all failures are
+// joined into the returned error. With config.FailFast, the batch instead
+// stops at the first failure and returns it, leaving later binaries untouched.
+// When config.Stats is set, an aggregate timing trailer is printed after the
+// batch completes. When config.Interactive is set, each binary is confirmed
+// before removal. When config.Throttle is set, the batch pauses that long
+// between removals. Canceling ctx stops the batch before its next removal;
+// the config.Report file still lists what finished and is marked interrupted.
+func RunBatch(ctx context.Context, deps Dependencies, config Config, names []string) error {
+	var binDir string
+
+	// Determine the binary directory unless the names are literal paths.
+	if !config.PathMode {
+		dir, err := deps.FS.DetermineBinDir(config.Goroot)
+		if err != nil {
+			_ = deps.Logger.Sync() // Flush logs; errors are ignored
+
+			return fmt.Errorf("failed to determine binary directory: %w", err)
+		}
+
+		if err := checkSafeDir(config, dir); err != nil {
+			_ = deps.Logger.Sync()
+
+			return err
+		}
+
+		binDir = dir
+	}
+
+	return runBatch(ctx, deps, binDir, config, names)
+}
+
+// RunAll removes every binary in the target directory as a batch.
+//
+// The same listing rules as the TUI apply: hidden files and app bundles are
+// included only when config.ShowHidden or config.IncludeBundles is set. A
+// directory with nothing to remove is reported and is not an error. Unless
+// config.Yes, config.DryRun, or config.Interactive is set, the batch waits for
+// a confirmation that names the count and total size to be freed.
+func RunAll(ctx context.Context, deps Dependencies, config Config) error {
+	binDir, err := deps.FS.DetermineBinDir(config.Goroot)
+	if err != nil {
+		_ = deps.Logger.Sync() // Flush logs; errors are ignored
+
+		return fmt.Errorf("failed to determine binary directory: %w", err)
+	}
+
+	if err := checkSafeDir(config, binDir); err != nil {
+		_ = deps.Logger.Sync()
+
+		return err
+	}
+
+	names := deps.FS.ListBinaries(binDir, fs.ListOptions{
+		ShowHidden:           config.ShowHidden,
+		IncludeBundles:       config.IncludeBundles,
+		IncludeNonExecutable: config.IncludeNonExecutable,
+		Match:                config.Match,
+		MinSize:              config.MinSize,
+		MaxSize:              config.MaxSize,
+		OlderThan:            config.OlderThan,
+		NewerThan:            config.NewerThan,
+	})
+
+	if config.KeepRunning {
+		names, err = skipRunning(deps, binDir, names)
+		if err != nil {
+			_ = deps.Logger.Sync()
+
+			return err
+		}
+	}
+
+	if config.BuiltWith != "" {
+		names, err = selectBuiltWith(ctx, deps, binDir, config, names)
+		if err != nil {
+			_ = deps.Logger.Sync()
+
+			return err
+		}
+	}
+
+	if config.SinceInstall != "" {
+		names, err = selectSinceInstall(ctx, deps, binDir, config, names)
+		if err != nil {
+			_ = deps.Logger.Sync()
+
+			return err
+		}
+	}
+
+	// An empty directory is nothing to do rather than an error, so repeated
+	// runs succeed.
+	if len(names) == 0 {
+		fmt.Fprintln(deps.output(), "No binaries to remove in "+binDir)
+
+		_ = deps.Logger.Sync() // Errors are ignored
+
+		return nil
+	}
+
+	// Interactive mode confirms each binary instead, and a dry run deletes nothing.
+	if !config.Yes && !config.DryRun && !config.Interactive {
+		confirmed, err := confirm(allPrompt(deps.FS, binDir, names), false, deps.input(), deps.output())
+		if err != nil {
+			_ = deps.Logger.Sync()
+
+			return err
+		}
+
+		if !confirmed {
+			_ = deps.Logger.Sync()
+
+			return fmt.Errorf("%w: removal of all binaries in %s", ErrRemovalDeclined, binDir)
+		}
+	}
+
+	return runBatch(ctx, deps, binDir, config, names)
+}
+
+// allPrompt asks to confirm removing every one of names from binDir, e.g.
+// "This will remove 57 binaries and free 1.2 GB. Continue?".
+// Binaries whose size cannot be read count as zero bytes.
+func allPrompt(filesystem fs.FS, binDir string, names []string) string {
+	entries := make([]ListEntry, 0, len(names))
+
+	for _, name := range names {
+		size, _ := filesystem.BinarySize(filesystem.AdjustBinaryPath(binDir, name))
+		entries = append(entries, ListEntry{Name: name, Size: size})
+	}
+
+	noun := "binaries"
+	if len(names) == 1 {
+		noun = "binary"
+	}
+
+	return fmt.Sprintf(
+		"This will remove %d %s and free %s. Continue?",
+		len(names), noun, format.Bytes(totalSize(entries)),
+	)
+}
+
+// runBatch removes names from binDir, prompting for each one in interactive
+// mode and pausing between removals when throttled.
+func runBatch(ctx context.Context, deps Dependencies, binDir string, config Config, names []string) error {
+	log := deps.Logger
+
+	if config.SkipParent {
+		kept, err := skipParent(deps, binDir, names)
+		if err != nil {
+			_ = log.Sync()
+
+			return err
+		}
+
+		names = kept
+	}
+
+	var (
+		removals    []Removal
+		timings     []removalTiming
+		failures    []batchFailure
+		errs        []error
+		interrupted bool
+	)
+
+	// Snapshot the directory to diff against afterwards. Path mode removes
+	// files from anywhere, so there is no single directory to compare.
+	showDiff := config.ShowDiff && binDir != ""
+
+	var before []string
+	if showDiff {
+		before = diffSnapshot(deps, binDir, config)
+	}
+
+	// Outside interactive mode every binary is confirmed up front.
+	confirmAll := !config.Interactive
+
+	batchStart := time.Now()
+
+	emitStart(deps, config, names)
+
+batch:
+	for _, name := range names {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, fmt.Errorf("batch interrupted: %w", err))
+			interrupted = true
+
+			break
+		}
+
+		if !confirmAll {
+			if config.Describe {
+				describeTarget(deps, binDir, config, name)
+			}
+
+			answer, err := askRemoval(name, deps.input(), deps.output())
+			if err != nil {
+				errs = append(errs, err)
+
+				break
+			}
+
+			switch answer {
+			case answerNo:
+				continue
+			case answerAll:
+				confirmAll = true
+			case answerQuit:
+				break batch
+			case answerYes:
+			}
+		}
+
+		// Pause between removals, but not before the first one.
+		if config.Throttle > 0 && len(removals)+len(failures) > 0 {
+			if config.Verbose {
+				log.Debug().Msgf("Waiting %s before removing %s", config.Throttle, name)
+			}
+
+			if err := deps.sleep()(ctx, config.Throttle); err != nil {
+				errs = append(errs, fmt.Errorf("batch interrupted: %w", err))
+				interrupted = true
+
+				break
+			}
+		}
+
+		config.Binary = name
+
+		start := time.Now()
+		removal, err := removeDirect(deps, binDir, config)
+		elapsed := time.Since(start)
+
+		emitRemoval(deps, config, removal, err)
+		recordAudit(deps, config, removal, err)
+		writeRemovalLog(deps, config, removal, err)
+
+		if config.Verbose {
+			log.Debug().Msgf("Processed %s in %s", name, format.Duration(elapsed))
+		}
+
+		if err != nil {
+			// Failures are listed inline only when a symbol set marks them;
+			// otherwise they are reported once in the joined error.
+			if config.Symbols.Failure != "" {
+				fmt.Fprintln(deps.output(), config.Symbols.failed(err.Error()))
+			}
+
+			failures = append(failures, batchFailure{Name: name, Err: err, Removal: removal})
+			errs = append(errs, err)
+
+			if config.FailFast {
+				break
+			}
+
+			continue
+		}
+
+		removals = append(removals, removal)
+		timings = append(timings, removalTiming{Name: name, Elapsed: elapsed})
+	}
+
+	emitSummary(deps, config, removals, len(errs))
+
+	if len(removals)+len(failures) > 0 {
+		fmt.Fprintln(deps.output(), formatBatchSummary(len(removals), failures, config.DryRun))
+	}
+
+	if len(removals) > 0 {
+		fmt.Fprintln(deps.output(), FormatFreed(removals, config.DryRun))
+	}
+
+	if config.Stats {
+		fmt.Fprint(deps.output(), formatBatchStats(timings, time.Since(batchStart)))
+	}
+
+	// A dry run diffs against the directory as the removals would leave it.
+	if showDiff {
+		after := withoutRemovals(before, removals)
+		if !config.DryRun {
+			after = diffSnapshot(deps, binDir, config)
+		}
+
+		printDiff(deps.output(), diffListings(before, after))
+	}
+
+	if config.Notify {
+		notifyCompletion(deps, removals, len(errs), config.DryRun)
+	}
+
+	// Write the session report if requested. An interrupted batch still
+	// reports what it finished, marked as interrupted.
+	if config.Report != "" {
+		report := Report{DryRun: config.DryRun, Interrupted: interrupted, Removals: removals}
+		for _, failure := range failures {
+			report.Failures = append(report.Failures, newFailure(failure.Name, failure.Removal, failure.Err))
+		}
+
+		if err := WriteReport(config.Report, report); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	_ = log.Sync() // Errors are ignored
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	return changesPending(config, removals)
+}
+
+// formatBatchSummary rolls up a batch's results, e.g.
+// "Removed 5 of 6 binaries (1 failed: age — permission denied)".
+// Binaries declined in interactive mode are not counted.
+func formatBatchSummary(removed int, failures []batchFailure, dryRun bool) string {
+	verb := "Removed"
+	if dryRun {
+		verb = "Would remove"
+	}
+
+	total := removed + len(failures)
+
+	noun := "binaries"
+	if total == 1 {
+		noun = "binary"
+	}
+
+	summary := fmt.Sprintf("%s %d of %d %s", verb, removed, total, noun)
+	if len(failures) == 0 {
+		return summary
+	}
+
+	reasons := make([]string, 0, len(failures))
+	for _, failure := range failures {
+		reasons = append(reasons, failure.Name+" — "+failureCause(failure.Err).Error())
+	}
+
+	return fmt.Sprintf("%s (%d failed: %s)", summary, len(failures), strings.Join(reasons, "; "))
+}
+
+// failureCause strips the "failed to remove binary NAME" context from err,
+// since the summary already names the binary.
+func failureCause(err error) error {
+	if cause := errors.Unwrap(err); cause != nil {
+		return cause
+	}
+
+	return err
+}
+
+// FormatFreed summarizes the space reclaimed by a session's removals,
+// e.g. "Freed 142.0 MB across 5 binaries". Dry runs report "Would free" instead.
+func FormatFreed(removals []Removal, dryRun bool) string {
+	total := freedSize(removals)
+
+	verb := "Freed"
+	if dryRun {
+		verb = "Would free"
+	}
+
+	noun := "binaries"
+	if len(removals) == 1 {
+		noun = "binary"
+	}
+
+	return fmt.Sprintf("%s %s across %d %s", verb, format.Bytes(total), len(removals), noun)
+}
+
+// notifyCompletion sends a desktop notification summarizing the batch.
+// A notification that cannot be shown is logged and never fails the batch.
+func notifyCompletion(deps Dependencies, removals []Removal, failed int, dryRun bool) {
+	if deps.Notifier == nil {
+		return
+	}
+
+	message := FormatFreed(removals, dryRun)
+	if failed > 0 {
+		message += fmt.Sprintf(" (%d failed)", failed)
+	}
+
+	if err := deps.Notifier.Notify("go-remove", message); err != nil {
+		deps.Logger.Warn().Err(err).Msg("Failed to send desktop notification")
+	}
+}
+
+// freedSize sums the sizes recorded for removals.
+func freedSize(removals []Removal) int64 {
+	var total int64
+	for _, removal := range removals {
+		total += removal.Size
+	}
+
+	return total
+}
+
+// formatBatchStats renders the aggregate timing trailer for a batch:
+// total elapsed time, average per-binary removal time, and the slowest binary.
+func formatBatchStats(timings []removalTiming, total time.Duration) string {
+	if len(timings) == 0 {
+		return fmt.Sprintf("Stats: 0 removed in %s\n", format.Duration(total))
+	}
+
+	var sum time.Duration
+
+	slowest := timings[0]
+
+	for _, timing := range timings {
+		sum += timing.Elapsed
+
+		if timing.Elapsed > slowest.Elapsed {
+			slowest = timing
+		}
+	}
+
+	average := sum / time.Duration(len(timings))
+
+	return fmt.Sprintf(
+		"Stats: %d removed in %s\n  average: %s\n  slowest: %s (%s)\n",
+		len(timings),
+		format.Duration(total),
+		format.Duration(average),
+		slowest.Name,
+		format.Duration(slowest.Elapsed),
+	)
+}
@@ -0,0 +1,911 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicholas-fedor/go-remove/internal/audit"
+	"github.com/nicholas-fedor/go-remove/internal/events"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+	mockLogger "github.com/nicholas-fedor/go-remove/internal/logger/mocks"
+	mockNotify "github.com/nicholas-fedor/go-remove/internal/notify/mocks"
+	"github.com/nicholas-fedor/go-remove/internal/proc"
+	mockProc "github.com/nicholas-fedor/go-remove/internal/proc/mocks"
+)
+
+// TestRunBatch verifies every name is attempted, failures are joined, and only
+// successful removals count toward the space freed.
+func TestRunBatch(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+
+	for name, size := range map[string]int64{"age": 1_500_000, "gopls": 30_000_000, "vhs": 2_000_000} {
+		filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+		filesystem.On("BinarySize", "/bin/"+name).Return(size, nil)
+	}
+
+	filesystem.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).Return(nil)
+	filesystem.On("RemoveBinary", "/bin/gopls", "gopls", false, mock.Anything).
+		Return(errors.New("permission denied"))
+	filesystem.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).Return(nil)
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	err := RunBatch(context.Background(), deps, Config{Stats: true}, []string{"age", "gopls", "vhs"})
+	gotOutput := getOutput()
+
+	if err == nil || !strings.Contains(err.Error(), "gopls") {
+		t.Errorf("RunBatch() error = %v, want failure for gopls", err)
+	}
+
+	for _, want := range []string{
+		"Successfully removed age\n",
+		"Successfully removed vhs\n",
+		"Removed 2 of 3 binaries (1 failed: gopls — permission denied)\n",
+		"Freed 3.5 MB across 2 binaries\n",
+		"Stats: 2 removed in ",
+		"  average: ",
+		"  slowest: ",
+	} {
+		if !strings.Contains(gotOutput, want) {
+			t.Errorf("RunBatch() output = %q, want it to contain %q", gotOutput, want)
+		}
+	}
+}
+
+// TestRunBatch_FailFast verifies --fail-fast stops at the first failure and
+// returns it, while a default batch goes on to the remaining binaries.
+func TestRunBatch_FailFast(t *testing.T) {
+	tests := []struct {
+		name      string
+		failFast  bool
+		wantThird bool
+	}{
+		{name: "continue on error", wantThird: true},
+		{name: "fail fast", failFast: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			denied := errors.New("permission denied")
+
+			filesystem := mockFS.NewMockFS(t)
+			filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+
+			for _, name := range []string{"age", "gopls", "vhs"} {
+				filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name).Maybe()
+				filesystem.On("BinarySize", "/bin/"+name).Return(int64(1000), nil).Maybe()
+			}
+
+			filesystem.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).Return(nil)
+			filesystem.On("RemoveBinary", "/bin/gopls", "gopls", false, mock.Anything).Return(denied)
+
+			if tt.wantThird {
+				filesystem.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).Return(nil)
+			}
+
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+			err := RunBatch(context.Background(), deps, Config{FailFast: tt.failFast}, []string{"age", "gopls", "vhs"})
+			getOutput()
+
+			if !errors.Is(err, denied) {
+				t.Errorf("RunBatch() error = %v, want %v", err, denied)
+			}
+
+			if !tt.wantThird {
+				filesystem.AssertNotCalled(t, "RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything)
+			}
+		})
+	}
+}
+
+// TestRunBatch_NoStats verifies the stats trailer is omitted unless requested.
+func TestRunBatch_NoStats(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+	filesystem.On("BinarySize", "/bin/vhs").Return(int64(0), errors.New("stat failed"))
+	filesystem.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).Return(nil)
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	if err := RunBatch(context.Background(), deps, Config{}, []string{"vhs"}); err != nil {
+		t.Fatalf("RunBatch() error = %v", err)
+	}
+
+	if got, want := getOutput(), "Successfully removed vhs\nRemoved 1 of 1 binary\nFreed 0 B across 1 binary\n"; got != want {
+		t.Errorf("RunBatch() output = %q, want %q", got, want)
+	}
+}
+
+// TestRunBatch_ExitCode verifies a dry run with --exit-code reports pending
+// removals as ErrChangesPending, and nothing to remove as success.
+func TestRunBatch_ExitCode(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+	filesystem.On("BinarySize", "/bin/vhs").Return(int64(1000), nil)
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	config := Config{DryRun: true, ExitCode: true}
+
+	err := RunBatch(context.Background(), deps, config, []string{"vhs"})
+	if !errors.Is(err, ErrChangesPending) {
+		t.Errorf("RunBatch() error = %v, want %v", err, ErrChangesPending)
+	}
+
+	if err := RunBatch(context.Background(), deps, config, nil); err != nil {
+		t.Errorf("RunBatch() with nothing to remove error = %v, want nil", err)
+	}
+
+	if got := getOutput(); !strings.Contains(got, "Would remove vhs\n") {
+		t.Errorf("RunBatch() output = %q, want the dry run plan", got)
+	}
+
+	filesystem.AssertNotCalled(t, "RemoveBinary", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestRunBatch_ShowDiff verifies --show-diff lists what a batch removed, using
+// a fresh listing after a real run and the planned removals after a dry run.
+func TestRunBatch_ShowDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		dryRun bool
+	}{
+		{name: "real run"},
+		{name: "dry run", dryRun: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesystem := mockFS.NewMockFS(t)
+			filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+
+			listing := filesystem.On("ListBinaries", "/bin", fs.ListOptions{ShowHidden: true}).
+				Return([]string{"age", "gopls", "vhs"}).Once()
+
+			for _, name := range []string{"age", "vhs"} {
+				filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+				filesystem.On("BinarySize", "/bin/"+name).Return(int64(1000), nil)
+
+				if !tt.dryRun {
+					filesystem.On("RemoveBinary", "/bin/"+name, name, false, mock.Anything).Return(nil)
+				}
+			}
+
+			if !tt.dryRun {
+				filesystem.On("ListBinaries", "/bin", fs.ListOptions{ShowHidden: true}).
+					Return([]string{"gopls"}).Once().NotBefore(listing)
+			}
+
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+			config := Config{ShowDiff: true, DryRun: tt.dryRun}
+
+			if err := RunBatch(context.Background(), deps, config, []string{"vhs", "age"}); err != nil {
+				t.Fatalf("RunBatch() error = %v", err)
+			}
+
+			if gotOutput := getOutput(); !strings.HasSuffix(gotOutput, "- age\n- vhs\n") {
+				t.Errorf("RunBatch() output = %q, want it to end with the diff", gotOutput)
+			}
+		})
+	}
+}
+
+// Test_diffListings verifies names are reported as removed or added, sorted,
+// and that an unchanged directory has an empty diff.
+func Test_diffListings(t *testing.T) {
+	tests := []struct {
+		name   string
+		before []string
+		after  []string
+		want   dirDiff
+		render string
+	}{
+		{
+			name:   "removed",
+			before: []string{"vhs", "age", "gopls"},
+			after:  []string{"gopls"},
+			want:   dirDiff{Removed: []string{"age", "vhs"}},
+			render: "- age\n- vhs\n",
+		},
+		{
+			name:   "removed and added",
+			before: []string{"gopls", "old"},
+			after:  []string{"gopls", "new"},
+			want:   dirDiff{Removed: []string{"old"}, Added: []string{"new"}},
+			render: "- old\n+ new\n",
+		},
+		{
+			name:   "unchanged",
+			before: []string{"gopls"},
+			after:  []string{"gopls"},
+			want:   dirDiff{},
+			render: "",
+		},
+		{
+			name:   "empty before",
+			after:  []string{"vhs"},
+			want:   dirDiff{Added: []string{"vhs"}},
+			render: "+ vhs\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffListings(tt.before, tt.after)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.render, got.String())
+		})
+	}
+}
+
+// TestRunBatch_Events verifies a batch streams start, per-removal, and summary
+// events to the configured emitter.
+func TestRunBatch_Events(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+
+	for _, name := range []string{"age", "vhs"} {
+		filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+		filesystem.On("BinarySize", "/bin/"+name).Return(int64(1000), nil)
+	}
+
+	filesystem.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).Return(nil)
+	filesystem.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).
+		Return(errors.New("permission denied"))
+
+	client, server := net.Pipe()
+	emitter := events.NewConnEmitter(client)
+
+	received := make(chan []events.Event, 1)
+
+	go func() {
+		var got []events.Event
+
+		decoder := json.NewDecoder(server)
+		for {
+			var event events.Event
+			if decoder.Decode(&event) != nil {
+				break
+			}
+
+			got = append(got, event)
+		}
+
+		received <- got
+	}()
+
+	captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t), Events: emitter}
+	if err := RunBatch(context.Background(), deps, Config{}, []string{"age", "vhs"}); err == nil {
+		t.Error("RunBatch() error = nil, want failure for vhs")
+	}
+
+	emitter.Close()
+
+	got := <-received
+
+	want := []events.Event{
+		{Type: events.TypeStart, Names: []string{"age", "vhs"}},
+		{Type: events.TypeRemoval, Name: "age", Path: "/bin/age", Size: 1000},
+		{Type: events.TypeRemoval, Name: "vhs", Path: "/bin/vhs", Error: "failed to remove binary vhs: permission denied"},
+		{Type: events.TypeSummary, Count: 1, Failed: 1, Freed: 1000},
+	}
+
+	for i := range got {
+		got[i].Time = time.Time{} // Timestamps vary between runs
+	}
+
+	assert.Equal(t, want, got)
+}
+
+// TestRunBatch_AuditLog verifies every removal attempt in a batch, failed or
+// not, is appended to the audit log.
+func TestRunBatch_AuditLog(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+
+	for _, name := range []string{"age", "vhs"} {
+		filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+		filesystem.On("BinarySize", "/bin/"+name).Return(int64(1000), nil)
+	}
+
+	filesystem.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).Return(nil)
+	filesystem.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).
+		Return(errors.New("permission denied"))
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	auditLog, err := audit.Open(path, audit.DefaultMaxSize, audit.DefaultKeep)
+	if err != nil {
+		t.Fatalf("audit.Open() error = %v", err)
+	}
+
+	captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t), Audit: auditLog}
+	if err := RunBatch(context.Background(), deps, Config{}, []string{"age", "vhs"}); err == nil {
+		t.Error("RunBatch() error = nil, want failure for vhs")
+	}
+
+	if err := auditLog.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read audit log: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if assert.Len(t, lines, 2) {
+		assert.Contains(t, lines[0], `name="age" path="/bin/age" result="removed"`)
+		assert.Contains(t, lines[1], `name="vhs" path="/bin/vhs" result="failed: failed to remove binary vhs: permission denied"`)
+	}
+}
+
+// TestRunBatch_Throttle verifies the batch pauses between removals but not
+// before the first one.
+func TestRunBatch_Throttle(t *testing.T) {
+	names := []string{"age", "gopls", "vhs"}
+
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+
+	for _, name := range names {
+		filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+		filesystem.On("BinarySize", "/bin/"+name).Return(int64(1000), nil)
+		filesystem.On("RemoveBinary", "/bin/"+name, name, false, mock.Anything).Return(nil)
+	}
+
+	var waits []time.Duration
+
+	deps := Dependencies{
+		FS:     filesystem,
+		Logger: newMockLoggerWithDefaults(t),
+		Sleep: func(_ context.Context, duration time.Duration) error {
+			waits = append(waits, duration)
+
+			return nil
+		},
+	}
+
+	captureStdout(t)
+
+	if err := RunBatch(context.Background(), deps, Config{Throttle: time.Second}, names); err != nil {
+		t.Fatalf("RunBatch() error = %v", err)
+	}
+
+	assert.Equal(t, []time.Duration{time.Second, time.Second}, waits)
+}
+
+// TestRunBatch_ThrottleCanceled verifies canceling the context during a pause
+// stops the batch before the next removal.
+func TestRunBatch_ThrottleCanceled(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("AdjustBinaryPath", "/bin", "age").Return("/bin/age")
+	filesystem.On("BinarySize", "/bin/age").Return(int64(1000), nil)
+	filesystem.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deps := Dependencies{
+		FS:     filesystem,
+		Logger: newMockLoggerWithDefaults(t),
+		Sleep: func(ctx context.Context, duration time.Duration) error {
+			cancel() // Simulate Ctrl+C arriving mid-pause
+
+			return sleepContext(ctx, duration)
+		},
+	}
+
+	getOutput := captureStdout(t)
+
+	err := RunBatch(ctx, deps, Config{Throttle: time.Hour}, []string{"age", "vhs"})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("RunBatch() error = %v, want %v", err, context.Canceled)
+	}
+
+	// RemoveBinary is only expected for age; vhs is never attempted.
+	assert.Equal(t, "Successfully removed age\nRemoved 1 of 1 binary\nFreed 1.0 kB across 1 binary\n", getOutput())
+}
+
+// TestRunBatch_Notify verifies a completion notification is sent and that a
+// failure to deliver it does not fail the batch.
+func TestRunBatch_Notify(t *testing.T) {
+	for _, notifyErr := range []error{nil, errors.New("notify-send not found")} {
+		filesystem := mockFS.NewMockFS(t)
+		filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+		filesystem.On("AdjustBinaryPath", "/bin", "gopls").Return("/bin/gopls")
+		filesystem.On("BinarySize", "/bin/gopls").Return(int64(1_200_000_000), nil)
+		filesystem.On("RemoveBinary", "/bin/gopls", "gopls", false, mock.Anything).Return(nil)
+
+		notifier := mockNotify.NewMockNotifier(t)
+		notifier.On("Notify", "go-remove", "Freed 1.2 GB across 1 binary").Return(notifyErr).Once()
+
+		captureStdout(t)
+
+		deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t), Notifier: notifier}
+		if err := RunBatch(context.Background(), deps, Config{Notify: true}, []string{"gopls"}); err != nil {
+			t.Errorf("RunBatch() error = %v with notifier error %v", err, notifyErr)
+		}
+	}
+}
+
+// Test_formatBatchSummary verifies the rollup counts and lists each failure
+// with its cause.
+func Test_formatBatchSummary(t *testing.T) {
+	denied := fmt.Errorf("failed to remove binary age: %w", errors.New("permission denied"))
+	busy := fmt.Errorf("failed to remove binary vhs: %w", errors.New("text file busy"))
+
+	tests := []struct {
+		name     string
+		removed  int
+		failures []batchFailure
+		dryRun   bool
+		want     string
+	}{
+		{
+			name:    "all removed",
+			removed: 5,
+			want:    "Removed 5 of 5 binaries",
+		},
+		{
+			name:     "one failure",
+			removed:  5,
+			failures: []batchFailure{{Name: "age", Err: denied}},
+			want:     "Removed 5 of 6 binaries (1 failed: age — permission denied)",
+		},
+		{
+			name:     "every binary failed",
+			failures: []batchFailure{{Name: "age", Err: denied}, {Name: "vhs", Err: busy}},
+			want:     "Removed 0 of 2 binaries (2 failed: age — permission denied; vhs — text file busy)",
+		},
+		{
+			name:    "dry run",
+			removed: 1,
+			dryRun:  true,
+			want:    "Would remove 1 of 1 binary",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatBatchSummary(tt.removed, tt.failures, tt.dryRun); got != tt.want {
+				t.Errorf("formatBatchSummary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_formatBatchStats verifies the total, average, and slowest lines.
+func Test_formatBatchStats(t *testing.T) {
+	tests := []struct {
+		name    string
+		timings []removalTiming
+		total   time.Duration
+		want    string
+	}{
+		{
+			name: "several binaries",
+			timings: []removalTiming{
+				{Name: "age", Elapsed: 10 * time.Millisecond},
+				{Name: "vhs", Elapsed: 40 * time.Millisecond},
+				{Name: "gopls", Elapsed: 25 * time.Millisecond},
+			},
+			total: 80 * time.Millisecond,
+			want:  "Stats: 3 removed in 80ms\n  average: 25ms\n  slowest: vhs (40ms)\n",
+		},
+		{
+			name:    "nothing removed",
+			timings: nil,
+			total:   2 * time.Millisecond,
+			want:    "Stats: 0 removed in 2ms\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatBatchStats(tt.timings, tt.total); got != tt.want {
+				t.Errorf("formatBatchStats() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRunAll_Interactive verifies y/n/a/q answers decide which binaries are removed.
+func TestRunAll_Interactive(t *testing.T) {
+	names := []string{"age", "gopls", "staticcheck", "vhs", "yq"}
+
+	tests := []struct {
+		name        string
+		input       string
+		wantRemoved []string
+	}{
+		{
+			name:        "yes, no, then all",
+			input:       "y\nn\na\n",
+			wantRemoved: []string{"age", "staticcheck", "vhs", "yq"},
+		},
+		{
+			name:        "quit stops the batch",
+			input:       "n\nyes\nq\n",
+			wantRemoved: []string{"gopls"},
+		},
+		{
+			name:        "end of input declines the rest",
+			input:       "y\n",
+			wantRemoved: []string{"age"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesystem := mockFS.NewMockFS(t)
+			filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+			filesystem.On("ListBinaries", "/bin", fs.ListOptions{}).Return(names)
+
+			for _, name := range tt.wantRemoved {
+				filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+				filesystem.On("BinarySize", "/bin/"+name).Return(int64(1000), nil)
+				filesystem.On("RemoveBinary", "/bin/"+name, name, false, mock.Anything).Return(nil)
+			}
+
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{
+				FS:     filesystem,
+				Logger: newMockLoggerWithDefaults(t),
+				Input:  strings.NewReader(tt.input),
+			}
+
+			if err := RunAll(context.Background(), deps, Config{All: true, Interactive: true}); err != nil {
+				t.Fatalf("RunAll() error = %v", err)
+			}
+
+			gotOutput := getOutput()
+
+			if !strings.HasPrefix(gotOutput, "Remove age? [y/n/a/q] ") {
+				t.Errorf("RunAll() output = %q, want it to start with the first prompt", gotOutput)
+			}
+
+			// RemoveBinary is only expected for binaries the answers confirmed.
+			filesystem.AssertExpectations(t)
+		})
+	}
+}
+
+// TestRunAll_InteractiveDescribe verifies --describe prints each binary's
+// format ahead of its prompt.
+func TestRunAll_InteractiveDescribe(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"age", "notes"})
+	filesystem.On("AdjustBinaryPath", "/bin", "age").Return("/bin/age")
+	filesystem.On("AdjustBinaryPath", "/bin", "notes").Return("/bin/notes")
+	filesystem.On("DescribeBinary", "/bin/age").Return("ELF 64-bit executable, amd64", nil)
+	filesystem.On("DescribeBinary", "/bin/notes").Return("", fs.ErrUnknownFormat)
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{
+		FS:     filesystem,
+		Logger: newMockLoggerWithDefaults(t),
+		Input:  strings.NewReader("n\nn\n"),
+	}
+
+	if err := RunAll(context.Background(), deps, Config{All: true, Interactive: true, Describe: true}); err != nil {
+		t.Fatalf("RunAll() error = %v", err)
+	}
+
+	want := "age: ELF 64-bit executable, amd64\nRemove age? [y/n/a/q] " +
+		"notes: unknown type\nRemove notes? [y/n/a/q] "
+	if got := getOutput(); got != want {
+		t.Errorf("RunAll() output = %q, want %q", got, want)
+	}
+}
+
+// TestRunAll_Empty verifies an empty directory is reported as nothing to do
+// and succeeds, so repeated runs are safe.
+func TestRunAll_Empty(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{})
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	if err := RunAll(context.Background(), deps, Config{All: true}); err != nil {
+		t.Errorf("RunAll() error = %v, want nil", err)
+	}
+
+	if got, want := getOutput(), "No binaries to remove in /bin\n"; got != want {
+		t.Errorf("RunAll() output = %q, want %q", got, want)
+	}
+}
+
+// TestRunAll_Confirm verifies --all asks before removing anything, naming the
+// count and total size to be freed, and removes nothing when declined.
+func TestRunAll_Confirm(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantErr     error
+		wantRemoved bool
+	}{
+		{name: "confirmed", input: "y\n", wantRemoved: true},
+		{name: "declined", input: "n\n", wantErr: ErrRemovalDeclined},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesystem := mockFS.NewMockFS(t)
+			filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+			filesystem.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"age", "gopls"})
+
+			for name, size := range map[string]int64{"age": 400_000_000, "gopls": 800_000_000} {
+				path := filepath.Join("/bin", name)
+				filesystem.On("AdjustBinaryPath", "/bin", name).Return(path)
+				filesystem.On("BinarySize", path).Return(size, nil)
+
+				if tt.wantRemoved {
+					filesystem.On("RemoveBinary", path, name, false, mock.Anything).Return(nil)
+				}
+			}
+
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t), Input: strings.NewReader(tt.input)}
+
+			err := RunAll(context.Background(), deps, Config{All: true})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RunAll() error = %v, want %v", err, tt.wantErr)
+			}
+
+			gotOutput := getOutput()
+			if want := "This will remove 2 binaries and free 1.2 GB. Continue? [y/N] "; !strings.HasPrefix(gotOutput, want) {
+				t.Errorf("RunAll() output = %q, want it to start with %q", gotOutput, want)
+			}
+
+			if !tt.wantRemoved {
+				filesystem.AssertNotCalled(t, "RemoveBinary", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+// TestRunAll_KeepRunning verifies binaries with running processes are warned
+// about and skipped, and that a failed process lookup stops the batch.
+func TestRunAll_KeepRunning(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"age", "gopls"})
+
+	for _, name := range []string{"age", "gopls"} {
+		filesystem.On("AdjustBinaryPath", "/bin", name).Return(filepath.Join("/bin", name))
+	}
+
+	filesystem.On("BinarySize", filepath.Join("/bin", "age")).Return(int64(1000), nil)
+	filesystem.On("RemoveBinary", filepath.Join("/bin", "age"), "age", false, mock.Anything).Return(nil)
+
+	processes := mockProc.NewMockFinder(t)
+	processes.On("RunningExecutables").Return([]string{filepath.Join("/bin", "gopls"), "/usr/bin/zsh"}, nil).Once()
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t), Processes: processes}
+	if err := RunAll(context.Background(), deps, Config{All: true, KeepRunning: true, Yes: true}); err != nil {
+		t.Fatalf("RunAll() error = %v", err)
+	}
+
+	gotOutput := getOutput()
+	for _, want := range []string{"Warning: gopls is running; skipping\n", "Successfully removed age\n"} {
+		if !strings.Contains(gotOutput, want) {
+			t.Errorf("RunAll() output = %q, want it to contain %q", gotOutput, want)
+		}
+	}
+
+	filesystem.AssertNotCalled(t, "RemoveBinary", filepath.Join("/bin", "gopls"), "gopls", false, mock.Anything)
+
+	processes.On("RunningExecutables").Return(nil, errors.New("permission denied")).Once()
+
+	if err := RunAll(context.Background(), deps, Config{All: true, KeepRunning: true}); err == nil {
+		t.Error("RunAll() error = nil, want the process lookup failure")
+	}
+}
+
+// Test_skipParent verifies the binary that started go-remove is warned about
+// and skipped, and that an unknown parent or unsupported platform keeps every
+// binary.
+func Test_skipParent(t *testing.T) {
+	tests := []struct {
+		name       string
+		parent     string
+		parentErr  error
+		want       []string
+		wantOutput string
+	}{
+		{
+			name:       "parent is a target",
+			parent:     filepath.Join("/bin", "gopls"),
+			want:       []string{"age"},
+			wantOutput: "Warning: gopls started go-remove; skipping\n",
+		},
+		{
+			name:   "parent is elsewhere",
+			parent: "/usr/bin/zsh",
+			want:   []string{"age", "gopls"},
+		},
+		{
+			name:      "parent unknown",
+			parentErr: proc.ErrParentUnknown,
+			want:      []string{"age", "gopls"},
+		},
+		{
+			name:      "unsupported platform",
+			parentErr: proc.ErrUnsupportedPlatform,
+			want:      []string{"age", "gopls"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesystem := mockFS.NewMockFS(t)
+
+			if tt.parentErr == nil {
+				for _, name := range []string{"age", "gopls"} {
+					filesystem.On("AdjustBinaryPath", "/bin", name).Return(filepath.Join("/bin", name))
+				}
+			}
+
+			processes := mockProc.NewMockFinder(t)
+			processes.On("ParentExecutable").Return(tt.parent, tt.parentErr)
+
+			// Lookup failures are only logged.
+			nopLog := zerolog.New(io.Discard)
+			log := mockLogger.NewMockLogger(t)
+			log.On("Debug").Return(nopLog.Debug()).Maybe()
+			log.On("Warn").Return(nopLog.Warn()).Maybe()
+
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{FS: filesystem, Logger: log, Processes: processes}
+
+			got, err := skipParent(deps, "/bin", []string{"age", "gopls"})
+			if err != nil {
+				t.Fatalf("skipParent() error = %v", err)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("skipParent() = %q, want %q", got, tt.want)
+			}
+
+			if gotOutput := getOutput(); gotOutput != tt.wantOutput {
+				t.Errorf("skipParent() output = %q, want %q", gotOutput, tt.wantOutput)
+			}
+		})
+	}
+}
+
+// TestFormatFreed verifies the freed-space summary for real and dry runs.
+func TestFormatFreed(t *testing.T) {
+	removals := []Removal{
+		{Name: "age", Size: 2_000_000},
+		{Name: "gopls", Size: 140_000_000},
+	}
+
+	if got, want := FormatFreed(removals, false), "Freed 142.0 MB across 2 binaries"; got != want {
+		t.Errorf("FormatFreed() = %q, want %q", got, want)
+	}
+
+	if got, want := FormatFreed(removals[:1], true), "Would free 2.0 MB across 1 binary"; got != want {
+		t.Errorf("FormatFreed() dry run = %q, want %q", got, want)
+	}
+}
+
+// TestRunBatch_Symbols verifies each symbol set marks successful and failed
+// removals in batch output.
+func TestRunBatch_Symbols(t *testing.T) {
+	tests := []struct {
+		name  string
+		set   string
+		wants []string
+	}{
+		{
+			name: "unicode",
+			set:  SymbolsUnicode,
+			wants: []string{
+				"✓ Successfully removed age\n",
+				"✗ failed to remove binary vhs: permission denied\n",
+			},
+		},
+		{
+			name: "ascii",
+			set:  SymbolsASCII,
+			wants: []string{
+				"OK Successfully removed age\n",
+				"FAIL failed to remove binary vhs: permission denied\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			symbols, err := ParseSymbols(tt.set)
+			if err != nil {
+				t.Fatalf("ParseSymbols() error = %v", err)
+			}
+
+			filesystem := mockFS.NewMockFS(t)
+			filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+
+			for _, name := range []string{"age", "vhs"} {
+				filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+				filesystem.On("BinarySize", "/bin/"+name).Return(int64(1000), nil)
+			}
+
+			filesystem.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).Return(nil)
+			filesystem.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).
+				Return(errors.New("permission denied"))
+
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+			if err := RunBatch(context.Background(), deps, Config{Symbols: symbols}, []string{"age", "vhs"}); err == nil {
+				t.Error("RunBatch() error = nil, want failure for vhs")
+			}
+
+			gotOutput := getOutput()
+
+			for _, want := range tt.wants {
+				if !strings.Contains(gotOutput, want) {
+					t.Errorf("RunBatch() output = %q, want it to contain %q", gotOutput, want)
+				}
+			}
+		})
+	}
+}
+
+// TestParseSymbols verifies known symbol set names and the error for unknown ones.
+func TestParseSymbols(t *testing.T) {
+	got, err := ParseSymbols(SymbolsASCII)
+	if err != nil || got.Success != "OK" || got.Failure != "FAIL" {
+		t.Errorf("ParseSymbols(ascii) = %+v, %v", got, err)
+	}
+
+	if _, err := ParseSymbols("emoji"); !errors.Is(err, ErrUnknownSymbols) {
+		t.Errorf("ParseSymbols(emoji) error = %v, want %v", err, ErrUnknownSymbols)
+	}
+}
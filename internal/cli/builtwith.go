@@ -0,0 +1,104 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/version"
+	"os"
+	"strings"
+)
+
+// ErrInvalidGoVersion indicates a --built-with value that is not a Go version such as go1.22 or <1.22.
+var ErrInvalidGoVersion = errors.New("--built-with must be a Go version such as go1.21, go1.21.5, or <go1.22")
+
+// goVersionMatcher reports whether a binary built with the given Go version,
+// e.g. "go1.21.5", is selected by --built-with.
+type goVersionMatcher func(goVersion string) bool
+
+// ValidateBuiltWith reports whether spec is a valid --built-with value.
+//
+// A release such as "go1.21" selects every patch release of it, a full version
+// such as "go1.21.5" selects only that version, and a leading "<" selects
+// anything older, e.g. "<go1.22". The "go" prefix is optional.
+func ValidateBuiltWith(spec string) error {
+	_, err := parseBuiltWith(spec)
+
+	return err
+}
+
+// parseBuiltWith returns the matcher for a --built-with value, or an error
+// wrapping ErrInvalidGoVersion if it is not a Go version.
+func parseBuiltWith(spec string) (goVersionMatcher, error) {
+	target, older := strings.CutPrefix(strings.TrimSpace(spec), "<")
+
+	target = strings.TrimSpace(target)
+	if !strings.HasPrefix(target, "go") {
+		target = "go" + target
+	}
+
+	if !version.IsValid(target) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidGoVersion, spec)
+	}
+
+	switch {
+	case older:
+		return func(goVersion string) bool {
+			return version.Compare(goVersion, target) < 0
+		}, nil
+	case version.Lang(target) == target:
+		// A bare release like go1.21 covers go1.21.0 through its last patch.
+		return func(goVersion string) bool {
+			return version.Lang(goVersion) == target
+		}, nil
+	default:
+		return func(goVersion string) bool {
+			return version.Compare(goVersion, target) == 0
+		}, nil
+	}
+}
+
+// selectBuiltWith keeps the names whose binaries in binDir were built with a
+// Go version config.BuiltWith selects. Binaries without readable build info
+// or a recognizable Go version are skipped with a note, since there is no
+// telling what built them.
+func selectBuiltWith(ctx context.Context, deps Dependencies, binDir string, config Config, names []string) ([]string, error) {
+	if deps.Extractor == nil {
+		return nil, ErrExtractorNotInitialized
+	}
+
+	matches, err := parseBuiltWith(config.BuiltWith)
+	if err != nil {
+		return nil, err
+	}
+
+	var kept []string
+
+	for _, name := range names {
+		info, err := deps.Extractor.Extract(ctx, deps.FS.AdjustBinaryPath(binDir, name))
+		if err != nil || info == nil || !version.IsValid(goRelease(info.GoVersion)) {
+			fmt.Fprintf(os.Stdout, "Note: %s has no Go version in its build info; skipping\n", name)
+
+			continue
+		}
+
+		if matches(goRelease(info.GoVersion)) {
+			kept = append(kept, name)
+		}
+	}
+
+	return kept, nil
+}
+
+// goRelease strips the experiment suffix build info can carry after the
+// version, e.g. "go1.21.5 X:boringcrypto", which go/version does not accept.
+func goRelease(goVersion string) string {
+	release, _, _ := strings.Cut(goVersion, " ")
+
+	return release
+}
@@ -0,0 +1,116 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
+	mockBuildInfo "github.com/nicholas-fedor/go-remove/internal/buildinfo/mocks"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// Test_parseBuiltWith verifies releases match every patch, full versions
+// match exactly, "<" matches older versions, and malformed values are rejected.
+func Test_parseBuiltWith(t *testing.T) {
+	tests := []struct {
+		spec    string
+		match   []string
+		noMatch []string
+		wantErr bool
+	}{
+		{spec: "go1.21", match: []string{"go1.21.0", "go1.21.13", "go1.21rc2"}, noMatch: []string{"go1.22.0", "go1.2"}},
+		{spec: "1.21", match: []string{"go1.21.5"}, noMatch: []string{"go1.20.14"}},
+		{spec: "go1.21.5", match: []string{"go1.21.5"}, noMatch: []string{"go1.21.6"}},
+		{spec: "<go1.22", match: []string{"go1.21.13", "go1.18"}, noMatch: []string{"go1.22.0", "go1.26.1"}},
+		{spec: "<1.22.3", match: []string{"go1.22.2"}, noMatch: []string{"go1.22.3"}},
+		{spec: "latest", wantErr: true},
+		{spec: "", wantErr: true},
+		{spec: "<", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			matches, err := parseBuiltWith(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseBuiltWith() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				if !errors.Is(err, ErrInvalidGoVersion) {
+					t.Errorf("parseBuiltWith() error = %v, want %v", err, ErrInvalidGoVersion)
+				}
+
+				return
+			}
+
+			for _, goVersion := range tt.match {
+				if !matches(goVersion) {
+					t.Errorf("%s does not match %s, want a match", tt.spec, goVersion)
+				}
+			}
+
+			for _, goVersion := range tt.noMatch {
+				if matches(goVersion) {
+					t.Errorf("%s matches %s, want no match", tt.spec, goVersion)
+				}
+			}
+		})
+	}
+}
+
+// TestRunAll_BuiltWith verifies --built-with removes only binaries built with
+// an older Go version and notes binaries without build info.
+func TestRunAll_BuiltWith(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"age", "gopls", "script"})
+
+	extractor := mockBuildInfo.NewMockExtractor(t)
+
+	for name, goVersion := range map[string]string{"age": "go1.21.4 X:boringcrypto", "gopls": "go1.26.0", "script": ""} {
+		filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+
+		if goVersion == "" {
+			extractor.On("Extract", mock.Anything, "/bin/"+name).Return(nil, buildinfo.ErrNotGoBinary)
+		} else {
+			extractor.On("Extract", mock.Anything, "/bin/"+name).
+				Return(&buildinfo.BuildInfoData{GoVersion: goVersion}, nil)
+		}
+	}
+
+	filesystem.On("BinarySize", "/bin/age").Return(int64(1000), nil)
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t), Extractor: extractor}
+	config := Config{All: true, DryRun: true, BuiltWith: "<go1.22"}
+
+	if err := RunAll(context.Background(), deps, config); err != nil {
+		t.Fatalf("RunAll() error = %v", err)
+	}
+
+	gotOutput := getOutput()
+	for _, want := range []string{
+		"Note: script has no Go version in its build info; skipping\n",
+		"Would remove age\n",
+		"Would remove 1 of 1 binary\n",
+	} {
+		if !strings.Contains(gotOutput, want) {
+			t.Errorf("RunAll() output = %q, want it to contain %q", gotOutput, want)
+		}
+	}
+
+	if strings.Contains(gotOutput, "gopls") {
+		t.Errorf("RunAll() output = %q, want gopls left alone", gotOutput)
+	}
+}
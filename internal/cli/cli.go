@@ -8,89 +8,592 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"regexp"
+	"time"
 
+	"github.com/nicholas-fedor/go-remove/internal/audit"
+	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
+	"github.com/nicholas-fedor/go-remove/internal/events"
 	"github.com/nicholas-fedor/go-remove/internal/fs"
 	"github.com/nicholas-fedor/go-remove/internal/history"
 	"github.com/nicholas-fedor/go-remove/internal/logger"
+	"github.com/nicholas-fedor/go-remove/internal/notify"
+	"github.com/nicholas-fedor/go-remove/internal/proc"
 )
 
 // Config holds command-line configuration options.
 type Config struct {
-	Binary      string // Binary name to remove; empty for TUI mode
-	Verbose     bool   // Enable verbose logging
-	Goroot      bool   // Use GOROOT/bin instead of GOBIN or GOPATH/bin
-	Help        bool   // Show help; managed by Cobra
-	LogLevel    string // Log level (debug, info, warn, error)
-	RestoreMode bool   // Start TUI in history mode
+	Binary               string    `json:"binary"`               // Binary name to remove; empty for TUI mode
+	Module               string    `json:"module"`               // Module or package path whose binary should be removed
+	PathMode             bool      `json:"pathMode"`             // Treat Binary as a literal file path instead of a name
+	ExactName            bool      `json:"exactName"`            // Match Binary exactly instead of expanding an unambiguous prefix
+	Verbose              bool      `json:"verbose"`              // Enable verbose logging
+	Goroot               bool      `json:"goroot"`               // Use GOROOT/bin instead of GOBIN or GOPATH/bin
+	Help                 bool      `json:"-"`                    // Show help; managed by Cobra
+	LogLevel             string    `json:"logLevel"`             // Log level (debug, info, warn, error)
+	LogSink              string    `json:"logSink"`              // Log destination for direct removal (stderr, syslog, both)
+	RestoreMode          bool      `json:"restoreMode"`          // Start TUI in history mode
+	JSON                 bool      `json:"json"`                 // Emit machine-readable JSON output
+	Pretty               bool      `json:"pretty"`               // Indent JSON output for readability
+	Summary              bool      `json:"summary"`              // Append a count and total size summary to list output
+	ShowSkipped          bool      `json:"showSkipped"`          // Append excluded directory entries and reasons to list output
+	Long                 bool      `json:"long"`                 // Include size and modification time in list output
+	TimeFormat           string    `json:"timeFormat"`           // How list --long shows modification times: a format shortcut or Go layout; empty is relative
+	CheckPath            bool      `json:"checkPath"`            // Report listed binaries shadowed by an earlier PATH entry
+	CheckCorrupt         bool      `json:"checkCorrupt"`         // Flag zero-byte, headerless, or truncated binaries in list output and the TUI
+	GroupByModule        bool      `json:"groupByModule"`        // Group list output by the module each binary was built from
+	Null                 bool      `json:"null"`                 // End each listed name with a NUL byte instead of a newline, for xargs -0
+	DryRun               bool      `json:"dryRun"`               // Report removals without deleting anything
+	ExitCode             bool      `json:"exitCode"`             // Return ErrChangesPending when a dry run finds binaries to remove
+	FailFast             bool      `json:"failFast"`             // Stop a batch at its first failed removal, leaving the rest in place
+	Report               string    `json:"report"`               // Path of a JSON report describing the session's removals
+	Stats                bool      `json:"stats"`                // Print aggregate timing after batch removal
+	ShowHidden           bool      `json:"showHidden"`           // Include hidden (dot-prefixed) files when listing binaries
+	IncludeBundles       bool      `json:"includeBundles"`       // List app bundle directories and allow removing them after confirmation
+	IncludeNonExecutable bool      `json:"includeNonExecutable"` // List regular files without an execute permission bit
+	All                  bool      `json:"all"`                  // Remove every binary in the target directory
+	KeepRunning          bool      `json:"keepRunning"`          // Skip --all binaries that currently have running processes
+	FindDuplicates       bool      `json:"findDuplicates"`       // Report binaries with identical contents instead of removing
+	SkipParent           bool      `json:"skipParent"`           // Skip a binary that is the executable of go-remove's parent process
+	ShowDiff             bool      `json:"showDiff"`             // Print the binary directory's changes after a batch
+	BuiltWith            string    `json:"builtWith"`            // Limit --all to binaries built with this Go version, e.g. go1.21 or <go1.22
+	SinceInstall         string    `json:"sinceInstall"`         // Limit --all to binaries built on or after a date, or before it with a "<" prefix
+	SelectFile           string    `json:"selectFile"`           // File listing the binaries to remove, one name per line
+	Manifest             string    `json:"manifest"`             // File listing the binaries prune keeps; every other binary is removed
+	Yes                  bool      `json:"yes"`                  // Skip the confirmation before --all or a prune removes anything
+	Interactive          bool      `json:"interactive"`          // Prompt before each removal in a batch
+	Describe             bool      `json:"describe"`             // Show each binary's executable format before its interactive prompt
+	Cursor               string    `json:"cursor"`               // TUI cursor symbol; empty uses the default
+	ColumnPadding        int       `json:"columnPadding"`        // TUI grid column padding; 0 uses the default
+	ListLayout           bool      `json:"listLayout"`           // Show TUI binaries in a single column instead of a grid
+	GridOrder            string    `json:"gridOrder"`            // TUI grid fill order, GridOrderRow or GridOrderColumn; empty means column
+	Inline               bool      `json:"inline"`               // Render the TUI below the prompt instead of on the alternate screen
+	StateFile            string    `json:"stateFile"`            // File remembering the TUI's sort order and panel toggles between sessions; empty forgets them
+	PruneEmpty           bool      `json:"pruneEmpty"`           // Remove a binary's directory once it is empty, unless it is a standard Go directory
+	CleanDangling        bool      `json:"cleanDangling"`        // Remove symlinks left pointing at a removed binary
+	AssumeWritable       bool      `json:"assumeWritable"`       // Skip the per-removal check that the binary's directory is writable
+	EventSocket          string    `json:"eventSocket"`          // Unix socket that receives JSON progress events during direct removal
+	AuditLog             string    `json:"auditLog"`             // File that receives a rotated audit line per direct removal
+	OutputDir            string    `json:"outputDir"`            // Directory that receives a JSON log file per direct removal
+	Notify               bool      `json:"notify"`               // Send a desktop notification when a batch completes
+	Safe                 bool      `json:"safe"`                 // Refuse to remove anything outside GOROOT, GOPATH, GOBIN, or ~/go
+	DirFromGoEnv         bool      `json:"dirFromGoEnv"`         // Determine the binary directory from go env instead of the process environment
+	GoVersion            string    `json:"goVersion"`            // Go release whose toolchain determines the binary directory; empty uses the current one
+	ReinstallVersion     string    `json:"reinstallVersion"`     // Version to go install after removing the binary; empty skips reinstalling
+	Symbols              SymbolSet `json:"symbols"`              // Glyphs marking removal results; the zero value prints none
+
+	// Match limits list, --all, and TUI binaries to names it matches; nil matches all.
+	Match *regexp.Regexp `json:"match"`
+
+	// Allow lists the patterns a binary's name must all match to be removed,
+	// from the allow_pattern setting and --allow-pattern; nil allows every name.
+	Allow []*regexp.Regexp `json:"allow"`
+
+	// MinSize and MaxSize limit list, --all, and TUI binaries to sizes within
+	// them, in bytes and inclusive; 0 means no bound.
+	MinSize int64 `json:"minSize"`
+	MaxSize int64 `json:"maxSize"`
+
+	// OlderThan and NewerThan limit list, --all, and TUI binaries to those
+	// last modified within the window they form; 0 means no bound.
+	OlderThan time.Duration `json:"olderThan"`
+	NewerThan time.Duration `json:"newerThan"`
+
+	// Throttle is how long a batch pauses between removals; 0 removes without pausing.
+	Throttle time.Duration `json:"throttle"`
+}
+
+// MarshalJSON encodes the config with Throttle, OlderThan, and NewerThan
+// written as duration strings such as "500ms" rather than counts of nanoseconds.
+func (c Config) MarshalJSON() ([]byte, error) {
+	type plain Config
+
+	data, err := json.Marshal(struct {
+		plain
+
+		Throttle  string `json:"throttle"`
+		OlderThan string `json:"olderThan"`
+		NewerThan string `json:"newerThan"`
+	}{
+		plain:     plain(c),
+		Throttle:  c.Throttle.String(),
+		OlderThan: c.OlderThan.String(),
+		NewerThan: c.NewerThan.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	return data, nil
 }
 
 // Dependencies holds runtime dependencies for CLI execution.
 type Dependencies struct {
-	FS             fs.FS           // Filesystem operations
-	Logger         logger.Logger   // Logging interface
-	HistoryManager history.Manager // History manager for undo/restore operations (optional)
+	FS             fs.FS               // Filesystem operations
+	Logger         logger.Logger       // Logging interface
+	HistoryManager history.Manager     // History manager for undo/restore operations (optional)
+	Extractor      buildinfo.Extractor // Build info extractor for module lookups, Config.BuiltWith, and Config.SinceInstall (optional)
+	Input          io.Reader           // Source for confirmation prompts (optional; defaults to stdin)
+	Output         io.Writer           // Destination for progress messages and prompts (optional; defaults to stdout)
+	Events         events.Emitter      // Progress event stream for integrations (optional)
+	Audit          audit.Recorder      // Audit trail that records each removal (optional)
+	Notifier       notify.Notifier     // Desktop notifier used when Config.Notify is set (optional)
+	LookPath       PathResolver        // Resolves commands on PATH for Config.CheckPath (optional; defaults to exec.LookPath)
+	Sleep          Sleeper             // Waits between throttled batch removals (optional; defaults to a timer)
+	Processes      proc.Finder         // Finds running executables for Config.KeepRunning and Config.SkipParent (optional)
+	Install        Installer           // Runs go install for Config.ReinstallVersion (optional; defaults to the go command)
 }
 
+// ErrPathRequiresBinary indicates path mode was requested without a file path.
+var ErrPathRequiresBinary = errors.New("path mode requires a file path argument")
+
+// ErrNoBinary indicates Remove was called without a binary name or module path.
+var ErrNoBinary = errors.New("no binary or module to remove")
+
+// ErrOutsideGoRoots indicates --safe refused a directory outside the known Go roots.
+var ErrOutsideGoRoots = errors.New("refusing to operate outside GOROOT, GOPATH, GOBIN, or ~/go")
+
+// ErrChangesPending indicates a dry run with --exit-code found binaries it
+// would remove, so the directory has drifted from what the command expects.
+var ErrChangesPending = errors.New("dry run found binaries to remove")
+
+// ErrBundlesNotEnabled indicates an app bundle was targeted without opting in to bundle removal.
+var ErrBundlesNotEnabled = errors.New("app bundle removal requires --include-bundles")
+
 // Run executes the CLI logic with the provided dependencies and configuration.
 func Run(deps Dependencies, config Config) error {
 	log := deps.Logger
 
-	// Determine the binary directory based on GOROOT or GOPATH/GOBIN settings.
-	binDir, err := deps.FS.DetermineBinDir(config.Goroot)
+	binDir, config, err := resolveTarget(deps, config)
 	if err != nil {
 		_ = log.Sync() // Flush logs; errors are ignored
 
-		return fmt.Errorf("failed to determine binary directory: %w", err)
+		return err
 	}
 
 	// Execute either TUI mode or direct binary removal based on config.Binary.
+	var removals []Removal
+
 	if config.Binary == "" {
-		err = RunTUI(binDir, config, log, deps.FS, DefaultRunner{}, deps.HistoryManager)
-	} else {
-		binaryPath := deps.FS.AdjustBinaryPath(binDir, config.Binary)
+		removals, err = RunTUI(binDir, config, log, deps.FS, DefaultRunner{}, deps.HistoryManager, deps.Audit)
+		if err != nil {
+			_ = log.Sync() // Flush logs; errors are ignored
 
-		// Record deletion to history if manager is available.
-		// RecordDeletion moves the binary to trash internally.
-		if deps.HistoryManager != nil {
-			ctx := context.Background()
-			if _, recordErr := deps.HistoryManager.RecordDeletion(
-				ctx,
-				binaryPath,
-			); recordErr != nil {
+			return fmt.Errorf("failed to run TUI: %w", err)
+		}
+
+		if len(removals) > 0 {
+			fmt.Fprintln(os.Stdout, FormatFreed(removals, config.DryRun))
+		}
+	} else {
+		if config.SkipParent {
+			kept, err := skipParent(deps, binDir, []string{config.Binary})
+			if err != nil || len(kept) == 0 {
 				_ = log.Sync()
 
-				return fmt.Errorf("failed to record deletion: %w", recordErr)
+				return err
 			}
+		}
 
-			// Binary was successfully moved to trash by RecordDeletion.
-			if !config.Verbose {
-				fmt.Fprintf(os.Stdout, "Successfully removed %s\n", config.Binary)
-			}
-		} else {
-			// No history manager available; use direct removal as fallback.
-			err = deps.FS.RemoveBinary(binaryPath, config.Binary, config.Verbose, log)
-			if err != nil {
-				_ = log.Sync()
+		// Check the binary can be reinstalled before removing it.
+		target, err := reinstallTarget(deps, binDir, config)
+		if err != nil {
+			_ = log.Sync()
 
-				return fmt.Errorf("failed to remove binary %s: %w", config.Binary, err)
-			}
+			return err
+		}
 
-			if !config.Verbose {
-				fmt.Fprintf(os.Stdout, "Successfully removed %s\n", config.Binary)
+		removal, removeErr := removeTarget(deps, binDir, config)
+		if removeErr != nil {
+			_ = log.Sync()
+
+			return removeErr
+		}
+
+		removals = []Removal{removal}
+
+		if target != "" {
+			if err := reinstall(deps, config, target); err != nil {
+				_ = log.Sync()
+
+				return err
 			}
 		}
 	}
 
-	if err != nil {
-		_ = log.Sync() // Flush logs; errors are ignored
+	// Write the session report if requested.
+	if config.Report != "" {
+		report := Report{DryRun: config.DryRun, Removals: removals}
+		if err := WriteReport(config.Report, report); err != nil {
+			_ = log.Sync()
 
-		return fmt.Errorf("failed to run TUI: %w", err)
+			return err
+		}
 	}
 
 	// Sync the logger to ensure all logs are written before exit.
 	_ = log.Sync() // Errors are ignored
 
+	return changesPending(config, removals)
+}
+
+// changesPending returns ErrChangesPending when config asks for an exit code
+// from a dry run that would have removed something, and nil otherwise.
+func changesPending(config Config, removals []Removal) error {
+	if !config.ExitCode || !config.DryRun || len(removals) == 0 {
+		return nil
+	}
+
+	noun := "binaries"
+	if len(removals) == 1 {
+		noun = "binary"
+	}
+
+	return fmt.Errorf("%w: %d %s", ErrChangesPending, len(removals), noun)
+}
+
+// Remove removes the single binary named by config.Binary or config.Module
+// without the TUI, and returns what was removed.
+//
+// It resolves the binary the same way Run does, including prefix matching and
+// --safe checks, and reports progress to the same event stream, audit trail,
+// and removal log. Unlike Run it never writes a session report.
+//
+// Parameters:
+//   - deps: Runtime dependencies; Extractor is required when config.Module is set
+//   - config: Configuration naming the binary and how to remove it
+//
+// Returns:
+//   - The removal, with BytesFreed zero for dry runs
+//   - An error if no binary was named or it cannot be resolved or removed
+func Remove(deps Dependencies, config Config) (Removal, error) {
+	defer func() { _ = deps.Logger.Sync() }() // Errors are ignored
+
+	if config.Binary == "" && config.Module == "" {
+		return Removal{}, ErrNoBinary
+	}
+
+	binDir, config, err := resolveTarget(deps, config)
+	if err != nil {
+		return Removal{}, err
+	}
+
+	return removeTarget(deps, binDir, config)
+}
+
+// resolveTarget determines the binary directory for config and resolves
+// config.Binary from a module path or an unambiguous prefix.
+//
+// Returns:
+//   - The binary directory; empty in path mode
+//   - config with Binary resolved
+//   - An error if the directory cannot be used or the name cannot be resolved
+func resolveTarget(deps Dependencies, config Config) (string, Config, error) {
+	// Path mode removes a literal file and never consults the binary directory.
+	if config.PathMode && config.Binary == "" {
+		return "", config, ErrPathRequiresBinary
+	}
+
+	var (
+		binDir string
+		err    error
+	)
+
+	// Determine the binary directory based on GOROOT or GOPATH/GOBIN settings.
+	if !config.PathMode {
+		binDir, err = deps.FS.DetermineBinDir(config.Goroot)
+		if err != nil {
+			return "", config, fmt.Errorf("failed to determine binary directory: %w", err)
+		}
+
+		if err := checkSafeDir(config, binDir); err != nil {
+			return "", config, err
+		}
+	}
+
+	// Resolve the binary name from its module path when requested.
+	if config.Module != "" {
+		config.Binary, err = resolveModuleBinary(
+			context.Background(),
+			deps.FS,
+			deps.Extractor,
+			binDir,
+			config.Module,
+		)
+		if err != nil {
+			return "", config, fmt.Errorf("failed to resolve module %s: %w", config.Module, err)
+		}
+	}
+
+	// Let an unambiguous prefix stand in for a long binary name.
+	if config.Binary != "" && !config.PathMode && config.Module == "" && !config.ExactName {
+		config.Binary, err = resolvePrefix(deps.FS, binDir, config.Binary, fs.ListOptions{
+			ShowHidden:           true,
+			IncludeBundles:       config.IncludeBundles,
+			IncludeNonExecutable: config.IncludeNonExecutable,
+		}, deps.output())
+		if err != nil {
+			return "", config, err
+		}
+	}
+
+	return binDir, config, nil
+}
+
+// removeTarget removes the resolved config.Binary from binDir, reporting it to
+// the event stream, audit trail, and removal log.
+func removeTarget(deps Dependencies, binDir string, config Config) (Removal, error) {
+	emitStart(deps, config, []string{config.Binary})
+
+	removal, err := removeDirect(deps, binDir, config)
+	emitRemoval(deps, config, removal, err)
+	recordAudit(deps, config, removal, err)
+	writeRemovalLog(deps, config, removal, err)
+
+	if err != nil {
+		emitSummary(deps, config, nil, 1)
+
+		return Removal{}, err
+	}
+
+	emitSummary(deps, config, []Removal{removal}, 0)
+
+	return removal, nil
+}
+
+// removeDirect removes config.Binary without the TUI and prints the outcome.
+// In dry-run mode nothing is deleted and the intended removal is printed instead.
+func removeDirect(deps Dependencies, binDir string, config Config) (Removal, error) {
+	binaryPath, err := resolveBinaryPath(deps.FS, binDir, config)
+	if err != nil {
+		return Removal{}, err
+	}
+
+	return removeResolved(deps, binaryPath, config)
+}
+
+// removeResolved removes the binary at binaryPath, which resolveBinaryPath
+// already derived from config.Binary, and prints the outcome.
+func removeResolved(deps Dependencies, binaryPath string, config Config) (Removal, error) {
+	// The allowlist is checked first so a refused binary is never touched.
+	if err := checkAllowed(config, filepath.Base(binaryPath)); err != nil {
+		return failedRemoval(binaryPath, 0), err
+	}
+
+	// App bundles are directories and are deleted recursively, so they need
+	// both an explicit opt-in and a confirmation.
+	bundle := fs.IsBundle(binaryPath)
+	if bundle {
+		if err := confirmBundleRemoval(deps, config); err != nil {
+			return failedRemoval(binaryPath, 0), err
+		}
+	}
+
+	// A dry run predicts the real one, so a binary that is not there fails it too.
+	if config.DryRun {
+		if _, err := deps.FS.BinarySize(binaryPath); errors.Is(err, fs.ErrBinaryNotFound) {
+			return failedRemoval(binaryPath, 0), fmt.Errorf("failed to remove binary %s: %w", config.Binary, err)
+		}
+	}
+
+	// Hash and measure before removal; afterwards the file is gone or in the trash.
+	checksum := removalChecksum(deps.FS, deps.Logger, config, binaryPath)
+	size := removalSize(deps.FS, binaryPath)
+	module, version := removalModule(deps, config, binaryPath)
+
+	// Only the deletion itself is timed, so the report reflects the filesystem.
+	var elapsed time.Duration
+
+	switch {
+	case config.DryRun:
+		// Report what would be removed without touching the filesystem.
+		fmt.Fprintln(deps.output(), config.Symbols.succeeded("Would remove "+config.Binary))
+
+	case deps.HistoryManager != nil && !bundle:
+		// Record deletion to history if manager is available.
+		// History tracks single files, so bundles fall through to direct removal.
+		// RecordDeletion moves the binary to trash internally.
+		ctx := context.Background()
+
+		start := time.Now()
+		_, err := deps.HistoryManager.RecordDeletion(ctx, binaryPath)
+		elapsed = time.Since(start)
+
+		if err != nil {
+			return failedRemoval(binaryPath, elapsed), fmt.Errorf("failed to record deletion: %w", err)
+		}
+
+		// Binary was successfully moved to trash by RecordDeletion.
+		if !config.Verbose {
+			fmt.Fprintln(deps.output(), config.Symbols.succeeded("Successfully removed "+config.Binary))
+		}
+
+	default:
+		// No history manager available; use direct removal as fallback.
+		start := time.Now()
+		err := deps.FS.RemoveBinary(binaryPath, config.Binary, config.Verbose, deps.Logger)
+		elapsed = time.Since(start)
+
+		if err != nil {
+			return failedRemoval(binaryPath, elapsed),
+				fmt.Errorf("failed to remove binary %s: %w", config.Binary, err)
+		}
+
+		if !config.Verbose {
+			fmt.Fprintln(deps.output(), config.Symbols.succeeded("Successfully removed "+config.Binary))
+		}
+	}
+
+	if config.CleanDangling {
+		cleanDanglingLinks(deps, binaryPath, config)
+	}
+
+	if config.PruneEmpty && !config.DryRun {
+		pruneParentDir(deps, binaryPath, config.Verbose)
+	}
+
+	removal := newRemoval(config.Binary, binaryPath, size, checksum, config.DryRun)
+	removal.Module, removal.Version = module, version
+	removal.DurationMs = durationMs(elapsed)
+
+	return removal, nil
+}
+
+// failedRemoval records where a failed deletion was attempted and how long it
+// took, so the failure can be reported in full while nothing is recorded as
+// removed.
+func failedRemoval(binaryPath string, elapsed time.Duration) Removal {
+	return Removal{Path: binaryPath, DurationMs: durationMs(elapsed)}
+}
+
+// newRemoval records a removal of size bytes. Dry runs free nothing, so their
+// BytesFreed is zero.
+func newRemoval(name, binaryPath string, size int64, checksum string, dryRun bool) Removal {
+	removal := Removal{Name: name, Path: binaryPath, Size: size, Checksum: checksum}
+	if !dryRun {
+		removal.BytesFreed = size
+	}
+
+	return removal
+}
+
+// pruneParentDir removes the directory that held binaryPath if it is now empty.
+// Standard Go directories are kept, and a failure to prune never fails the removal.
+func pruneParentDir(deps Dependencies, binaryPath string, verbose bool) {
+	dir := filepath.Dir(binaryPath)
+
+	removed, err := deps.FS.PruneEmptyDir(dir)
+
+	switch {
+	case errors.Is(err, fs.ErrProtectedDir):
+		if verbose {
+			deps.Logger.Debug().Msgf("Keeping standard directory %s", dir)
+		}
+	case err != nil:
+		deps.Logger.Warn().Err(err).Msgf("Could not prune %s", dir)
+	case removed:
+		fmt.Fprintf(deps.output(), "Removed empty directory %s\n", dir)
+	}
+}
+
+// removalSize returns the size of binaryPath before it is removed, or zero if
+// the size cannot be determined. Bundles are directories and are not measured.
+func removalSize(filesystem fs.FS, binaryPath string) int64 {
+	if fs.IsBundle(binaryPath) {
+		return 0
+	}
+
+	size, err := filesystem.BinarySize(binaryPath)
+	if err != nil {
+		return 0
+	}
+
+	return size
+}
+
+// removalChecksum returns the SHA-256 of binaryPath when it will be logged,
+// reported, or written to a removal log, and an empty string otherwise.
+// Bundles are directories and are not hashed. A failure to hash is logged but
+// never blocks the removal.
+func removalChecksum(filesystem fs.FS, log logger.Logger, config Config, binaryPath string) string {
+	if (!config.Verbose && config.Report == "" && config.OutputDir == "") || fs.IsBundle(binaryPath) {
+		return ""
+	}
+
+	checksum, err := filesystem.Checksum(binaryPath)
+	if err != nil {
+		if config.Verbose {
+			log.Debug().Err(err).Msgf("Could not compute checksum of %s", binaryPath)
+		}
+
+		return ""
+	}
+
+	if config.Verbose {
+		log.Debug().Msgf("SHA-256 of %s: %s", binaryPath, checksum)
+	}
+
+	return checksum
+}
+
+// confirmBundleRemoval checks that bundle removal is enabled and asks the user to confirm it.
+// Dry runs delete nothing and skip the prompt.
+func confirmBundleRemoval(deps Dependencies, config Config) error {
+	if !config.IncludeBundles {
+		return fmt.Errorf("%w: %s", ErrBundlesNotEnabled, config.Binary)
+	}
+
+	if config.DryRun {
+		return nil
+	}
+
+	confirmed, err := confirm(
+		fmt.Sprintf("Remove app bundle %s and all of its contents?", config.Binary),
+		false, deps.input(), deps.output(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if !confirmed {
+		return fmt.Errorf("%w: %s", ErrRemovalDeclined, config.Binary)
+	}
+
 	return nil
 }
+
+// resolveBinaryPath returns the file to remove for direct removal mode.
+// In path mode config.Binary is a literal path that must name a regular file;
+// otherwise it is a binary name joined to binDir.
+func resolveBinaryPath(filesystem fs.FS, binDir string, config Config) (string, error) {
+	if !config.PathMode {
+		return filesystem.AdjustBinaryPath(binDir, config.Binary), nil
+	}
+
+	binaryPath, err := filesystem.ResolveFilePath(config.Binary)
+	if err != nil {
+		return "", fmt.Errorf("invalid path %s: %w", config.Binary, err)
+	}
+
+	if err := checkSafeDir(config, filepath.Dir(binaryPath)); err != nil {
+		return "", err
+	}
+
+	return binaryPath, nil
+}
+
+// checkSafeDir returns ErrOutsideGoRoots when config.Safe is set and dir lies
+// outside every known Go root.
+func checkSafeDir(config Config, dir string) error {
+	if !config.Safe || fs.InGoRoots(dir) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrOutsideGoRoots, dir)
+}
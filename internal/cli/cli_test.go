@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/rs/zerolog"
@@ -19,6 +21,7 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	mockRunner "github.com/nicholas-fedor/go-remove/internal/cli/mocks"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
 	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
 	mockLogger "github.com/nicholas-fedor/go-remove/internal/logger/mocks"
 )
@@ -28,6 +31,14 @@ func mockNoOpRunner(tea.Model, ...tea.ProgramOption) (*tea.Program, error) {
 	return nil, nil //nolint:nilnil // Mock no-op runner returns nil values for test simplicity
 }
 
+// headlessRunner runs the TUI without a terminal, for sessions that end on
+// their own, such as an empty directory quitting once it is listed.
+func headlessRunner(m tea.Model, opts ...tea.ProgramOption) (*tea.Program, error) {
+	opts = append(opts, tea.WithInput(nil), tea.WithOutput(io.Discard))
+
+	return tea.NewProgram(m, opts...), nil
+}
+
 // newMockLoggerWithDefaults creates a MockLogger with default expectations for all methods.
 // This helper reduces boilerplate when setting up logger mocks for tests that don't need
 // to verify specific logger interactions.
@@ -132,7 +143,7 @@ func executeRun(deps Dependencies, config Config, runner ProgramRunner) error {
 	}
 
 	if config.Binary == "" {
-		err = RunTUI(binDir, config, log, deps.FS, runner, nil)
+		_, err = RunTUI(binDir, config, log, deps.FS, runner, nil, nil)
 	} else {
 		binaryPath := deps.FS.AdjustBinaryPath(binDir, config.Binary)
 
@@ -244,7 +255,6 @@ func TestRun(t *testing.T) {
 			setupFS: func(t *testing.T) *mockFS.MockFS { //nolint:thelper // Anonymous setup function, not a test helper
 				m := mockFS.NewMockFS(t)
 				m.On("DetermineBinDir", false).Return("/bin", nil)
-				m.On("ListBinaries", "/bin").Return([]string{"vhs"})
 
 				return m
 			},
@@ -263,15 +273,15 @@ func TestRun(t *testing.T) {
 			setupFS: func(t *testing.T) *mockFS.MockFS { //nolint:thelper // Anonymous setup function, not a test helper
 				m := mockFS.NewMockFS(t)
 				m.On("DetermineBinDir", false).Return("/bin", nil)
-				m.On("ListBinaries", "/bin").Return([]string{})
+				m.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{})
 
 				return m
 			},
 			setupLog: newMockLoggerWithDefaults,
 			setupRunner: func(t *testing.T) *mockRunner.MockProgramRunner { //nolint:thelper // Anonymous setup function, not a test helper
 				m := mockRunner.NewMockProgramRunner(t)
-				// RunProgram may not be called if RunTUI returns an error early
-				m.On("RunProgram", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+				// The program lists the empty directory and quits on its own.
+				m.On("RunProgram", mock.Anything, mock.Anything).Return(headlessRunner)
 
 				return m
 			},
@@ -343,7 +353,9 @@ func TestRun_WithLoggerSync(t *testing.T) {
 			setupFS: func(t *testing.T) *mockFS.MockFS {
 				m := mockFS.NewMockFS(t)
 				m.On("DetermineBinDir", false).Return("/bin", nil)
+				m.On("ListBinaries", "/bin", mock.Anything).Return([]string{"tool"})
 				m.On("AdjustBinaryPath", "/bin", "tool").Return("/bin/tool")
+				m.On("BinarySize", "/bin/tool").Return(int64(0), nil)
 				m.On("RemoveBinary", "/bin/tool", "tool", false, mock.Anything).Return(nil)
 
 				return m
@@ -430,8 +442,11 @@ func TestRun_WithLoggerSync(t *testing.T) {
 func TestRun_VerboseMode(t *testing.T) {
 	m := mockFS.NewMockFS(t)
 	m.On("DetermineBinDir", false).Return("/bin", nil)
+	m.On("ListBinaries", "/bin", mock.Anything).Return([]string{"vhs"})
 	m.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+	m.On("BinarySize", "/bin/vhs").Return(int64(0), nil)
 	m.On("RemoveBinary", "/bin/vhs", "vhs", true, mock.Anything).Return(nil)
+	m.On("Checksum", "/bin/vhs").Return("abc123", nil)
 
 	mockLog := mockLogger.NewMockLogger(t)
 	nopLog := zerolog.New(io.Discard)
@@ -470,3 +485,337 @@ func TestRun_VerboseMode(t *testing.T) {
 	m.AssertExpectations(t)
 	mockLog.AssertExpectations(t)
 }
+
+// TestRun_PathMode verifies literal path removal bypasses the binary directory lookup.
+func TestRun_PathMode(t *testing.T) {
+	tests := []struct {
+		name       string
+		binary     string
+		setupFS    func(t *testing.T) *mockFS.MockFS
+		wantErr    error
+		wantOutput string
+	}{
+		{
+			name:   "absolute path",
+			binary: "/opt/tools/tool",
+			setupFS: func(t *testing.T) *mockFS.MockFS { //nolint:thelper // Anonymous setup function, not a test helper
+				m := mockFS.NewMockFS(t)
+				m.On("ResolveFilePath", "/opt/tools/tool").Return("/opt/tools/tool", nil)
+				m.On("BinarySize", "/opt/tools/tool").Return(int64(0), nil)
+				m.On("RemoveBinary", "/opt/tools/tool", "/opt/tools/tool", false, mock.Anything).
+					Return(nil)
+
+				return m
+			},
+			wantOutput: "Successfully removed /opt/tools/tool\n",
+		},
+		{
+			name:   "relative path",
+			binary: "./bin/tool",
+			setupFS: func(t *testing.T) *mockFS.MockFS { //nolint:thelper // Anonymous setup function, not a test helper
+				m := mockFS.NewMockFS(t)
+				m.On("ResolveFilePath", "./bin/tool").Return("/work/bin/tool", nil)
+				m.On("BinarySize", "/work/bin/tool").Return(int64(0), nil)
+				m.On("RemoveBinary", "/work/bin/tool", "./bin/tool", false, mock.Anything).Return(nil)
+
+				return m
+			},
+			wantOutput: "Successfully removed ./bin/tool\n",
+		},
+		{
+			name:   "directory rejected",
+			binary: "./bin",
+			setupFS: func(t *testing.T) *mockFS.MockFS { //nolint:thelper // Anonymous setup function, not a test helper
+				m := mockFS.NewMockFS(t)
+				m.On("ResolveFilePath", "./bin").
+					Return("", fmt.Errorf("%w: /work/bin", fs.ErrNotRegularFile))
+
+				return m
+			},
+			wantErr: fs.ErrNotRegularFile,
+		},
+		{
+			name:   "missing path argument",
+			binary: "",
+			setupFS: func(t *testing.T) *mockFS.MockFS { //nolint:thelper // Anonymous setup function, not a test helper
+				return mockFS.NewMockFS(t)
+			},
+			wantErr: ErrPathRequiresBinary,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{
+				FS:     tt.setupFS(t), // DetermineBinDir is never expected
+				Logger: newMockLoggerWithDefaults(t),
+			}
+
+			err := Run(deps, Config{Binary: tt.binary, PathMode: true})
+			gotOutput := getOutput()
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if gotOutput != tt.wantOutput {
+				t.Errorf("Run() output = %q, want %q", gotOutput, tt.wantOutput)
+			}
+		})
+	}
+}
+
+// TestRun_PruneEmpty verifies --prune-empty removes an emptied scratch directory
+// but leaves standard directories alone and never prunes during a dry run.
+func TestRun_PruneEmpty(t *testing.T) {
+	tests := []struct {
+		name       string
+		dryRun     bool
+		removed    bool
+		pruneErr   error
+		wantOutput string
+	}{
+		{
+			name:       "empty scratch directory",
+			removed:    true,
+			wantOutput: "Successfully removed /scratch/tool\nRemoved empty directory /scratch\n",
+		},
+		{
+			name:       "directory not empty",
+			wantOutput: "Successfully removed /scratch/tool\n",
+		},
+		{
+			name:       "standard directory",
+			pruneErr:   fmt.Errorf("%w: /scratch", fs.ErrProtectedDir),
+			wantOutput: "Successfully removed /scratch/tool\n",
+		},
+		{
+			name:       "dry run",
+			dryRun:     true,
+			wantOutput: "Would remove /scratch/tool\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesystem := mockFS.NewMockFS(t)
+			filesystem.On("ResolveFilePath", "/scratch/tool").Return("/scratch/tool", nil)
+			filesystem.On("BinarySize", "/scratch/tool").Return(int64(0), nil)
+
+			if !tt.dryRun {
+				filesystem.On("RemoveBinary", "/scratch/tool", "/scratch/tool", false, mock.Anything).
+					Return(nil)
+				filesystem.On("PruneEmptyDir", "/scratch").Return(tt.removed, tt.pruneErr)
+			}
+
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+			config := Config{Binary: "/scratch/tool", PathMode: true, PruneEmpty: true, DryRun: tt.dryRun}
+
+			if err := Run(deps, config); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			if got := getOutput(); got != tt.wantOutput {
+				t.Errorf("Run() output = %q, want %q", got, tt.wantOutput)
+			}
+		})
+	}
+}
+
+// TestRun_CleanDangling verifies --clean-dangling removes only the symlinks
+// left dangling by the removal, and lists them without removing in a dry run.
+func TestRun_CleanDangling(t *testing.T) {
+	links := []fs.Symlink{
+		{Path: "/bin/tool", Target: "/scratch/tool", Dangling: true},
+		{Path: "/bin/stale", Target: "/scratch/stale", Dangling: true},
+		{Path: "/bin/other", Target: "/scratch/other"},
+	}
+
+	tests := []struct {
+		name       string
+		dryRun     bool
+		wantOutput string
+	}{
+		{name: "removal", wantOutput: "Successfully removed /scratch/tool\n"},
+		{name: "dry run", dryRun: true, wantOutput: "Would remove /scratch/tool\nWould remove symlink /bin/tool\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesystem := mockFS.NewMockFS(t)
+			filesystem.On("ResolveFilePath", "/scratch/tool").Return("/scratch/tool", nil)
+			filesystem.On("BinarySize", "/scratch/tool").Return(int64(0), nil)
+			filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+			filesystem.On("Symlinks", "/scratch").Return(nil, nil)
+			filesystem.On("Symlinks", "/bin").Return(links, nil)
+
+			if !tt.dryRun {
+				filesystem.On("RemoveBinary", "/scratch/tool", "/scratch/tool", false, mock.Anything).Return(nil)
+				filesystem.On("RemoveBinary", "/bin/tool", "tool", false, mock.Anything).Return(nil)
+			}
+
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+			config := Config{Binary: "/scratch/tool", PathMode: true, CleanDangling: true, DryRun: tt.dryRun}
+
+			if err := Run(deps, config); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			if got := getOutput(); got != tt.wantOutput {
+				t.Errorf("Run() output = %q, want %q", got, tt.wantOutput)
+			}
+
+			filesystem.AssertNotCalled(t, "RemoveBinary", "/bin/stale", mock.Anything, mock.Anything, mock.Anything)
+			filesystem.AssertNotCalled(t, "RemoveBinary", "/bin/other", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestRun_Safe verifies --safe allows directories inside the Go roots and
+// refuses ones outside them before anything is removed.
+func TestRun_Safe(t *testing.T) {
+	gopath := t.TempDir()
+	t.Setenv("GOPATH", gopath)
+	t.Setenv("GOBIN", "")
+
+	goBin := filepath.Join(gopath, "bin")
+	outside := t.TempDir()
+
+	tests := []struct {
+		name    string
+		config  Config
+		setupFS func(t *testing.T) *mockFS.MockFS
+		wantErr error
+	}{
+		{
+			name:   "bin directory inside GOPATH",
+			config: Config{Binary: "vhs", Safe: true},
+			setupFS: func(t *testing.T) *mockFS.MockFS { //nolint:thelper // Anonymous setup function, not a test helper
+				m := mockFS.NewMockFS(t)
+				m.On("DetermineBinDir", false).Return(goBin, nil)
+				m.On("ListBinaries", goBin, mock.Anything).Return([]string{"vhs"})
+				m.On("AdjustBinaryPath", goBin, "vhs").Return(filepath.Join(goBin, "vhs"))
+				m.On("BinarySize", filepath.Join(goBin, "vhs")).Return(int64(0), nil)
+				m.On("RemoveBinary", filepath.Join(goBin, "vhs"), "vhs", false, mock.Anything).Return(nil)
+
+				return m
+			},
+		},
+		{
+			name:   "bin directory outside the Go roots",
+			config: Config{Binary: "vhs", Safe: true},
+			setupFS: func(t *testing.T) *mockFS.MockFS { //nolint:thelper // Anonymous setup function, not a test helper
+				m := mockFS.NewMockFS(t)
+				m.On("DetermineBinDir", false).Return(outside, nil)
+
+				return m
+			},
+			wantErr: ErrOutsideGoRoots,
+		},
+		{
+			name:   "path outside the Go roots",
+			config: Config{Binary: filepath.Join(outside, "tool"), PathMode: true, Safe: true},
+			setupFS: func(t *testing.T) *mockFS.MockFS { //nolint:thelper // Anonymous setup function, not a test helper
+				m := mockFS.NewMockFS(t)
+				m.On("ResolveFilePath", filepath.Join(outside, "tool")).
+					Return(filepath.Join(outside, "tool"), nil)
+
+				return m
+			},
+			wantErr: ErrOutsideGoRoots,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureStdout(t)
+
+			// RemoveBinary is only expected when the directory is allowed.
+			deps := Dependencies{FS: tt.setupFS(t), Logger: newMockLoggerWithDefaults(t)}
+
+			if err := Run(deps, tt.config); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestRun_Bundle verifies app bundles require opting in and an explicit confirmation.
+func TestRun_Bundle(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     Config
+		input      string
+		remove     bool
+		wantErr    error
+		wantOutput string
+	}{
+		{
+			name:    "not enabled",
+			config:  Config{Binary: "Viewer.app"},
+			wantErr: ErrBundlesNotEnabled,
+		},
+		{
+			name:       "confirmed",
+			config:     Config{Binary: "Viewer.app", IncludeBundles: true},
+			input:      "y\n",
+			remove:     true,
+			wantOutput: "Remove app bundle Viewer.app and all of its contents? [y/N] Successfully removed Viewer.app\n",
+		},
+		{
+			name:       "declined",
+			config:     Config{Binary: "Viewer.app", IncludeBundles: true},
+			input:      "\n",
+			wantErr:    ErrRemovalDeclined,
+			wantOutput: "Remove app bundle Viewer.app and all of its contents? [y/N] ",
+		},
+		{
+			name:       "dry run skips prompt",
+			config:     Config{Binary: "Viewer.app", IncludeBundles: true, DryRun: true},
+			wantOutput: "Would remove Viewer.app\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesystem := mockFS.NewMockFS(t)
+			filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+			filesystem.On("ListBinaries", "/bin", mock.Anything).Return([]string{"Viewer.app"})
+			filesystem.On("AdjustBinaryPath", "/bin", "Viewer.app").Return("/bin/Viewer.app")
+
+			if tt.remove {
+				filesystem.On("RemoveBinary", "/bin/Viewer.app", "Viewer.app", false, mock.Anything).
+					Return(nil)
+			}
+
+			if tt.config.DryRun {
+				filesystem.On("BinarySize", "/bin/Viewer.app").Return(int64(4096), nil)
+			}
+
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{
+				FS:     filesystem,
+				Logger: newMockLoggerWithDefaults(t),
+				Input:  strings.NewReader(tt.input),
+			}
+
+			err := Run(deps, tt.config)
+			gotOutput := getOutput()
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if gotOutput != tt.wantOutput {
+				t.Errorf("Run() output = %q, want %q", gotOutput, tt.wantOutput)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+)
+
+// markCorrupt records why each entry looks corrupt, such as a zero-byte or
+// truncated file left by an interrupted install. Files that cannot be read
+// are left unmarked rather than guessed at.
+func markCorrupt(filesystem fs.FS, entries []ListEntry) {
+	for i, entry := range entries {
+		if reason, err := filesystem.CorruptReason(entry.Path); err == nil {
+			entries[i].Corrupt = reason
+		}
+	}
+}
+
+// writeCorrupt prints each binary that looks corrupt, with the reason, as
+// candidates for removal.
+func writeCorrupt(entries []ListEntry) {
+	var corrupt []ListEntry
+
+	for _, entry := range entries {
+		if entry.Corrupt != "" {
+			corrupt = append(corrupt, entry)
+		}
+	}
+
+	if len(corrupt) == 0 {
+		return
+	}
+
+	fmt.Fprintf(os.Stdout, "\nLikely corrupt %d:\n", len(corrupt))
+
+	for _, entry := range corrupt {
+		fmt.Fprintf(os.Stdout, "  %s (%s)\n", entry.Name, entry.Corrupt)
+	}
+}
+
+// checkCorrupt returns why each of names in dir looks corrupt, with "" for
+// those that look intact, skipping names already in known. Unreadable files
+// count as intact.
+func checkCorrupt(filesystem fs.FS, dir string, names []string, known map[string]string) map[string]string {
+	reasons := make(map[string]string, len(names))
+
+	for _, name := range names {
+		if _, ok := known[name]; ok {
+			continue
+		}
+
+		reason, _ := filesystem.CorruptReason(filesystem.AdjustBinaryPath(dir, name))
+		reasons[name] = reason
+	}
+
+	return reasons
+}
@@ -0,0 +1,70 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// cleanDanglingLinks removes the symlinks left pointing at binaryPath once it
+// is gone, looking in the binary's own directory and in the target binary
+// directory, where aliases to binaries kept elsewhere usually live. A dry run
+// lists the links instead. Links are only ever removed if they dangle, and a
+// failure to clean one never fails the removal.
+func cleanDanglingLinks(deps Dependencies, binaryPath string, config Config) {
+	target, err := filepath.Abs(binaryPath)
+	if err != nil {
+		target = filepath.Clean(binaryPath)
+	}
+
+	for _, dir := range linkDirs(deps, target, config) {
+		links, err := deps.FS.Symlinks(dir)
+		if err != nil {
+			deps.Logger.Warn().Err(err).Msgf("Could not scan %s for dangling symlinks", dir)
+
+			continue
+		}
+
+		for _, link := range links {
+			if link.Target != target {
+				continue
+			}
+
+			if config.DryRun {
+				fmt.Fprintln(os.Stdout, "Would remove symlink "+link.Path)
+
+				continue
+			}
+
+			if !link.Dangling {
+				continue
+			}
+
+			if err := deps.FS.RemoveBinary(link.Path, filepath.Base(link.Path), config.Verbose, deps.Logger); err != nil {
+				deps.Logger.Warn().Err(err).Msgf("Could not remove dangling symlink %s", link.Path)
+
+				continue
+			}
+
+			deps.Logger.Info().Msgf("Removed dangling symlink %s -> %s", link.Path, link.Target)
+		}
+	}
+}
+
+// linkDirs returns the directories to scan for links to target: its own
+// directory and, when it can be determined and differs, the binary directory.
+func linkDirs(deps Dependencies, target string, config Config) []string {
+	dirs := []string{filepath.Dir(target)}
+
+	binDir, err := deps.FS.DetermineBinDir(config.Goroot)
+	if err == nil && binDir != "" && filepath.Clean(binDir) != dirs[0] {
+		dirs = append(dirs, filepath.Clean(binDir))
+	}
+
+	return dirs
+}
@@ -0,0 +1,103 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"io"
+	"slices"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+)
+
+// Colors used by the --show-diff output.
+const (
+	diffRemovedColor = "196" // Red
+	diffAddedColor   = "46"  // Lime green
+)
+
+// dirDiff lists how a binary directory changed between two listings.
+type dirDiff struct {
+	Removed []string // Names present before but not after
+	Added   []string // Names present after but not before
+}
+
+// diffListings compares two listings of a directory. Both results are sorted.
+func diffListings(before, after []string) dirDiff {
+	var diff dirDiff
+
+	for _, name := range before {
+		if !slices.Contains(after, name) {
+			diff.Removed = append(diff.Removed, name)
+		}
+	}
+
+	for _, name := range after {
+		if !slices.Contains(before, name) {
+			diff.Added = append(diff.Added, name)
+		}
+	}
+
+	slices.Sort(diff.Removed)
+	slices.Sort(diff.Added)
+
+	return diff
+}
+
+// withoutRemovals returns the listing as it would look once removals were
+// deleted, for showing a dry run's hypothetical diff.
+func withoutRemovals(listing []string, removals []Removal) []string {
+	return slices.DeleteFunc(slices.Clone(listing), func(name string) bool {
+		return slices.ContainsFunc(removals, func(removal Removal) bool {
+			return removal.Name == name
+		})
+	})
+}
+
+// diffSnapshot lists binDir for --show-diff, including hidden files so every
+// binary a batch can touch is compared.
+func diffSnapshot(deps Dependencies, binDir string, config Config) []string {
+	return deps.FS.ListBinaries(binDir, fs.ListOptions{
+		ShowHidden:           true,
+		IncludeBundles:       config.IncludeBundles,
+		IncludeNonExecutable: config.IncludeNonExecutable,
+	})
+}
+
+// String renders the diff one name per line, "- vhs" for removed binaries and
+// "+ vhs" for added ones. An unchanged directory renders as "".
+func (d dirDiff) String() string {
+	return d.render(lipgloss.NewStyle(), lipgloss.NewStyle())
+}
+
+// render renders the diff like String, styling removed and added lines.
+func (d dirDiff) render(removedStyle, addedStyle lipgloss.Style) string {
+	var b strings.Builder
+
+	for _, name := range d.Removed {
+		b.WriteString(removedStyle.Render("- "+name) + "\n")
+	}
+
+	for _, name := range d.Added {
+		b.WriteString(addedStyle.Render("+ "+name) + "\n")
+	}
+
+	return b.String()
+}
+
+// diffStyles returns the styles for removed and added names.
+func diffStyles() (removedStyle, addedStyle lipgloss.Style) {
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(diffRemovedColor)),
+		lipgloss.NewStyle().Foreground(lipgloss.Color(diffAddedColor))
+}
+
+// printDiff writes the diff to w in color, which lipgloss drops when w is not
+// a color terminal.
+func printDiff(w io.Writer, diff dirDiff) {
+	_, _ = lipgloss.Fprint(w, diff.render(diffStyles()))
+}
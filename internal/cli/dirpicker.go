@@ -0,0 +1,192 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// Errors returned by the directory picker.
+var (
+	// ErrDirPickerCanceled indicates the user dismissed the directory picker.
+	ErrDirPickerCanceled = errors.New("directory selection canceled")
+
+	// ErrEmptyDir indicates the directory picker was confirmed without a directory.
+	ErrEmptyDir = errors.New("enter a directory")
+
+	// ErrNotDirectory indicates the chosen path exists but is not a directory.
+	ErrNotDirectory = errors.New("not a directory")
+)
+
+// dirPicker is a single-line prompt for choosing the binary directory when it
+// cannot be determined from the environment.
+type dirPicker struct {
+	cause    error       // Why the directory could not be determined
+	input    string      // Directory typed so far
+	problem  string      // Validation message for the last confirmed input
+	dir      string      // Confirmed directory; empty until the input is accepted
+	canceled bool        // Whether the user dismissed the prompt
+	styles   styleConfig // TUI appearance settings
+}
+
+// PickBinDir asks the user for the binary directory after cause prevented it
+// from being determined, such as GOROOT being unset with --goroot.
+//
+// The prompt is pre-filled with ~/go/bin when a home directory is available.
+//
+// Parameters:
+//   - cause: Error returned while determining the binary directory
+//   - runner: Program runner used to display the prompt
+//
+// Returns:
+//   - The absolute path of the chosen directory
+//   - An error wrapping cause if the prompt is canceled or cannot be shown
+func PickBinDir(cause error, runner ProgramRunner) (string, error) {
+	picker := newDirPicker(cause)
+
+	program, err := runner.RunProgram(picker)
+	if err != nil {
+		return "", fmt.Errorf("%w; failed to start directory picker: %w", cause, err)
+	}
+
+	// Allow mocked runners to return nil for testing purposes.
+	if program != nil {
+		if _, err := program.Run(); err != nil {
+			return "", fmt.Errorf("%w; failed to run directory picker: %w", cause, err)
+		}
+	}
+
+	if picker.canceled || picker.dir == "" {
+		return "", fmt.Errorf("%w: %w", ErrDirPickerCanceled, cause)
+	}
+
+	return picker.dir, nil
+}
+
+// newDirPicker creates a directory picker suggesting ~/go/bin.
+func newDirPicker(cause error) *dirPicker {
+	picker := &dirPicker{cause: cause, styles: defaultStyleConfig()}
+
+	if home, err := os.UserHomeDir(); err == nil {
+		picker.input = filepath.Join(home, "go", "bin")
+	}
+
+	return picker
+}
+
+// Init implements tea.Model; the picker needs no startup commands.
+func (p *dirPicker) Init() tea.Cmd {
+	return nil
+}
+
+// Update processes key events: text edits the input, Enter validates and
+// accepts it, and Esc or Ctrl+C cancels.
+func (p *dirPicker) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return p, nil
+	}
+
+	switch keyMsg.String() {
+	case "ctrl+c", "esc":
+		p.canceled = true
+
+		return p, tea.Quit
+
+	case "enter":
+		dir, err := validateBinDir(p.input)
+		if err != nil {
+			p.problem = err.Error()
+
+			return p, nil
+		}
+
+		p.dir = dir
+
+		return p, tea.Quit
+
+	case "backspace":
+		if runes := []rune(p.input); len(runes) > 0 {
+			p.input = string(runes[:len(runes)-1])
+		}
+
+	default:
+		if text := keyMsg.Key().Text; text != "" {
+			p.input += text
+		}
+	}
+
+	p.problem = ""
+
+	return p, nil
+}
+
+// View renders the cause, the input line, any validation problem, and the key hints.
+func (p *dirPicker) View() tea.View {
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(p.styles.TitleColor))
+	statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(p.styles.StatusColor))
+	footerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(p.styles.FooterColor))
+
+	var s strings.Builder
+
+	s.WriteString(titleStyle.Render("Could not determine the binary directory"))
+	s.WriteString("\n")
+	s.WriteString(p.cause.Error())
+	s.WriteString("\n\n")
+	s.WriteString("Directory: " + p.input + "_\n")
+
+	if p.problem != "" {
+		s.WriteString(statusStyle.Render(p.problem))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(footerStyle.Render("Enter: confirm  Esc: cancel"))
+	s.WriteString("\n")
+
+	return tea.NewView(s.String())
+}
+
+// validateBinDir resolves input to an absolute directory, expanding a leading "~".
+func validateBinDir(input string) (string, error) {
+	dir := strings.TrimSpace(input)
+	if dir == "" {
+		return "", ErrEmptyDir
+	}
+
+	if dir == "~" || strings.HasPrefix(dir, "~"+string(filepath.Separator)) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to expand ~: %w", err)
+		}
+
+		dir = filepath.Join(home, dir[1:])
+	}
+
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", input, err)
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		return "", fmt.Errorf("cannot use %s: %w", dir, err)
+	}
+
+	if !info.IsDir() {
+		return "", fmt.Errorf("%w: %s", ErrNotDirectory, dir)
+	}
+
+	return dir, nil
+}
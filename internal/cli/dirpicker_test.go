@@ -0,0 +1,132 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	tea "charm.land/bubbletea/v2"
+
+	mockRunner "github.com/nicholas-fedor/go-remove/internal/cli/mocks"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+)
+
+// TestPickBinDir verifies the chosen directory is returned and that a
+// canceled prompt reports the original cause.
+func TestPickBinDir(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		keys    []tea.KeyPressMsg
+		want    string
+		wantErr error
+	}{
+		{
+			name: "confirmed directory",
+			keys: append(typeKeys(dir), keyPressString(keyEnter)),
+			want: dir,
+		},
+		{
+			name:    "canceled",
+			keys:    []tea.KeyPressMsg{{Code: tea.KeyEscape}},
+			wantErr: ErrDirPickerCanceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := mockRunner.NewMockProgramRunner(t)
+			runner.On("RunProgram", mock.Anything).
+				Run(func(args mock.Arguments) {
+					picker := args.Get(0).(*dirPicker)
+					picker.input = ""
+
+					for _, key := range tt.keys {
+						picker.Update(key)
+					}
+				}).
+				Return(nil, nil)
+
+			got, err := PickBinDir(fs.ErrGorootNotSet, runner)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PickBinDir() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil && !errors.Is(err, fs.ErrGorootNotSet) {
+				t.Errorf("PickBinDir() error = %v, want it to wrap the cause", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("PickBinDir() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_dirPicker_Update verifies editing, and that an invalid directory keeps
+// the prompt open with a problem message.
+func Test_dirPicker_Update(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tool")
+	if err := os.WriteFile(file, []byte("test"), 0o600); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+
+	picker := &dirPicker{cause: fs.ErrGorootNotSet}
+
+	for _, key := range typeKeys(file + "x") {
+		picker.Update(key)
+	}
+
+	picker.Update(tea.KeyPressMsg{Code: tea.KeyBackspace})
+	assert.Equal(t, file, picker.input)
+
+	_, cmd := picker.Update(keyPressString(keyEnter))
+	assert.Nil(t, cmd)
+	assert.Empty(t, picker.dir)
+	assert.Contains(t, picker.problem, ErrNotDirectory.Error())
+	assert.Contains(t, picker.View().Content, "GOROOT is not set")
+
+	// Editing again clears the problem.
+	picker.Update(tea.KeyPressMsg{Code: tea.KeyBackspace})
+	assert.Empty(t, picker.problem)
+}
+
+// Test_validateBinDir verifies empty input, missing paths, and home expansion.
+func Test_validateBinDir(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+
+	if _, err := validateBinDir("  "); !errors.Is(err, ErrEmptyDir) {
+		t.Errorf("validateBinDir() error = %v, want %v", err, ErrEmptyDir)
+	}
+
+	if _, err := validateBinDir(filepath.Join(home, "missing")); err == nil {
+		t.Error("validateBinDir() error = nil for a missing directory")
+	}
+
+	got, err := validateBinDir("~")
+	if err != nil || got != home {
+		t.Errorf("validateBinDir(~) = %q, %v, want %q", got, err, home)
+	}
+}
+
+// typeKeys converts text into the key presses that would type it.
+func typeKeys(text string) []tea.KeyPressMsg {
+	keys := make([]tea.KeyPressMsg, 0, len(text))
+	for _, r := range text {
+		keys = append(keys, keyPress(r))
+	}
+
+	return keys
+}
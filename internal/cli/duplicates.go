@@ -0,0 +1,159 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/nicholas-fedor/go-remove/internal/format"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	"github.com/nicholas-fedor/go-remove/internal/logger"
+)
+
+// duplicateGroup is a set of binaries with identical contents.
+type duplicateGroup struct {
+	names []string // Binary names in listing order; the first is the copy kept
+	size  int64    // Size of each copy in bytes
+}
+
+// RunDuplicates reports binaries in the target directory whose contents are
+// identical, one group per line, and how much space the redundant copies use.
+//
+// Only the report is printed unless config.Interactive is set, in which case
+// every copy after the first in each group is offered for removal as a batch,
+// with the same y/n/a/q prompt as --interactive. The same listing rules as
+// --all apply, except that app bundles are never compared.
+func RunDuplicates(ctx context.Context, deps Dependencies, config Config) error {
+	binDir, err := deps.FS.DetermineBinDir(config.Goroot)
+	if err != nil {
+		_ = deps.Logger.Sync() // Flush logs; errors are ignored
+
+		return fmt.Errorf("failed to determine binary directory: %w", err)
+	}
+
+	if err := checkSafeDir(config, binDir); err != nil {
+		_ = deps.Logger.Sync()
+
+		return err
+	}
+
+	names := deps.FS.ListBinaries(binDir, fs.ListOptions{
+		ShowHidden:           config.ShowHidden,
+		IncludeNonExecutable: config.IncludeNonExecutable,
+		Match:                config.Match,
+		MinSize:              config.MinSize,
+		MaxSize:              config.MaxSize,
+		OlderThan:            config.OlderThan,
+		NewerThan:            config.NewerThan,
+	})
+
+	groups := findDuplicates(deps.FS, deps.Logger, binDir, names)
+	if len(groups) == 0 {
+		fmt.Fprintln(os.Stdout, "No duplicate binaries in "+binDir)
+
+		_ = deps.Logger.Sync() // Errors are ignored
+
+		return nil
+	}
+
+	var (
+		redundant []string
+		wasted    int64
+	)
+
+	for _, group := range groups {
+		fmt.Fprintf(os.Stdout, "%s (%s each)\n", strings.Join(group.names, ", "), format.Bytes(group.size))
+
+		redundant = append(redundant, group.names[1:]...)
+		wasted += group.size * int64(len(group.names)-1)
+	}
+
+	noun := "copies use"
+	if len(redundant) == 1 {
+		noun = "copy uses"
+	}
+
+	fmt.Fprintf(os.Stdout, "%d redundant %s %s\n", len(redundant), noun, format.Bytes(wasted))
+
+	if !config.Interactive {
+		_ = deps.Logger.Sync() // Errors are ignored
+
+		return nil
+	}
+
+	return runBatch(ctx, deps, binDir, config, redundant)
+}
+
+// findDuplicates groups the named binaries in binDir by content. Only
+// binaries of equal size are hashed, so a directory without duplicates costs
+// one stat per binary. A binary that cannot be read is logged and left out.
+// Groups are ordered by their first name, and names within a group keep the
+// order they were given in.
+func findDuplicates(filesystem fs.FS, log logger.Logger, binDir string, names []string) []duplicateGroup {
+	bySize := make(map[int64][]string)
+
+	var sizes []int64
+
+	for _, name := range names {
+		size, err := filesystem.BinarySize(filesystem.AdjustBinaryPath(binDir, name))
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping %s when looking for duplicates", name)
+
+			continue
+		}
+
+		if _, seen := bySize[size]; !seen {
+			sizes = append(sizes, size)
+		}
+
+		bySize[size] = append(bySize[size], name)
+	}
+
+	var groups []duplicateGroup
+
+	for _, size := range sizes {
+		candidates := bySize[size]
+		if len(candidates) < 2 {
+			continue
+		}
+
+		byHash := make(map[string]*duplicateGroup)
+
+		var order []string
+
+		for _, name := range candidates {
+			hash, err := filesystem.Checksum(filesystem.AdjustBinaryPath(binDir, name))
+			if err != nil {
+				log.Warn().Err(err).Msgf("Skipping %s when looking for duplicates", name)
+
+				continue
+			}
+
+			group, ok := byHash[hash]
+			if !ok {
+				group = &duplicateGroup{size: size}
+				byHash[hash] = group
+				order = append(order, hash)
+			}
+
+			group.names = append(group.names, name)
+		}
+
+		for _, hash := range order {
+			if group := byHash[hash]; len(group.names) > 1 {
+				groups = append(groups, *group)
+			}
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return groups[i].names[0] < groups[j].names[0] })
+
+	return groups
+}
@@ -0,0 +1,67 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// Test_findDuplicates verifies identical files are grouped and a distinct
+// file of the same size is not.
+func Test_findDuplicates(t *testing.T) {
+	dir := t.TempDir()
+
+	for name, contents := range map[string]string{
+		"gopls":       "same contents",
+		"gopls-copy":  "same contents",
+		"staticcheck": "diff contents",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o755))
+	}
+
+	names := []string{"gopls", "gopls-copy", "staticcheck"}
+	got := findDuplicates(fs.NewRealFS(), &tuiMockLogger{}, dir, names)
+
+	want := []duplicateGroup{{names: []string{"gopls", "gopls-copy"}, size: int64(len("same contents"))}}
+	assert.Equal(t, want, got)
+}
+
+// TestRunDuplicates verifies the report lists each group and the space the
+// redundant copies use, and removes nothing without --interactive.
+func TestRunDuplicates(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", mock.Anything).Return([]string{"age", "age.bak", "gopls", "vhs"})
+
+	for name, size := range map[string]int64{"age": 1_500_000, "age.bak": 1_500_000, "gopls": 1_500_000, "vhs": 2_000_000} {
+		filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+		filesystem.On("BinarySize", "/bin/"+name).Return(size, nil)
+	}
+
+	filesystem.On("Checksum", "/bin/age").Return("aaaa", nil)
+	filesystem.On("Checksum", "/bin/age.bak").Return("aaaa", nil)
+	filesystem.On("Checksum", "/bin/gopls").Return("bbbb", nil)
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	err := RunDuplicates(context.Background(), deps, Config{})
+	gotOutput := getOutput()
+
+	require.NoError(t, err)
+	assert.Equal(t, "age, age.bak (1.5 MB each)\n1 redundant copy uses 1.5 MB\n", gotOutput)
+	filesystem.AssertNotCalled(t, "RemoveBinary", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"github.com/nicholas-fedor/go-remove/internal/events"
+)
+
+// emitter returns the configured event emitter, defaulting to one that discards events.
+func (d Dependencies) emitter() events.Emitter {
+	if d.Events != nil {
+		return d.Events
+	}
+
+	return events.Nop{}
+}
+
+// emit publishes event. A listener that goes away must never interrupt
+// removals, so failures are only logged.
+func emit(deps Dependencies, event events.Event) {
+	if err := deps.emitter().Emit(event); err != nil {
+		deps.Logger.Debug().Err(err).Msg("Failed to emit event")
+	}
+}
+
+// emitStart announces the binaries a session is about to remove.
+func emitStart(deps Dependencies, config Config, names []string) {
+	emit(deps, events.Event{Type: events.TypeStart, DryRun: config.DryRun, Names: names})
+}
+
+// emitRemoval reports the outcome of removing config.Binary.
+func emitRemoval(deps Dependencies, config Config, removal Removal, err error) {
+	event := events.Event{
+		Type:   events.TypeRemoval,
+		DryRun: config.DryRun,
+		Name:   config.Binary,
+		Path:   removal.Path,
+		Size:   removal.Size,
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	emit(deps, event)
+}
+
+// emitSummary reports how many binaries were removed and how much space they freed.
+func emitSummary(deps Dependencies, config Config, removals []Removal, failed int) {
+	emit(deps, events.Event{
+		Type:   events.TypeSummary,
+		DryRun: config.DryRun,
+		Count:  len(removals),
+		Failed: failed,
+		Freed:  freedSize(removals),
+	})
+}
@@ -0,0 +1,357 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/nicholas-fedor/go-remove/internal/format"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+)
+
+// sizeUnits maps the lowercase unit suffixes accepted by ParseSize to their sizes in bytes.
+var sizeUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"k":   format.KB,
+	"kb":  format.KB,
+	"m":   format.MB,
+	"mb":  format.MB,
+	"g":   format.GB,
+	"gb":  format.GB,
+	"t":   format.TB,
+	"tb":  format.TB,
+	"kib": format.KiB,
+	"mib": format.MiB,
+	"gib": format.GiB,
+	"tib": format.TiB,
+}
+
+// ErrInvalidSize indicates a size that is not a non-negative number with an optional unit.
+var ErrInvalidSize = errors.New("invalid size")
+
+// ErrInvalidAge indicates an age that is not a non-negative duration such as 36h, 7d, or 2w.
+var ErrInvalidAge = errors.New("invalid age")
+
+// ageUnits maps the day and week suffixes ParseAge accepts beyond those of
+// time.ParseDuration to their lengths.
+var ageUnits = map[string]time.Duration{
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// ListEntry describes a single binary in list output.
+type ListEntry struct {
+	Name    string    `json:"name"` // Binary file name
+	Path    string    `json:"path"` // Full path to the binary
+	Size    int64     `json:"size"` // Size in bytes
+	ModTime time.Time `json:"-"`    // Last modification time, shown by long output
+
+	// ShadowedBy is the file that runs instead when an earlier PATH entry
+	// shadows this binary. It is only set with --check-path.
+	ShadowedBy string `json:"shadowedBy,omitempty"`
+
+	// Module is the module path the binary was built from. It is only set
+	// with --group-by-module, and stays empty when build info is unreadable.
+	Module string `json:"module,omitempty"`
+
+	// Corrupt says why the binary looks corrupt, e.g. "empty file". It is
+	// only set with --check-corrupt.
+	Corrupt string `json:"corrupt,omitempty"`
+}
+
+// ListSummary wraps list entries with aggregate totals for JSON output.
+type ListSummary struct {
+	Binaries  []ListEntry `json:"binaries"`  // Listed binaries
+	Count     int         `json:"count"`     // Number of binaries
+	TotalSize int64       `json:"totalSize"` // Combined size in bytes
+}
+
+// RunList prints the binaries found in the target directory.
+//
+// Output is one name per line, or a JSON array when config.JSON is set.
+// When config.Summary is set, a totals line is appended to text output and
+// JSON output is wrapped in a ListSummary object. When config.ShowSkipped is
+// set, text output ends with the directory entries that were excluded and why.
+// When config.Long is set, text output includes each binary's size and when
+// it was last modified, in config.TimeFormat. When config.CheckPath is set, binaries that
+// an earlier PATH entry shadows are reported, since removing them does not
+// change what runs. When config.CheckCorrupt is set, zero-byte, headerless,
+// and truncated binaries are reported as likely corrupt. When
+// config.GroupByModule is set, text output groups binaries under the module
+// they were built from and JSON entries carry it.
+// When config.Null is set, each name ends with a NUL byte instead of a
+// newline, so names with spaces or newlines survive xargs -0.
+func RunList(deps Dependencies, config Config) error {
+	log := deps.Logger
+
+	binDir, err := deps.FS.DetermineBinDir(config.Goroot)
+	if err != nil {
+		_ = log.Sync() // Flush logs; errors are ignored
+
+		return fmt.Errorf("failed to determine binary directory: %w", err)
+	}
+
+	opts := fs.ListOptions{
+		ShowHidden:           config.ShowHidden,
+		IncludeNonExecutable: config.IncludeNonExecutable,
+		Match:                config.Match,
+		MinSize:              config.MinSize,
+		MaxSize:              config.MaxSize,
+		OlderThan:            config.OlderThan,
+		NewerThan:            config.NewerThan,
+	}
+	entries, err := listEntries(deps.FS, binDir, opts)
+	if err != nil {
+		_ = log.Sync() // Flush logs; errors are ignored
+
+		return err
+	}
+
+	if config.CheckPath {
+		markShadowed(deps.lookPath(), entries)
+	}
+
+	if config.CheckCorrupt {
+		markCorrupt(deps.FS, entries)
+	}
+
+	if config.GroupByModule {
+		if deps.Extractor == nil {
+			_ = log.Sync()
+
+			return ErrExtractorNotInitialized
+		}
+
+		markModules(context.Background(), deps.Extractor, entries)
+	}
+
+	if config.JSON {
+		err = writeListJSON(entries, config.Summary, config.Pretty)
+	} else {
+		switch {
+		case config.Null:
+			writeListNull(entries)
+		case config.GroupByModule:
+			writeListGrouped(entries, config.Summary)
+		case config.Long:
+			writeListLong(entries, config.Summary, time.Now(), config.TimeFormat)
+		default:
+			writeListText(entries, config.Summary)
+		}
+
+		if config.ShowSkipped {
+			writeSkipped(deps.FS.ListSkipped(binDir, opts))
+		}
+
+		if config.CheckPath {
+			writeShadowed(entries)
+		}
+
+		if config.CheckCorrupt {
+			writeCorrupt(entries)
+		}
+	}
+
+	_ = log.Sync() // Errors are ignored
+
+	return err
+}
+
+// listEntries collects sorted list entries for the binaries in dir, reading
+// each binary's metadata in the same pass as the directory listing.
+func listEntries(filesystem fs.FS, dir string, opts fs.ListOptions) ([]ListEntry, error) {
+	binaries, err := filesystem.ListBinariesWithInfo(dir, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list binaries: %w", err)
+	}
+
+	sort.Slice(binaries, func(i, j int) bool { return binaries[i].Name < binaries[j].Name })
+
+	entries := make([]ListEntry, 0, len(binaries))
+
+	for _, binary := range binaries {
+		entries = append(entries, ListEntry{
+			Name:    binary.Name,
+			Path:    binary.Path,
+			Size:    binary.Size,
+			ModTime: binary.ModTime,
+		})
+	}
+
+	return entries, nil
+}
+
+// writeListText prints one binary name per line, followed by an optional summary line.
+func writeListText(entries []ListEntry, summary bool) {
+	for _, entry := range entries {
+		fmt.Fprintln(os.Stdout, entry.Name)
+	}
+
+	if summary {
+		fmt.Fprintln(os.Stdout, formatSummary(len(entries), totalSize(entries)))
+	}
+}
+
+// writeListNull prints each binary name followed by a NUL byte, as find -print0 does.
+func writeListNull(entries []ListEntry) {
+	for _, entry := range entries {
+		fmt.Fprint(os.Stdout, entry.Name+"\x00")
+	}
+}
+
+// writeListLong prints each binary with its size and last modification time
+// in timeFormat, in aligned columns, followed by an optional summary line. An
+// empty timeFormat shows how long before now each binary was modified.
+func writeListLong(entries []ListEntry, summary bool, now time.Time, timeFormat string) {
+	if timeFormat == "" {
+		timeFormat = format.TimeRelative
+	}
+
+	sizes := make([]string, len(entries))
+	nameWidth, sizeWidth := 0, 0
+
+	for i, entry := range entries {
+		sizes[i] = format.Bytes(entry.Size)
+		nameWidth = max(nameWidth, len(entry.Name))
+		sizeWidth = max(sizeWidth, len(sizes[i]))
+	}
+
+	for i, entry := range entries {
+		modified := format.Time(entry.ModTime, now, timeFormat)
+
+		fmt.Fprintf(os.Stdout, "%-*s  %*s  %s\n", nameWidth, entry.Name, sizeWidth, sizes[i], modified)
+	}
+
+	if summary {
+		fmt.Fprintln(os.Stdout, formatSummary(len(entries), totalSize(entries)))
+	}
+}
+
+// writeSkipped prints each excluded entry with the reason it was excluded.
+func writeSkipped(skipped []fs.SkippedFile) {
+	if len(skipped) == 0 {
+		return
+	}
+
+	sort.Slice(skipped, func(i, j int) bool { return skipped[i].Name < skipped[j].Name })
+
+	fmt.Fprintf(os.Stdout, "\nSkipped %d:\n", len(skipped))
+
+	for _, file := range skipped {
+		fmt.Fprintf(os.Stdout, "  %s (%s)\n", file.Name, file.Reason)
+	}
+}
+
+// writeListJSON prints the entries as a JSON array, or as a ListSummary when summary is set.
+func writeListJSON(entries []ListEntry, summary, pretty bool) error {
+	var payload any = entries
+	if summary {
+		payload = ListSummary{
+			Binaries:  entries,
+			Count:     len(entries),
+			TotalSize: totalSize(entries),
+		}
+	}
+
+	return writeJSON(os.Stdout, payload, pretty)
+}
+
+// totalSize returns the combined size in bytes of the given entries.
+func totalSize(entries []ListEntry) int64 {
+	var total int64
+	for _, entry := range entries {
+		total += entry.Size
+	}
+
+	return total
+}
+
+// formatSummary renders a count and total size, e.g. "12 binaries, 210.0 MB total".
+func formatSummary(count int, total int64) string {
+	noun := "binaries"
+	if count == 1 {
+		noun = "binary"
+	}
+
+	return fmt.Sprintf("%d %s, %s total", count, noun, format.Bytes(total))
+}
+
+// ParseSize parses a human-readable size such as "50MB", "1.5 GB", or "1GiB".
+//
+// Units are case-insensitive. KB, MB, GB, and TB (or K, M, G, T) are decimal,
+// matching how sizes are displayed; KiB, MiB, GiB, and TiB are binary. A bare
+// number is a count of bytes.
+//
+// Parameters:
+//   - size: Size to parse
+//
+// Returns:
+//   - The size in bytes, rounded down to a whole byte
+//   - An error wrapping ErrInvalidSize if the size cannot be parsed
+func ParseSize(size string) (int64, error) {
+	trimmed := strings.TrimSpace(size)
+
+	split := strings.IndexFunc(trimmed, func(r rune) bool {
+		return !unicode.IsDigit(r) && r != '.'
+	})
+	if split < 0 {
+		split = len(trimmed)
+	}
+
+	number, unit := trimmed[:split], strings.ToLower(strings.TrimSpace(trimmed[split:]))
+
+	multiplier, ok := sizeUnits[unit]
+	if !ok || number == "" {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSize, size)
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSize, size)
+	}
+
+	return int64(value * multiplier), nil
+}
+
+// ParseAge parses an age such as "36h", "7d", or "2w".
+//
+// Days (d) and weeks (w) may be fractional, e.g. "1.5d"; anything else is
+// parsed by time.ParseDuration, so "90m" and "1h30m" work too.
+//
+// Parameters:
+//   - age: Age to parse
+//
+// Returns:
+//   - The age as a duration
+//   - An error wrapping ErrInvalidAge if the age cannot be parsed or is negative
+func ParseAge(age string) (time.Duration, error) {
+	trimmed := strings.TrimSpace(age)
+
+	if unit, ok := ageUnits[strings.ToLower(trimmed[max(len(trimmed)-1, 0):])]; ok {
+		value, err := strconv.ParseFloat(trimmed[:len(trimmed)-1], 64)
+		if err != nil || value < 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidAge, age)
+		}
+
+		return time.Duration(value * float64(unit)), nil
+	}
+
+	duration, err := time.ParseDuration(trimmed)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAge, age)
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,370 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// newListMockFS creates a MockFS that lists "vhs" and "age" with fixed sizes.
+func newListMockFS(t *testing.T) *mockFS.MockFS {
+	t.Helper()
+
+	m := mockFS.NewMockFS(t)
+	m.On("DetermineBinDir", false).Return("/bin", nil)
+	m.On("ListBinariesWithInfo", "/bin", fs.ListOptions{}).Return([]fs.BinaryInfo{
+		{Name: "vhs", Path: "/bin/vhs", Size: 2_500_000},
+		{Name: "age", Path: "/bin/age", Size: 1500},
+	}, nil)
+
+	return m
+}
+
+// TestRunList verifies the list output in text and JSON forms, with and without a summary.
+func TestRunList(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     Config
+		setupFS    func(t *testing.T) *mockFS.MockFS
+		wantErr    bool
+		wantOutput string
+	}{
+		{
+			name:       "text without summary",
+			config:     Config{},
+			setupFS:    newListMockFS,
+			wantOutput: "age\nvhs\n",
+		},
+		{
+			name:       "null separated",
+			config:     Config{Null: true},
+			setupFS:    newListMockFS,
+			wantOutput: "age\x00vhs\x00",
+		},
+		{
+			name:       "text with summary",
+			config:     Config{Summary: true},
+			setupFS:    newListMockFS,
+			wantOutput: "age\nvhs\n2 binaries, 2.5 MB total\n",
+		},
+		{
+			name:    "json without summary",
+			config:  Config{JSON: true},
+			setupFS: newListMockFS,
+			wantOutput: `[{"name":"age","path":"/bin/age","size":1500},` +
+				`{"name":"vhs","path":"/bin/vhs","size":2500000}]` + "\n",
+		},
+		{
+			name:    "json with summary",
+			config:  Config{JSON: true, Summary: true},
+			setupFS: newListMockFS,
+			wantOutput: `{"binaries":[{"name":"age","path":"/bin/age","size":1500},` +
+				`{"name":"vhs","path":"/bin/vhs","size":2500000}],"count":2,"totalSize":2501500}` + "\n",
+		},
+		{
+			name:   "empty directory with summary",
+			config: Config{Summary: true},
+			setupFS: func(t *testing.T) *mockFS.MockFS { //nolint:thelper // Anonymous setup function, not a test helper
+				m := mockFS.NewMockFS(t)
+				m.On("DetermineBinDir", false).Return("/bin", nil)
+				m.On("ListBinariesWithInfo", "/bin", fs.ListOptions{}).Return([]fs.BinaryInfo{}, nil)
+
+				return m
+			},
+			wantOutput: "0 binaries, 0 B total\n",
+		},
+		{
+			name:   "unreadable directory",
+			config: Config{},
+			setupFS: func(t *testing.T) *mockFS.MockFS { //nolint:thelper // Anonymous setup function, not a test helper
+				m := mockFS.NewMockFS(t)
+				m.On("DetermineBinDir", false).Return("/bin", nil)
+				m.On("ListBinariesWithInfo", "/bin", fs.ListOptions{}).
+					Return(nil, errors.New("permission denied"))
+
+				return m
+			},
+			wantErr: true,
+		},
+		{
+			name:   "show skipped",
+			config: Config{ShowSkipped: true},
+			setupFS: func(t *testing.T) *mockFS.MockFS { //nolint:thelper // Anonymous setup function, not a test helper
+				m := newListMockFS(t)
+				m.On("ListSkipped", "/bin", fs.ListOptions{}).Return([]fs.SkippedFile{
+					{Name: "notes.txt", Reason: fs.SkipNotExecutable},
+					{Name: ".envrc", Reason: fs.SkipHidden},
+					{Name: "cache", Reason: fs.SkipDirectory},
+				})
+
+				return m
+			},
+			wantOutput: "age\nvhs\n\nSkipped 3:\n" +
+				"  .envrc (hidden)\n  cache (is a directory)\n  notes.txt (not executable)\n",
+		},
+		{
+			name:   "bin dir error",
+			config: Config{},
+			setupFS: func(t *testing.T) *mockFS.MockFS { //nolint:thelper // Anonymous setup function, not a test helper
+				m := mockFS.NewMockFS(t)
+				m.On("DetermineBinDir", false).Return("", errors.New("bin dir failed"))
+
+				return m
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{
+				FS:     tt.setupFS(t),
+				Logger: newMockLoggerWithDefaults(t),
+			}
+
+			err := RunList(deps, tt.config)
+			gotOutput := getOutput()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RunList() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if gotOutput != tt.wantOutput {
+				t.Errorf("RunList() output = %q, want %q", gotOutput, tt.wantOutput)
+			}
+		})
+	}
+}
+
+// TestRunList_CheckPath verifies binaries resolving elsewhere on PATH are
+// reported as shadowed in text and JSON output, while binaries that resolve to
+// themselves or are not on PATH are not.
+func TestRunList_CheckPath(t *testing.T) {
+	resolver := func(file string) (string, error) {
+		switch file {
+		case "age":
+			return "/usr/local/bin/age", nil
+		case "vhs":
+			return "/bin/vhs", nil
+		default:
+			return "", exec.ErrNotFound
+		}
+	}
+
+	tests := []struct {
+		name       string
+		config     Config
+		wantOutput string
+	}{
+		{
+			name:       "text",
+			config:     Config{CheckPath: true},
+			wantOutput: "age\nvhs\n\nShadowed on PATH 1:\n  age (runs /usr/local/bin/age)\n",
+		},
+		{
+			name:   "json",
+			config: Config{CheckPath: true, JSON: true},
+			wantOutput: `[{"name":"age","path":"/bin/age","size":1500,"shadowedBy":"/usr/local/bin/age"},` +
+				`{"name":"vhs","path":"/bin/vhs","size":2500000}]` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{
+				FS:       newListMockFS(t),
+				Logger:   newMockLoggerWithDefaults(t),
+				LookPath: resolver,
+			}
+
+			if err := RunList(deps, tt.config); err != nil {
+				t.Fatalf("RunList() error = %v", err)
+			}
+
+			if got := getOutput(); got != tt.wantOutput {
+				t.Errorf("RunList() output = %q, want %q", got, tt.wantOutput)
+			}
+		})
+	}
+}
+
+// TestRunList_CheckCorrupt verifies likely corrupt binaries are reported with
+// their reason in text and JSON output.
+func TestRunList_CheckCorrupt(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     Config
+		wantOutput string
+	}{
+		{
+			name:       "text",
+			config:     Config{CheckCorrupt: true},
+			wantOutput: "age\nvhs\n\nLikely corrupt 1:\n  vhs (empty file)\n",
+		},
+		{
+			name:   "json",
+			config: Config{CheckCorrupt: true, JSON: true},
+			wantOutput: `[{"name":"age","path":"/bin/age","size":1500},` +
+				`{"name":"vhs","path":"/bin/vhs","size":2500000,"corrupt":"empty file"}]` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesystem := newListMockFS(t)
+			filesystem.On("CorruptReason", "/bin/age").Return("", nil)
+			filesystem.On("CorruptReason", "/bin/vhs").Return(fs.CorruptEmpty, nil)
+
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+			if err := RunList(deps, tt.config); err != nil {
+				t.Fatalf("RunList() error = %v", err)
+			}
+
+			if got := getOutput(); got != tt.wantOutput {
+				t.Errorf("RunList() output = %q, want %q", got, tt.wantOutput)
+			}
+		})
+	}
+}
+
+// TestParseSize verifies decimal and binary units, case and spacing, and
+// rejected input.
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		size    string
+		want    int64
+		wantErr bool
+	}{
+		{size: "50MB", want: 50_000_000},
+		{size: "1GiB", want: 1 << 30},
+		{size: "1.5 gb", want: 1_500_000_000},
+		{size: "10k", want: 10_000},
+		{size: "2KiB", want: 2048},
+		{size: "512", want: 512},
+		{size: "0", want: 0},
+		{size: "", wantErr: true},
+		{size: "MB", wantErr: true},
+		{size: "-5MB", wantErr: true},
+		{size: "1.2.3MB", wantErr: true},
+		{size: "5XB", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.size, func(t *testing.T) {
+			got, err := ParseSize(tt.size)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr && !errors.Is(err, ErrInvalidSize) {
+				t.Errorf("ParseSize() error = %v, want %v", err, ErrInvalidSize)
+			}
+
+			if got != tt.want {
+				t.Errorf("ParseSize() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseAge verifies day and week suffixes and Go durations are accepted,
+// and that negative or malformed ages are rejected.
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		age     string
+		want    time.Duration
+		wantErr bool
+	}{
+		{age: "7d", want: 7 * 24 * time.Hour},
+		{age: "2W", want: 14 * 24 * time.Hour},
+		{age: "1.5d", want: 36 * time.Hour},
+		{age: "36h", want: 36 * time.Hour},
+		{age: "1h30m", want: 90 * time.Minute},
+		{age: "0", want: 0},
+		{age: "", wantErr: true},
+		{age: "d", wantErr: true},
+		{age: "-1d", wantErr: true},
+		{age: "-2h", wantErr: true},
+		{age: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.age, func(t *testing.T) {
+			got, err := ParseAge(tt.age)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr && !errors.Is(err, ErrInvalidAge) {
+				t.Errorf("ParseAge() error = %v, want %v", err, ErrInvalidAge)
+			}
+
+			if got != tt.want {
+				t.Errorf("ParseAge() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_writeListLong verifies long output aligns names and sizes.
+func Test_writeListLong(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	entries := []ListEntry{
+		{Name: "age", Path: "/bin/age", Size: 1500, ModTime: now.Add(-72 * time.Hour)},
+		{Name: "gopls", Path: "/bin/gopls", Size: 30_000_000, ModTime: now.Add(-90 * time.Minute)},
+	}
+
+	getOutput := captureStdout(t)
+
+	writeListLong(entries, true, now, "")
+
+	want := "age     1.5 kB  3 days ago\n" +
+		"gopls  30.0 MB  1 hour ago\n" +
+		"2 binaries, 30.0 MB total\n"
+	if got := getOutput(); got != want {
+		t.Errorf("writeListLong() output = %q, want %q", got, want)
+	}
+}
+
+// Test_writeListLong_TimeFormat verifies --time-format changes how
+// modification times are shown.
+func Test_writeListLong_TimeFormat(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	entries := []ListEntry{{Name: "age", Path: "/bin/age", Size: 1500, ModTime: now.Add(-72 * time.Hour)}}
+
+	tests := []struct {
+		timeFormat string
+		want       string
+	}{
+		{timeFormat: "relative", want: "age  1.5 kB  3 days ago\n"},
+		{timeFormat: "rfc3339", want: "age  1.5 kB  2026-03-07T12:00:00Z\n"},
+		{timeFormat: "unix", want: "age  1.5 kB  1772884800\n"},
+		{timeFormat: "Jan 2 15:04", want: "age  1.5 kB  Mar 7 12:00\n"},
+	}
+
+	for _, tt := range tests {
+		getOutput := captureStdout(t)
+
+		writeListLong(entries, false, now, tt.timeFormat)
+
+		if got := getOutput(); got != tt.want {
+			t.Errorf("writeListLong(%q) output = %q, want %q", tt.timeFormat, got, tt.want)
+		}
+	}
+}
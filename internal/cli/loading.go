@@ -0,0 +1,94 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"fmt"
+	"maps"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// spinnerFrames are drawn in turn while the initial listing runs.
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// spinnerInterval is the time between spinner frames.
+const spinnerInterval = 100 * time.Millisecond
+
+// choicesLoadedMsg carries the result of the initial directory listing.
+type choicesLoadedMsg struct {
+	names   []string
+	corrupt map[string]string // Corruption check results; nil without Config.CheckCorrupt
+}
+
+// spinnerTickMsg advances the loading spinner by one frame.
+type spinnerTickMsg struct{}
+
+// loadChoices returns a command that lists the model's directory off the
+// update loop, so a slow filesystem leaves the spinner running instead of a
+// blank screen.
+func (m *model) loadChoices() tea.Cmd {
+	// Capture the listing inputs now; the command runs on another goroutine.
+	filesystem, dir, opts, checkCorruption := m.fs, m.dir, m.listOptions(), m.config.CheckCorrupt
+
+	return func() tea.Msg {
+		msg := choicesLoadedMsg{names: filesystem.ListBinaries(dir, opts)}
+
+		// Reading each binary is slow too, so it happens behind the spinner.
+		if checkCorruption {
+			msg.corrupt = checkCorrupt(filesystem, dir, msg.names, nil)
+		}
+
+		return msg
+	}
+}
+
+// spinnerTick returns a command that advances the spinner after spinnerInterval.
+func spinnerTick() tea.Cmd {
+	return tea.Tick(spinnerInterval, func(time.Time) tea.Msg {
+		return spinnerTickMsg{}
+	})
+}
+
+// handleChoicesLoaded shows the listed binaries, or quits with
+// ErrNoBinariesFound when the directory has none to offer.
+func (m *model) handleChoicesLoaded(msg choicesLoadedMsg) (tea.Model, tea.Cmd) {
+	m.loading = false
+
+	// History mode can still restore binaries into an empty directory.
+	if len(msg.names) == 0 && m.mode != modeHistory {
+		m.loadErr = fmt.Errorf("%w: %s", ErrNoBinariesFound, m.dir)
+
+		return m, tea.Quit
+	}
+
+	m.choices = msg.names
+	maps.Copy(m.corrupt, msg.corrupt)
+	m.sortChoices()
+	m.updateGrid()
+
+	return m, nil
+}
+
+// handleSpinnerTick advances the spinner while the listing is still running.
+func (m *model) handleSpinnerTick() (tea.Model, tea.Cmd) {
+	if !m.loading {
+		return m, nil
+	}
+
+	m.spinnerFrame = (m.spinnerFrame + 1) % len(spinnerFrames)
+
+	return m, spinnerTick()
+}
+
+// viewLoading renders the spinner shown until the initial listing arrives.
+func (m *model) viewLoading() tea.View {
+	spinnerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.CursorColor))
+
+	return m.newView(fmt.Sprintf("%s Scanning %s...\n", spinnerStyle.Render(spinnerFrames[m.spinnerFrame]), m.dir))
+}
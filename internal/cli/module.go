@@ -0,0 +1,159 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+)
+
+// unknownModule heads the group of binaries whose module could not be read.
+const unknownModule = "(unknown module)"
+
+// Errors returned when resolving binaries by module path.
+var (
+	// ErrExtractorNotInitialized indicates module matching was requested without a build info extractor.
+	ErrExtractorNotInitialized = errors.New("build info extractor not initialized")
+
+	// ErrNoModuleMatch indicates no binary in the directory was built from the requested module.
+	ErrNoModuleMatch = errors.New("no binary found for module")
+
+	// ErrAmbiguousModule indicates more than one binary matched the requested module.
+	ErrAmbiguousModule = errors.New("multiple binaries match module")
+)
+
+// resolveModuleBinary returns the name of the single binary in dir built from module.
+//
+// A binary matches when either its main package path or its module path equals
+// module, so both "github.com/foo/bar" and "github.com/foo/bar/cmd/baz" resolve.
+// Binaries without readable build info are skipped.
+//
+// Parameters:
+//   - ctx: Context for build info extraction
+//   - filesystem: Filesystem used to list and locate binaries
+//   - extractor: Build info extractor used to read module information
+//   - dir: Directory to scan
+//   - module: Module or package path to match
+//
+// Returns:
+//   - The matching binary name
+//   - An error if no binary or more than one binary matches
+func resolveModuleBinary(
+	ctx context.Context,
+	filesystem fs.FS,
+	extractor buildinfo.Extractor,
+	dir, module string,
+) (string, error) {
+	if extractor == nil {
+		return "", ErrExtractorNotInitialized
+	}
+
+	var matches []string
+
+	// Hidden files are included: the caller asked for a specific module, not a listing.
+	for _, name := range filesystem.ListBinaries(dir, fs.ListOptions{ShowHidden: true}) {
+		info, err := extractor.Extract(ctx, filesystem.AdjustBinaryPath(dir, name))
+		if err != nil {
+			continue // Not a Go binary or build info unavailable
+		}
+
+		if info.PackagePath == module || info.ModulePath == module {
+			matches = append(matches, name)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("%w: %s", ErrNoModuleMatch, module)
+	case 1:
+		return matches[0], nil
+	default:
+		sort.Strings(matches)
+
+		return "", fmt.Errorf(
+			"%w %s: %s (remove by name instead)",
+			ErrAmbiguousModule,
+			module,
+			strings.Join(matches, ", "),
+		)
+	}
+}
+
+// moduleGroup is a module path and the binaries built from it.
+type moduleGroup struct {
+	Module string   // Module path, or unknownModule
+	Names  []string // Binaries built from the module, in listing order
+}
+
+// markModules records the module each entry was built from. Binaries without
+// readable build info, such as scripts, are left without a module.
+func markModules(ctx context.Context, extractor buildinfo.Extractor, entries []ListEntry) {
+	for i, entry := range entries {
+		info, err := extractor.Extract(ctx, entry.Path)
+		if err != nil {
+			continue // Not a Go binary or build info unavailable
+		}
+
+		entries[i].Module = info.ModulePath
+	}
+}
+
+// groupByModule groups entries by module path in alphabetical order, with
+// binaries of unknown module in a final group.
+func groupByModule(entries []ListEntry) []moduleGroup {
+	index := make(map[string]int)
+
+	var groups []moduleGroup
+
+	for _, entry := range entries {
+		module := entry.Module
+		if module == "" {
+			module = unknownModule
+		}
+
+		i, ok := index[module]
+		if !ok {
+			i = len(groups)
+			index[module] = i
+			groups = append(groups, moduleGroup{Module: module})
+		}
+
+		groups[i].Names = append(groups[i].Names, entry.Name)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if (groups[i].Module == unknownModule) != (groups[j].Module == unknownModule) {
+			return groups[j].Module == unknownModule
+		}
+
+		return groups[i].Module < groups[j].Module
+	})
+
+	return groups
+}
+
+// writeListGrouped prints each module path followed by its binaries, indented,
+// and an optional summary line.
+func writeListGrouped(entries []ListEntry, summary bool) {
+	for _, group := range groupByModule(entries) {
+		fmt.Fprintln(os.Stdout, group.Module)
+
+		for _, name := range group.Names {
+			fmt.Fprintln(os.Stdout, "  "+name)
+		}
+	}
+
+	if summary {
+		fmt.Fprintln(os.Stdout, formatSummary(len(entries), totalSize(entries)))
+	}
+}
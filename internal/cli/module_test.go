@@ -0,0 +1,230 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
+	mockBuildInfo "github.com/nicholas-fedor/go-remove/internal/buildinfo/mocks"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// newModuleMocks creates filesystem and extractor mocks from a map of binary names to build info.
+// A nil build info value simulates a binary without readable build information.
+func newModuleMocks(
+	t *testing.T,
+	modules map[string]*buildinfo.BuildInfoData,
+) (*mockFS.MockFS, *mockBuildInfo.MockExtractor) {
+	t.Helper()
+
+	filesystem := mockFS.NewMockFS(t)
+	extractor := mockBuildInfo.NewMockExtractor(t)
+
+	names := make([]string, 0, len(modules))
+
+	for name, info := range modules {
+		names = append(names, name)
+		path := "/bin/" + name
+
+		filesystem.On("AdjustBinaryPath", "/bin", name).Return(path)
+
+		if info == nil {
+			extractor.On("Extract", mock.Anything, path).
+				Return(nil, buildinfo.ErrNotGoBinary)
+		} else {
+			extractor.On("Extract", mock.Anything, path).Return(info, nil)
+		}
+	}
+
+	filesystem.On("ListBinaries", "/bin", fs.ListOptions{ShowHidden: true}).Return(names)
+
+	return filesystem, extractor
+}
+
+// Test_resolveModuleBinary verifies module and package paths resolve to binary names.
+func Test_resolveModuleBinary(t *testing.T) {
+	modules := map[string]*buildinfo.BuildInfoData{
+		"baz": {
+			PackagePath: "github.com/foo/bar/cmd/baz",
+			ModulePath:  "github.com/foo/bar",
+		},
+		"qux": {
+			PackagePath: "github.com/foo/bar/cmd/qux",
+			ModulePath:  "github.com/foo/bar",
+		},
+		"vhs": {
+			PackagePath: "github.com/charmbracelet/vhs",
+			ModulePath:  "github.com/charmbracelet/vhs",
+		},
+		"script": nil,
+	}
+
+	tests := []struct {
+		name    string
+		module  string
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "package path match",
+			module: "github.com/foo/bar/cmd/baz",
+			want:   "baz",
+		},
+		{
+			name:   "module path match",
+			module: "github.com/charmbracelet/vhs",
+			want:   "vhs",
+		},
+		{
+			name:    "multiple matches",
+			module:  "github.com/foo/bar",
+			wantErr: ErrAmbiguousModule,
+		},
+		{
+			name:    "no match",
+			module:  "github.com/other/tool",
+			wantErr: ErrNoModuleMatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesystem, extractor := newModuleMocks(t, modules)
+
+			got, err := resolveModuleBinary(
+				context.Background(),
+				filesystem,
+				extractor,
+				"/bin",
+				tt.module,
+			)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("resolveModuleBinary() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("resolveModuleBinary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_resolveModuleBinary_NoExtractor verifies module lookups require an extractor.
+func Test_resolveModuleBinary_NoExtractor(t *testing.T) {
+	_, err := resolveModuleBinary(context.Background(), mockFS.NewMockFS(t), nil, "/bin", "x")
+	if !errors.Is(err, ErrExtractorNotInitialized) {
+		t.Errorf("resolveModuleBinary() error = %v, want %v", err, ErrExtractorNotInitialized)
+	}
+}
+
+// TestRun_Module verifies Run removes the binary resolved from a module path.
+func TestRun_Module(t *testing.T) {
+	filesystem, extractor := newModuleMocks(t, map[string]*buildinfo.BuildInfoData{
+		"baz": {PackagePath: "github.com/foo/bar/cmd/baz", ModulePath: "github.com/foo/bar"},
+		"vhs": {PackagePath: "github.com/charmbracelet/vhs", ModulePath: "github.com/charmbracelet/vhs"},
+	})
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("BinarySize", "/bin/baz").Return(int64(0), nil)
+	filesystem.On("RemoveBinary", "/bin/baz", "baz", false, mock.Anything).Return(nil)
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{
+		FS:        filesystem,
+		Logger:    newMockLoggerWithDefaults(t),
+		Extractor: extractor,
+	}
+
+	err := Run(deps, Config{Module: "github.com/foo/bar/cmd/baz"})
+	gotOutput := getOutput()
+
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if want := "Successfully removed baz\n"; gotOutput != want {
+		t.Errorf("Run() output = %q, want %q", gotOutput, want)
+	}
+}
+
+// TestRunList_GroupByModule verifies binaries are grouped under their module
+// in text output, with unreadable binaries last, and that JSON entries carry
+// the module.
+func TestRunList_GroupByModule(t *testing.T) {
+	modules := map[string]*buildinfo.BuildInfoData{
+		"/bin/baz":    {ModulePath: "github.com/foo/bar"},
+		"/bin/qux":    {ModulePath: "github.com/foo/bar"},
+		"/bin/vhs":    {ModulePath: "github.com/charmbracelet/vhs"},
+		"/bin/script": nil,
+	}
+
+	tests := []struct {
+		name       string
+		config     Config
+		wantOutput string
+	}{
+		{
+			name:   "text",
+			config: Config{GroupByModule: true, Summary: true},
+			wantOutput: "github.com/charmbracelet/vhs\n  vhs\n" +
+				"github.com/foo/bar\n  baz\n  qux\n" +
+				"(unknown module)\n  script\n" +
+				"4 binaries, 4 B total\n",
+		},
+		{
+			name:   "json",
+			config: Config{GroupByModule: true, JSON: true},
+			wantOutput: `[{"name":"baz","path":"/bin/baz","size":1,"module":"github.com/foo/bar"},` +
+				`{"name":"qux","path":"/bin/qux","size":1,"module":"github.com/foo/bar"},` +
+				`{"name":"script","path":"/bin/script","size":1},` +
+				`{"name":"vhs","path":"/bin/vhs","size":1,"module":"github.com/charmbracelet/vhs"}]` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesystem := mockFS.NewMockFS(t)
+			extractor := mockBuildInfo.NewMockExtractor(t)
+
+			binaries := make([]fs.BinaryInfo, 0, len(modules))
+
+			for path, info := range modules {
+				binaries = append(binaries, fs.BinaryInfo{Name: path[len("/bin/"):], Path: path, Size: 1})
+
+				if info == nil {
+					extractor.On("Extract", mock.Anything, path).Return(nil, buildinfo.ErrNotGoBinary)
+				} else {
+					extractor.On("Extract", mock.Anything, path).Return(info, nil)
+				}
+			}
+
+			filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+			filesystem.On("ListBinariesWithInfo", "/bin", fs.ListOptions{}).Return(binaries, nil)
+
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{
+				FS:        filesystem,
+				Logger:    newMockLoggerWithDefaults(t),
+				Extractor: extractor,
+			}
+
+			if err := RunList(deps, tt.config); err != nil {
+				t.Fatalf("RunList() error = %v", err)
+			}
+
+			if got := getOutput(); got != tt.wantOutput {
+				t.Errorf("RunList() output = %q, want %q", got, tt.wantOutput)
+			}
+		})
+	}
+}
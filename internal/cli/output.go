@@ -0,0 +1,36 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// jsonIndent is the indentation used for pretty-printed JSON output.
+const jsonIndent = "  "
+
+// writeJSON encodes v as JSON to w followed by a single trailing newline.
+//
+// HTML escaping is disabled so module paths and file names containing
+// characters such as '<', '>' or '&' render verbatim. When pretty is set,
+// the output is indented; otherwise it is written on a single line.
+func writeJSON(w io.Writer, v any, pretty bool) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+
+	if pretty {
+		encoder.SetIndent("", jsonIndent)
+	}
+
+	// Encode always terminates the value with exactly one newline.
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode JSON output: %w", err)
+	}
+
+	return nil
+}
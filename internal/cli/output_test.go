@@ -0,0 +1,55 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+)
+
+// Test_writeJSON verifies the exact bytes written for compact and pretty JSON output.
+func Test_writeJSON(t *testing.T) {
+	entries := []ListEntry{
+		{Name: "tool<&>", Path: "/bin/tool<&>", Size: 42},
+	}
+
+	tests := []struct {
+		name   string
+		pretty bool
+		want   string
+	}{
+		{
+			name:   "compact",
+			pretty: false,
+			want:   `[{"name":"tool<&>","path":"/bin/tool<&>","size":42}]` + "\n",
+		},
+		{
+			name:   "pretty",
+			pretty: true,
+			want: "[\n" +
+				"  {\n" +
+				`    "name": "tool<&>",` + "\n" +
+				`    "path": "/bin/tool<&>",` + "\n" +
+				`    "size": 42` + "\n" +
+				"  }\n" +
+				"]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			if err := writeJSON(&buf, entries, tt.pretty); err != nil {
+				t.Fatalf("writeJSON() error = %v", err)
+			}
+
+			if got := buf.String(); got != tt.want {
+				t.Errorf("writeJSON() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/nicholas-fedor/go-remove/internal/audit"
+)
+
+// Permissions of the output directory and removal logs; logs may name
+// private paths, so they are not world-readable.
+const (
+	outputDirMode  = 0o750
+	removalLogMode = 0o600
+)
+
+// removalLogTimeLayout names removal log files; it sorts chronologically and
+// avoids characters that are invalid in Windows file names.
+const removalLogTimeLayout = "20060102T150405.000000000Z"
+
+// RemovalLog is the record written to Config.OutputDir for each removal.
+type RemovalLog struct {
+	Name     string    `json:"name"`               // Binary file name
+	Path     string    `json:"path,omitempty"`     // Full path to the binary, when resolved
+	Size     int64     `json:"size,omitempty"`     // Size in bytes before removal, when measured
+	Checksum string    `json:"checksum,omitempty"` // SHA-256 of the binary, when computed
+	Module   string    `json:"module,omitempty"`   // Module path from the binary's build info
+	Version  string    `json:"version,omitempty"`  // Module version from the binary's build info
+	Time     time.Time `json:"time"`               // When the removal finished
+	DryRun   bool      `json:"dryRun"`             // True when nothing was actually deleted
+	Outcome  string    `json:"outcome"`            // removed, would remove, or failed
+	Error    string    `json:"error,omitempty"`    // Why the removal failed
+}
+
+// PrepareOutputDir creates dir if needed and checks that removal logs can be
+// written to it, so an unusable directory is reported before anything is
+// removed.
+//
+// Parameters:
+//   - dir: Directory that receives one removal log per binary
+//
+// Returns:
+//   - An error if the directory cannot be created or is not writable
+func PrepareOutputDir(dir string) error {
+	if err := os.MkdirAll(dir, outputDirMode); err != nil {
+		return fmt.Errorf("failed to create output directory %s: %w", dir, err)
+	}
+
+	probe, err := os.CreateTemp(dir, ".go-remove-*")
+	if err != nil {
+		return fmt.Errorf("output directory %s is not writable: %w", dir, err)
+	}
+
+	_ = probe.Close()
+
+	if err := os.Remove(probe.Name()); err != nil {
+		return fmt.Errorf("failed to clean up output directory %s: %w", dir, err)
+	}
+
+	return nil
+}
+
+// writeRemovalLog writes the outcome of removing config.Binary to its own
+// file in config.OutputDir, if one is configured. A log that can't be written
+// is logged as a warning; it never undoes or interrupts the removal itself.
+func writeRemovalLog(deps Dependencies, config Config, removal Removal, err error) {
+	if config.OutputDir == "" {
+		return
+	}
+
+	record := RemovalLog{
+		Name:     filepath.Base(config.Binary),
+		Path:     removal.Path,
+		Size:     removal.Size,
+		Checksum: removal.Checksum,
+		Module:   removal.Module,
+		Version:  removal.Version,
+		Time:     time.Now().UTC(),
+		DryRun:   config.DryRun,
+		Outcome:  audit.ResultRemoved,
+	}
+
+	switch {
+	case err != nil:
+		record.Outcome = audit.ResultFailed
+		record.Error = err.Error()
+	case config.DryRun:
+		record.Outcome = audit.ResultWouldRemove
+	}
+
+	path := filepath.Join(config.OutputDir,
+		record.Name+"-"+record.Time.Format(removalLogTimeLayout)+".json")
+
+	if writeErr := writeRemovalLogFile(path, record); writeErr != nil {
+		deps.Logger.Warn().Err(writeErr).Msg("Failed to write removal log")
+	}
+}
+
+// writeRemovalLogFile writes record as indented JSON to a new file at path.
+func writeRemovalLogFile(path string, record RemovalLog) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, removalLogMode)
+	if err != nil {
+		return fmt.Errorf("failed to create removal log %s: %w", path, err)
+	}
+
+	if err := writeJSON(file, record, true); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write removal log %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// removalModule returns the module path and version recorded in the build
+// info of binaryPath when removal logs are written. Binaries without build
+// info, or runs without an extractor, leave both empty.
+func removalModule(deps Dependencies, config Config, binaryPath string) (string, string) {
+	if config.OutputDir == "" || deps.Extractor == nil {
+		return "", ""
+	}
+
+	info, err := deps.Extractor.Extract(context.Background(), binaryPath)
+	if err != nil || info == nil {
+		return "", ""
+	}
+
+	return info.ModulePath, info.Version
+}
@@ -0,0 +1,135 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
+	mockBuildInfo "github.com/nicholas-fedor/go-remove/internal/buildinfo/mocks"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// TestPrepareOutputDir verifies missing directories are created and
+// unwritable ones are reported.
+func TestPrepareOutputDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "logs", "go-remove")
+	if err := PrepareOutputDir(dir); err != nil {
+		t.Fatalf("PrepareOutputDir() error = %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("output directory was not created: %v", err)
+	}
+
+	if len(entries) != 0 {
+		t.Errorf("PrepareOutputDir() left %d files behind, want none", len(entries))
+	}
+
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := PrepareOutputDir(file); err == nil {
+		t.Error("PrepareOutputDir() of a regular file error = nil, want an error")
+	}
+
+	if runtime.GOOS == "windows" || os.Geteuid() == 0 {
+		return // Permission bits don't stop writes here.
+	}
+
+	readOnly := t.TempDir()
+	if err := os.Chmod(readOnly, 0o500); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = os.Chmod(readOnly, 0o700) })
+
+	err = PrepareOutputDir(readOnly)
+	if err == nil || !strings.Contains(err.Error(), "is not writable") {
+		t.Errorf("PrepareOutputDir() error = %v, want a not-writable error", err)
+	}
+}
+
+// TestRunBatch_OutputDir verifies one removal log is written per binary,
+// with its checksum, build info, and outcome.
+func TestRunBatch_OutputDir(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+
+	for _, name := range []string{"age", "vhs"} {
+		filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+		filesystem.On("BinarySize", "/bin/"+name).Return(int64(1000), nil)
+		filesystem.On("Checksum", "/bin/"+name).Return("sum-"+name, nil)
+	}
+
+	filesystem.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).Return(nil)
+	filesystem.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).
+		Return(errors.New("permission denied"))
+
+	extractor := mockBuildInfo.NewMockExtractor(t)
+	extractor.On("Extract", mock.Anything, "/bin/age").
+		Return(&buildinfo.BuildInfoData{ModulePath: "filippo.io/age", Version: "v1.2.1"}, nil)
+	extractor.On("Extract", mock.Anything, "/bin/vhs").Return(nil, buildinfo.ErrNotGoBinary)
+
+	dir := t.TempDir()
+
+	captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t), Extractor: extractor}
+	if err := RunBatch(context.Background(), deps, Config{OutputDir: dir}, []string{"age", "vhs"}); err == nil {
+		t.Error("RunBatch() error = nil, want failure for vhs")
+	}
+
+	records := map[string]RemovalLog{}
+
+	paths, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("failed to read removal log: %v", err)
+		}
+
+		var record RemovalLog
+		if err := json.Unmarshal(data, &record); err != nil {
+			t.Fatalf("removal log %s is not JSON: %v", path, err)
+		}
+
+		assert.True(t, strings.HasPrefix(filepath.Base(path), record.Name+"-"))
+		assert.False(t, record.Time.IsZero())
+
+		records[record.Name] = record
+	}
+
+	if !assert.Len(t, records, 2) {
+		return
+	}
+
+	age := records["age"]
+	assert.Equal(t, "/bin/age", age.Path)
+	assert.Equal(t, int64(1000), age.Size)
+	assert.Equal(t, "sum-age", age.Checksum)
+	assert.Equal(t, "filippo.io/age", age.Module)
+	assert.Equal(t, "v1.2.1", age.Version)
+	assert.Equal(t, "removed", age.Outcome)
+	assert.Empty(t, age.Error)
+
+	vhs := records["vhs"]
+	assert.Equal(t, "failed", vhs.Outcome)
+	assert.Equal(t, "failed to remove binary vhs: permission denied", vhs.Error)
+}
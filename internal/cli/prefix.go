@@ -0,0 +1,76 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+)
+
+// ErrAmbiguousPrefix indicates a partial name matched more than one binary.
+var ErrAmbiguousPrefix = errors.New("multiple binaries match")
+
+// onDiskName returns the file name binary name has in dir, which carries .exe
+// on Windows, so user-supplied names can be compared with directory listings.
+func onDiskName(filesystem fs.FS, dir, name string) string {
+	return filepath.Base(filesystem.AdjustBinaryPath(dir, name))
+}
+
+// resolvePrefix expands name to the single binary in dir that starts with it.
+//
+// An exact match always wins, so "go" still removes a binary named "go" even
+// when "gopls" is installed. When nothing matches at all, name is returned
+// unchanged and the removal reports the missing binary as usual.
+//
+// Parameters:
+//   - filesystem: Filesystem used to list and locate binaries
+//   - dir: Directory to scan
+//   - name: Binary name or prefix given on the command line
+//   - opts: Listing options, so hidden files and bundles follow the usual rules
+//   - out: Destination for the note naming the matched binary
+//
+// Returns:
+//   - The binary name to remove
+//   - An error if name is a prefix of several binaries
+func resolvePrefix(filesystem fs.FS, dir, name string, opts fs.ListOptions, out io.Writer) (string, error) {
+	names := filesystem.ListBinaries(dir, opts)
+
+	if slices.Contains(names, onDiskName(filesystem, dir, name)) {
+		return name, nil
+	}
+
+	var matches []string
+
+	for _, candidate := range names {
+		if strings.HasPrefix(candidate, name) {
+			matches = append(matches, candidate)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return name, nil
+	case 1:
+		fmt.Fprintf(out, "Matched %s to %s\n", name, matches[0])
+
+		return matches[0], nil
+	default:
+		slices.Sort(matches)
+
+		return "", fmt.Errorf(
+			"%w %q: %s (be more specific)",
+			ErrAmbiguousPrefix,
+			name,
+			strings.Join(matches, ", "),
+		)
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// Test_resolvePrefix verifies exact names win and unambiguous prefixes expand.
+func Test_resolvePrefix(t *testing.T) {
+	installed := []string{"golangci-lint", "gotests", "gotestsum", "vhs"}
+
+	tests := []struct {
+		name       string
+		arg        string
+		want       string
+		wantErr    error
+		wantOutput string
+	}{
+		{
+			name:       "unique prefix",
+			arg:        "golangci",
+			want:       "golangci-lint",
+			wantOutput: "Matched golangci to golangci-lint\n",
+		},
+		{
+			name: "exact match is authoritative",
+			arg:  "gotests",
+			want: "gotests",
+		},
+		{
+			name:    "ambiguous prefix",
+			arg:     "gotest",
+			wantErr: ErrAmbiguousPrefix,
+		},
+		{
+			name: "no match leaves the name unchanged",
+			arg:  "age",
+			want: "age",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesystem := mockFS.NewMockFS(t)
+			filesystem.On("ListBinaries", "/bin", fs.ListOptions{ShowHidden: true}).Return(installed)
+			filesystem.On("AdjustBinaryPath", "/bin", tt.arg).Return("/bin/" + tt.arg)
+
+			var out strings.Builder
+
+			got, err := resolvePrefix(filesystem, "/bin", tt.arg, fs.ListOptions{ShowHidden: true}, &out)
+			gotOutput := out.String()
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("resolvePrefix() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("resolvePrefix() = %q, want %q", got, tt.want)
+			}
+
+			if gotOutput != tt.wantOutput {
+				t.Errorf("resolvePrefix() output = %q, want %q", gotOutput, tt.wantOutput)
+			}
+		})
+	}
+}
+
+// TestRun_Prefix verifies Run removes the binary an unambiguous prefix names
+// and lists the candidates when the prefix is ambiguous.
+func TestRun_Prefix(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", mock.Anything).Return([]string{"gopls", "gotests", "gotestsum"})
+	filesystem.On("AdjustBinaryPath", "/bin", mock.Anything).Return(func(dir, name string) string {
+		return dir + "/" + name
+	})
+	filesystem.On("BinarySize", "/bin/gopls").Return(int64(0), nil)
+	filesystem.On("RemoveBinary", "/bin/gopls", "gopls", false, mock.Anything).Return(nil)
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	if err := Run(deps, Config{Binary: "gop"}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	err := Run(deps, Config{Binary: "gotest"})
+	gotOutput := getOutput()
+
+	if !errors.Is(err, ErrAmbiguousPrefix) || !strings.Contains(err.Error(), "gotests, gotestsum") {
+		t.Errorf("Run() error = %v, want %v listing both candidates", err, ErrAmbiguousPrefix)
+	}
+
+	if want := "Matched gop to gopls\nSuccessfully removed gopls\n"; gotOutput != want {
+		t.Errorf("Run() output = %q, want %q", gotOutput, want)
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ErrRemovalDeclined indicates the user answered no to a removal confirmation.
+var ErrRemovalDeclined = errors.New("removal declined")
+
+// input returns the reader used for interactive prompts, defaulting to standard input.
+func (d Dependencies) input() io.Reader {
+	if d.Input != nil {
+		return d.Input
+	}
+
+	return os.Stdin
+}
+
+// output returns the writer for progress messages and prompts, defaulting to standard output.
+func (d Dependencies) output() io.Writer {
+	if d.Output != nil {
+		return d.Output
+	}
+
+	return os.Stdout
+}
+
+// confirm writes question to out followed by a [y/N] hint, or [Y/n] when
+// defaultYes is set, and reads the answer from in. "y" and "yes" accept and
+// "n" and "no" decline, in any case. An empty answer or end of input takes the
+// default. Any other answer declines, so a mistyped reply never removes
+// anything. Every yes/no prompt goes through confirm so they all behave alike.
+func confirm(question string, defaultYes bool, in io.Reader, out io.Writer) (bool, error) {
+	hint := "[y/N]"
+	if defaultYes {
+		hint = "[Y/n]"
+	}
+
+	fmt.Fprintf(out, "%s %s ", question, hint)
+
+	answer, err := readAnswer(in)
+	if err != nil {
+		return false, err
+	}
+
+	switch strings.ToLower(answer) {
+	case "":
+		return defaultYes, nil
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// removalAnswer is a response to a per-binary removal prompt.
+type removalAnswer int
+
+// Answers accepted by askRemoval.
+const (
+	answerNo   removalAnswer = iota // Skip this binary
+	answerYes                       // Remove this binary
+	answerAll                       // Remove this and every remaining binary without asking
+	answerQuit                      // Stop without removing anything further
+)
+
+// askRemoval writes a prompt to out asking whether name should be removed, in
+// the style of rm -i, and reads the answer from in. Anything other than y, a,
+// or q (or their long forms) counts as no.
+func askRemoval(name string, in io.Reader, out io.Writer) (removalAnswer, error) {
+	fmt.Fprintf(out, "Remove %s? [y/n/a/q] ", name)
+
+	answer, err := readAnswer(in)
+	if err != nil {
+		return answerNo, err
+	}
+
+	switch strings.ToLower(answer) {
+	case "y", "yes":
+		return answerYes, nil
+	case "a", "all":
+		return answerAll, nil
+	case "q", "quit":
+		return answerQuit, nil
+	default:
+		return answerNo, nil
+	}
+}
+
+// describeTarget prints the executable format of the binary about to be
+// confirmed, so the user can tell it is really a program before removing it.
+func describeTarget(deps Dependencies, binDir string, config Config, name string) {
+	path := name
+	if !config.PathMode {
+		path = deps.FS.AdjustBinaryPath(binDir, name)
+	}
+
+	kind, err := deps.FS.DescribeBinary(path)
+	if err != nil {
+		kind = "unknown type"
+	}
+
+	fmt.Fprintf(deps.output(), "%s: %s\n", name, kind)
+}
+
+// readAnswer reads one line from in, trimmed of surrounding whitespace.
+//
+// The reader is consumed one byte at a time so nothing past the newline is
+// buffered, allowing successive prompts to share the same reader. End of
+// input yields whatever was read so far.
+func readAnswer(in io.Reader) (string, error) {
+	var (
+		line []byte
+		buf  [1]byte
+	)
+
+	for {
+		n, err := in.Read(buf[:])
+		if n > 0 {
+			if buf[0] == '\n' {
+				break
+			}
+
+			line = append(line, buf[0])
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return "", fmt.Errorf("failed to read answer: %w", err)
+		}
+	}
+
+	return strings.TrimSpace(string(line)), nil
+}
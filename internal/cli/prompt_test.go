@@ -0,0 +1,105 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// errReader fails every read with err.
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+// Test_confirm verifies answers are matched in any case, an empty answer or
+// end of input takes the default, and anything else declines.
+func Test_confirm(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		defaultYes bool
+		want       bool
+	}{
+		{name: "y", input: "y\n", want: true},
+		{name: "yes upper case", input: "YES\n", want: true},
+		{name: "yes with spaces", input: "  Yes  \n", want: true},
+		{name: "n", input: "n\n", defaultYes: true, want: false},
+		{name: "no mixed case", input: "No\n", defaultYes: true, want: false},
+		{name: "empty takes default no", input: "\n", want: false},
+		{name: "empty takes default yes", input: "\n", defaultYes: true, want: true},
+		{name: "end of input takes default no", input: "", want: false},
+		{name: "end of input takes default yes", input: "", defaultYes: true, want: true},
+		{name: "answer without newline", input: "y", want: true},
+		{name: "crlf line ending", input: "yes\r\n", want: true},
+		{name: "unrecognized answer declines", input: "yep\n", defaultYes: true, want: false},
+		{name: "only first line is read", input: "n\ny\n", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+
+			got, err := confirm("Remove it?", tt.defaultYes, strings.NewReader(tt.input), &out)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// Test_confirm_Hint verifies the prompt shows which answer is the default.
+func Test_confirm_Hint(t *testing.T) {
+	var out bytes.Buffer
+
+	_, err := confirm("Remove it?", false, strings.NewReader("\n"), &out)
+	require.NoError(t, err)
+	assert.Equal(t, "Remove it? [y/N] ", out.String())
+
+	out.Reset()
+
+	_, err = confirm("Remove it?", true, strings.NewReader("\n"), &out)
+	require.NoError(t, err)
+	assert.Equal(t, "Remove it? [Y/n] ", out.String())
+}
+
+// Test_confirm_ReadError verifies a failed read declines and reports the error.
+func Test_confirm_ReadError(t *testing.T) {
+	errRead := errors.New("read failed")
+
+	got, err := confirm("Remove it?", true, errReader{err: errRead}, &bytes.Buffer{})
+	require.ErrorIs(t, err, errRead)
+	assert.False(t, got)
+}
+
+// Test_askRemoval verifies the prompt is written to the given writer and each
+// answer, in short or long form, maps to its choice.
+func Test_askRemoval(t *testing.T) {
+	tests := []struct {
+		input string
+		want  removalAnswer
+	}{
+		{input: "y\n", want: answerYes},
+		{input: "all\n", want: answerAll},
+		{input: "Q\n", want: answerQuit},
+		{input: "maybe\n", want: answerNo},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.TrimSpace(tt.input), func(t *testing.T) {
+			var out bytes.Buffer
+
+			got, err := askRemoval("vhs", strings.NewReader(tt.input), &out)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, "Remove vhs? [y/n/a/q] ", out.String())
+		})
+	}
+}
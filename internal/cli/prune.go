@@ -0,0 +1,175 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+)
+
+// prunePlan splits a binary directory into what a manifest keeps and removes.
+type prunePlan struct {
+	Keep    []string // Installed binaries named in the manifest
+	Remove  []string // Installed binaries the manifest does not name
+	Missing []string // Manifest entries that are not installed
+}
+
+// ReadManifest reads the binary names a prune manifest keeps.
+//
+// The file uses the --select-from-file format, one entry per line. Entries
+// may also be go install-style package paths such as
+// golang.org/x/tools/gopls@latest, which keep the binary they install.
+//
+// Parameters:
+//   - path: Path of the manifest file
+//
+// Returns:
+//   - The kept binary names in file order
+//   - An error if the file cannot be read
+func ReadManifest(path string) ([]string, error) {
+	entries, err := ReadSelection(path)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		name := BinaryNameFromPackage(entry)
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
+
+// RunPrune removes every binary in the target directory that the manifest in
+// config.Manifest does not list.
+//
+// The plan is printed first, naming the binaries kept and removed, so a
+// misread manifest is caught before anything is deleted. Removal then waits
+// for confirmation unless config.Yes or config.DryRun is set. Hidden files
+// are never pruned, as they are not shown anywhere else either.
+func RunPrune(ctx context.Context, deps Dependencies, config Config) error {
+	wanted, err := ReadManifest(config.Manifest)
+	if err != nil {
+		_ = deps.Logger.Sync() // Flush logs; errors are ignored
+
+		return err
+	}
+
+	binDir, err := deps.FS.DetermineBinDir(config.Goroot)
+	if err != nil {
+		_ = deps.Logger.Sync()
+
+		return fmt.Errorf("failed to determine binary directory: %w", err)
+	}
+
+	if err := checkSafeDir(config, binDir); err != nil {
+		_ = deps.Logger.Sync()
+
+		return err
+	}
+
+	installed := deps.FS.ListBinaries(binDir, fs.ListOptions{
+		IncludeBundles:       config.IncludeBundles,
+		IncludeNonExecutable: config.IncludeNonExecutable,
+	})
+
+	plan := planPrune(installed, wanted, func(name string) string {
+		return onDiskName(deps.FS, binDir, name)
+	})
+	printPrunePlan(deps.output(), plan)
+
+	if len(plan.Remove) == 0 {
+		fmt.Fprintln(deps.output(), "Nothing to prune in "+binDir)
+
+		_ = deps.Logger.Sync() // Errors are ignored
+
+		return nil
+	}
+
+	if !config.Yes && !config.DryRun {
+		noun := "binaries"
+		if len(plan.Remove) == 1 {
+			noun = "binary"
+		}
+
+		confirmed, err := confirm(fmt.Sprintf("Remove %d %s?", len(plan.Remove), noun), false, deps.input(), deps.output())
+		if err != nil {
+			_ = deps.Logger.Sync()
+
+			return err
+		}
+
+		if !confirmed {
+			_ = deps.Logger.Sync()
+
+			return fmt.Errorf("%w: prune of %s", ErrRemovalDeclined, binDir)
+		}
+	}
+
+	return runBatch(ctx, deps, binDir, config, plan.Remove)
+}
+
+// planPrune compares the installed binaries against the manifest's names,
+// which onDisk maps to their file names so "tool" keeps tool.exe on Windows.
+// Keep and Remove follow the listing's order; Missing follows the manifest's.
+func planPrune(installed, wanted []string, onDisk func(name string) string) prunePlan {
+	var plan prunePlan
+
+	wantedFiles := make([]string, len(wanted))
+	for i, name := range wanted {
+		wantedFiles[i] = onDisk(name)
+	}
+
+	for _, name := range installed {
+		if slices.Contains(wantedFiles, name) {
+			plan.Keep = append(plan.Keep, name)
+		} else {
+			plan.Remove = append(plan.Remove, name)
+		}
+	}
+
+	for i, name := range wanted {
+		if !slices.Contains(installed, wantedFiles[i]) {
+			plan.Missing = append(plan.Missing, name)
+		}
+	}
+
+	return plan
+}
+
+// printPrunePlan writes the plan to w, e.g.
+// "Keep: gopls, dlv (in manifest)" and "Remove: oldtool (not in manifest)",
+// coloring names with the --show-diff colors.
+func printPrunePlan(w io.Writer, plan prunePlan) {
+	removedStyle, keptStyle := diffStyles()
+
+	var b strings.Builder
+
+	if len(plan.Keep) > 0 {
+		fmt.Fprintf(&b, "Keep: %s (in manifest)\n", keptStyle.Render(strings.Join(plan.Keep, ", ")))
+	}
+
+	if len(plan.Remove) > 0 {
+		fmt.Fprintf(&b, "Remove: %s (not in manifest)\n", removedStyle.Render(strings.Join(plan.Remove, ", ")))
+	}
+
+	for _, name := range plan.Missing {
+		fmt.Fprintf(&b, "Note: %s is in the manifest but not installed\n", name)
+	}
+
+	_, _ = lipgloss.Fprint(w, b.String())
+}
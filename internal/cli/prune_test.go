@@ -0,0 +1,191 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// TestReadManifest verifies package paths reduce to binary names and
+// repeated names are kept once.
+func TestReadManifest(t *testing.T) {
+	path := writeSelection(t, "# tools\ngolang.org/x/tools/gopls@latest\ndlv\ngopls\n")
+
+	got, err := ReadManifest(path)
+	if err != nil {
+		t.Fatalf("ReadManifest() error = %v", err)
+	}
+
+	if want := []string{"gopls", "dlv"}; !slices.Equal(got, want) {
+		t.Errorf("ReadManifest() = %q, want %q", got, want)
+	}
+}
+
+// TestRunPrune verifies the plan is shown before removal, binaries missing
+// from the manifest are removed only once confirmed, and --yes and dry runs
+// skip the prompt.
+func TestRunPrune(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		yes        bool
+		dryRun     bool
+		wantRemove bool
+		wantErr    error
+		wantOutput []string
+	}{
+		{
+			name:       "confirmed",
+			input:      "y\n",
+			wantRemove: true,
+			wantOutput: []string{"Remove 2 binaries? [y/N] ", "Successfully removed oldtool1\n"},
+		},
+		{
+			name:       "declined",
+			input:      "n\n",
+			wantErr:    ErrRemovalDeclined,
+			wantOutput: []string{"Remove 2 binaries? [y/N] "},
+		},
+		{
+			name:       "yes",
+			yes:        true,
+			wantRemove: true,
+			wantOutput: []string{"Removed 2 of 2 binaries\n"},
+		},
+		{
+			name:       "dry run",
+			dryRun:     true,
+			wantOutput: []string{"Would remove oldtool1\n", "Would remove oldtool2\n"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeSelection(t, "gopls\ndlv\nstaticcheck\n")
+
+			filesystem := mockFS.NewMockFS(t)
+			filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+			filesystem.On("ListBinaries", "/bin", fs.ListOptions{}).
+				Return([]string{"dlv", "gopls", "oldtool1", "oldtool2"})
+
+			for _, name := range []string{"gopls", "dlv", "staticcheck"} {
+				filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+			}
+
+			if tt.wantRemove || tt.dryRun {
+				for _, name := range []string{"oldtool1", "oldtool2"} {
+					filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+					filesystem.On("BinarySize", "/bin/"+name).Return(int64(1000), nil)
+				}
+			}
+
+			if tt.wantRemove {
+				for _, name := range []string{"oldtool1", "oldtool2"} {
+					filesystem.On("RemoveBinary", "/bin/"+name, name, false, mock.Anything).Return(nil)
+				}
+			}
+
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{
+				FS:     filesystem,
+				Logger: newMockLoggerWithDefaults(t),
+				Input:  strings.NewReader(tt.input),
+			}
+			config := Config{Manifest: path, Yes: tt.yes, DryRun: tt.dryRun}
+
+			err := RunPrune(context.Background(), deps, config)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RunPrune() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			gotOutput := getOutput()
+
+			plan := "Keep: dlv, gopls (in manifest)\nRemove: oldtool1, oldtool2 (not in manifest)\n" +
+				"Note: staticcheck is in the manifest but not installed\n"
+			if !strings.HasPrefix(gotOutput, plan) {
+				t.Errorf("RunPrune() output = %q, want it to start with %q", gotOutput, plan)
+			}
+
+			for _, want := range tt.wantOutput {
+				if !strings.Contains(gotOutput, want) {
+					t.Errorf("RunPrune() output = %q, want it to contain %q", gotOutput, want)
+				}
+			}
+
+			if (tt.yes || tt.dryRun) && strings.Contains(gotOutput, "[y/N]") {
+				t.Errorf("RunPrune() output = %q, want no prompt", gotOutput)
+			}
+		})
+	}
+}
+
+// TestRunPrune_NothingToPrune verifies a directory matching the manifest is
+// left alone without prompting.
+func TestRunPrune_NothingToPrune(t *testing.T) {
+	path := writeSelection(t, "gopls\n")
+
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"gopls"})
+	filesystem.On("AdjustBinaryPath", "/bin", "gopls").Return("/bin/gopls")
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	if err := RunPrune(context.Background(), deps, Config{Manifest: path}); err != nil {
+		t.Fatalf("RunPrune() error = %v", err)
+	}
+
+	want := "Keep: gopls (in manifest)\nNothing to prune in /bin\n"
+	if got := getOutput(); got != want {
+		t.Errorf("RunPrune() output = %q, want %q", got, want)
+	}
+}
+
+// Test_planPrune verifies manifest names are compared with the file names they
+// install as, so a Windows tool.exe is kept by a manifest naming tool.
+func Test_planPrune(t *testing.T) {
+	tests := []struct {
+		name      string
+		installed []string
+		ext       string
+		want      prunePlan
+	}{
+		{
+			name:      "unix",
+			installed: []string{"dlv", "oldtool"},
+			want:      prunePlan{Keep: []string{"dlv"}, Remove: []string{"oldtool"}, Missing: []string{"staticcheck"}},
+		},
+		{
+			name:      "windows suffix",
+			installed: []string{"dlv.exe", "oldtool.exe"},
+			ext:       ".exe",
+			want:      prunePlan{Keep: []string{"dlv.exe"}, Remove: []string{"oldtool.exe"}, Missing: []string{"staticcheck"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			onDisk := func(name string) string { return name + tt.ext }
+
+			got := planPrune(tt.installed, []string{"dlv", "staticcheck"}, onDisk)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planPrune() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
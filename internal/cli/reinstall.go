@@ -0,0 +1,176 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+
+	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+)
+
+// Errors returned when reinstalling a binary at a pinned version.
+var (
+	// ErrInvalidVersion indicates a reinstall version that is not a semantic version such as v1.2.3.
+	ErrInvalidVersion = errors.New("version must look like v1.2.3")
+
+	// ErrNoBuildInfo indicates a binary lacks the module build info needed to reinstall it.
+	ErrNoBuildInfo = errors.New("no module build info to reinstall from")
+
+	// ErrReinstallFailed indicates go install failed after the binary was removed.
+	ErrReinstallFailed = errors.New("go install failed")
+)
+
+// versionPattern matches the semantic versions go install accepts, including
+// prerelease, build metadata, and pseudo-version suffixes.
+var versionPattern = regexp.MustCompile(
+	`^v(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`,
+)
+
+// Installer runs go install for target, such as "golang.org/x/tools/gopls@v0.16.0",
+// returning the command's combined output.
+type Installer func(ctx context.Context, target string) ([]byte, error)
+
+// install returns the configured installer, falling back to goInstall.
+func (d Dependencies) install() Installer {
+	if d.Install != nil {
+		return d.Install
+	}
+
+	return goInstall
+}
+
+// goInstall runs the go command on PATH to install target.
+func goInstall(ctx context.Context, target string) ([]byte, error) {
+	goCmd, err := fs.GoCommand()
+	if err != nil {
+		return nil, err
+	}
+
+	return exec.CommandContext(ctx, goCmd, "install", target).CombinedOutput() //nolint:gosec // Target is built from build info and a validated version
+}
+
+// ValidateVersion reports whether version can be passed to go install, such as
+// v1.2.3 or v0.0.0-20240101000000-abcdef123456.
+func ValidateVersion(version string) error {
+	if !versionPattern.MatchString(version) {
+		return fmt.Errorf("%w: %q", ErrInvalidVersion, version)
+	}
+
+	return nil
+}
+
+// reinstallTarget returns the go install argument that reinstalls
+// config.Binary at config.ReinstallVersion, or "" when no version was requested.
+//
+// It runs before the binary is removed, so a binary that cannot be reinstalled
+// is left in place.
+//
+// Parameters:
+//   - deps: Dependencies providing the filesystem and build info extractor
+//   - binDir: Directory containing the binary; unused in path mode
+//   - config: Configuration naming the binary and the version
+//
+// Returns:
+//   - The package path joined to the version, e.g. "example.com/tool@v1.2.3"
+//   - An error if the binary cannot be found or has no usable build info
+func reinstallTarget(deps Dependencies, binDir string, config Config) (string, error) {
+	if config.ReinstallVersion == "" {
+		return "", nil
+	}
+
+	if deps.Extractor == nil {
+		return "", ErrExtractorNotInitialized
+	}
+
+	// Without a go command the default installer cannot run; keep the binary.
+	if deps.Install == nil {
+		if _, err := fs.GoCommand(); err != nil {
+			warnGoMissing(deps.Logger, err)
+
+			return "", fmt.Errorf("skipping %s: %w", config.Binary, err)
+		}
+	}
+
+	binaryPath, err := resolveBinaryPath(deps.FS, binDir, config)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := deps.Extractor.Extract(context.Background(), binaryPath)
+	if err != nil {
+		return "", fmt.Errorf("skipping %s: %w", config.Binary, ErrNoBuildInfo)
+	}
+
+	target, err := installTarget(info, config.ReinstallVersion)
+	if err != nil {
+		return "", fmt.Errorf("skipping %s: %w", config.Binary, err)
+	}
+
+	return target, nil
+}
+
+// installTarget builds the go install argument that installs the package
+// described by info at version, e.g. "golang.org/x/tools/gopls@v0.16.0".
+//
+// Returns:
+//   - The package path joined to the version
+//   - ErrInvalidVersion if version is not a semantic version, or
+//     ErrNoBuildInfo if info names no package to install
+func installTarget(info *buildinfo.BuildInfoData, version string) (string, error) {
+	if err := ValidateVersion(version); err != nil {
+		return "", err
+	}
+
+	if info == nil {
+		return "", ErrNoBuildInfo
+	}
+
+	// go install needs the main package, which is the module itself only for
+	// single-command modules.
+	pkg := info.PackagePath
+	if pkg == "" || pkg == "command-line-arguments" {
+		pkg = info.ModulePath
+	}
+
+	if pkg == "" {
+		return "", ErrNoBuildInfo
+	}
+
+	return pkg + "@" + version, nil
+}
+
+// reinstall installs target after its binary was removed, printing the outcome.
+// In dry-run mode the install is only reported.
+func reinstall(deps Dependencies, config Config, target string) error {
+	if config.DryRun {
+		fmt.Fprintln(os.Stdout, "Would reinstall "+target)
+
+		return nil
+	}
+
+	output, err := deps.install()(context.Background(), target)
+	if err != nil {
+		// The old binary is already gone; say how to get it back.
+		return fmt.Errorf(
+			"%w for %s: %w\n%s\nThe previous binary was removed; run go-remove --undo to restore it",
+			ErrReinstallFailed,
+			target,
+			err,
+			bytes.TrimSpace(output),
+		)
+	}
+
+	fmt.Fprintln(os.Stdout, config.Symbols.succeeded("Reinstalled "+target))
+
+	return nil
+}
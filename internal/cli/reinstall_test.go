@@ -0,0 +1,192 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
+	mockBuildInfo "github.com/nicholas-fedor/go-remove/internal/buildinfo/mocks"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// TestValidateVersion verifies reinstall versions must be semantic versions.
+func TestValidateVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		wantErr bool
+	}{
+		{version: "v1.2.3"},
+		{version: "v0.16.0-rc.1"},
+		{version: "v0.0.0-20240101000000-abcdef123456"},
+		{version: "1.2.3", wantErr: true},
+		{version: "latest", wantErr: true},
+		{version: "v1.2", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			err := ValidateVersion(tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateVersion(%q) error = %v, wantErr %v", tt.version, err, tt.wantErr)
+			}
+
+			if err != nil && !errors.Is(err, ErrInvalidVersion) {
+				t.Errorf("ValidateVersion(%q) error = %v, want %v", tt.version, err, ErrInvalidVersion)
+			}
+		})
+	}
+}
+
+// Test_installTarget verifies the go install argument is built from the main
+// package, or the module for single-command builds, and a validated version.
+func Test_installTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		info    *buildinfo.BuildInfoData
+		version string
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "main package",
+			info:    &buildinfo.BuildInfoData{PackagePath: "golang.org/x/tools/gopls", ModulePath: "golang.org/x/tools/gopls"},
+			version: "v0.16.0",
+			want:    "golang.org/x/tools/gopls@v0.16.0",
+		},
+		{
+			name:    "command-line-arguments falls back to the module",
+			info:    &buildinfo.BuildInfoData{PackagePath: "command-line-arguments", ModulePath: "example.com/tool"},
+			version: "v1.2.3-rc.1",
+			want:    "example.com/tool@v1.2.3-rc.1",
+		},
+		{
+			name:    "invalid version",
+			info:    &buildinfo.BuildInfoData{ModulePath: "example.com/tool"},
+			version: "latest",
+			wantErr: ErrInvalidVersion,
+		},
+		{name: "no build info", version: "v1.2.3", wantErr: ErrNoBuildInfo},
+		{name: "no package path", info: &buildinfo.BuildInfoData{}, version: "v1.2.3", wantErr: ErrNoBuildInfo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := installTarget(tt.info, tt.version)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("installTarget() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("installTarget() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRun_ReinstallVersion verifies a binary is removed and reinstalled at the
+// requested version, and is left alone when it has no build info.
+func TestRun_ReinstallVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		info       *buildinfo.BuildInfoData
+		installErr error
+		dryRun     bool
+		wantTarget string
+		wantOutput string
+		wantErr    error
+	}{
+		{
+			name:       "reinstalls the main package",
+			info:       &buildinfo.BuildInfoData{PackagePath: "example.com/tools/cmd/tool", ModulePath: "example.com/tools"},
+			wantTarget: "example.com/tools/cmd/tool@v1.2.3",
+			wantOutput: "Successfully removed /scratch/tool\nReinstalled example.com/tools/cmd/tool@v1.2.3\n",
+		},
+		{
+			name:       "falls back to the module path",
+			info:       &buildinfo.BuildInfoData{PackagePath: "command-line-arguments", ModulePath: "example.com/tool"},
+			wantTarget: "example.com/tool@v1.2.3",
+			wantOutput: "Successfully removed /scratch/tool\nReinstalled example.com/tool@v1.2.3\n",
+		},
+		{
+			name:       "dry run",
+			info:       &buildinfo.BuildInfoData{ModulePath: "example.com/tool"},
+			dryRun:     true,
+			wantOutput: "Would remove /scratch/tool\nWould reinstall example.com/tool@v1.2.3\n",
+		},
+		{
+			name:       "install fails",
+			info:       &buildinfo.BuildInfoData{ModulePath: "example.com/tool"},
+			installErr: errors.New("exit status 1"),
+			wantTarget: "example.com/tool@v1.2.3",
+			wantOutput: "Successfully removed /scratch/tool\n",
+			wantErr:    ErrReinstallFailed,
+		},
+		{
+			name:    "no build info",
+			wantErr: ErrNoBuildInfo,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesystem := mockFS.NewMockFS(t)
+			filesystem.On("ResolveFilePath", "/scratch/tool").Return("/scratch/tool", nil)
+
+			extractor := mockBuildInfo.NewMockExtractor(t)
+
+			if tt.info == nil {
+				extractor.On("Extract", mock.Anything, "/scratch/tool").Return(nil, buildinfo.ErrNotGoBinary)
+			} else {
+				extractor.On("Extract", mock.Anything, "/scratch/tool").Return(tt.info, nil)
+				filesystem.On("BinarySize", "/scratch/tool").Return(int64(0), nil)
+			}
+
+			if tt.info != nil && !tt.dryRun {
+				filesystem.On("RemoveBinary", "/scratch/tool", "/scratch/tool", false, mock.Anything).
+					Return(nil)
+			}
+
+			var installed string
+
+			getOutput := captureStdout(t)
+
+			deps := Dependencies{
+				FS:        filesystem,
+				Logger:    newMockLoggerWithDefaults(t),
+				Extractor: extractor,
+				Install: func(_ context.Context, target string) ([]byte, error) {
+					installed = target
+
+					return []byte("go: build failed\n"), tt.installErr
+				},
+			}
+			config := Config{
+				Binary:           "/scratch/tool",
+				PathMode:         true,
+				DryRun:           tt.dryRun,
+				ReinstallVersion: "v1.2.3",
+			}
+
+			err := Run(deps, config)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if installed != tt.wantTarget {
+				t.Errorf("installed %q, want %q", installed, tt.wantTarget)
+			}
+
+			if got := getOutput(); got != tt.wantOutput {
+				t.Errorf("Run() output = %q, want %q", got, tt.wantOutput)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// RenderString renders a TUI model, such as one returned by NewModel, as plain
+// text for a terminal of the given size.
+//
+// Colors and other escape sequences are removed, as is trailing space on each
+// line, so the output depends only on the model's state and the size. This
+// keeps golden-file tests stable across terminals and lets the TUI be captured
+// for documentation or bug reports. The model is initialized and resized in
+// place, but commands it returns are not run.
+//
+// Parameters:
+//   - m: Model to render
+//   - width: Terminal width in columns
+//   - height: Terminal height in lines
+//
+// Returns:
+//   - The rendered screen, one line per terminal row
+func RenderString(m tea.Model, width, height int) string {
+	_ = m.Init()
+
+	m, _ = m.Update(tea.WindowSizeMsg{Width: width, Height: height})
+
+	lines := strings.Split(ansi.Strip(m.View().Content), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,77 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Removal describes a binary removed during a session.
+type Removal struct {
+	Name       string  `json:"name"`               // Binary file name
+	Path       string  `json:"path"`               // Full path to the binary
+	Size       int64   `json:"size,omitempty"`     // Size in bytes before removal, when measured
+	BytesFreed int64   `json:"bytesFreed"`         // Bytes reclaimed; zero for dry runs or when the size is unknown
+	Checksum   string  `json:"checksum,omitempty"` // SHA-256 of the binary, when computed
+	Module     string  `json:"module,omitempty"`   // Module path from the binary's build info, when read
+	Version    string  `json:"version,omitempty"`  // Module version from the binary's build info, when read
+	DurationMs float64 `json:"durationMs"`         // Milliseconds spent deleting the binary; zero for dry runs
+}
+
+// Failure describes a binary a session could not remove.
+type Failure struct {
+	Name       string  `json:"name"`       // Binary file name
+	Error      string  `json:"error"`      // Why the removal failed
+	DurationMs float64 `json:"durationMs"` // Milliseconds spent on the failed deletion; zero if it was never attempted
+}
+
+// Report summarizes the removals performed during a session.
+type Report struct {
+	DryRun      bool      `json:"dryRun"`                // True when nothing was actually deleted
+	Interrupted bool      `json:"interrupted,omitempty"` // True when the session was stopped before every binary was tried
+	Removals    []Removal `json:"removals"`              // Binaries removed, or that would have been removed
+	Failures    []Failure `json:"failures,omitempty"`    // Binaries that could not be removed
+}
+
+// newFailure records the failed removal of name. removal is what the removal
+// returned alongside err, carrying the timing when deletion was attempted.
+func newFailure(name string, removal Removal, err error) Failure {
+	return Failure{Name: name, Error: err.Error(), DurationMs: removal.DurationMs}
+}
+
+// durationMs converts d to fractional milliseconds, keeping the sub-millisecond
+// precision fast local deletions need.
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// WriteReport writes the report as indented JSON to the file at path,
+// replacing any existing file.
+func WriteReport(path string, report Report) error {
+	// Encode an empty list rather than null when nothing was removed.
+	if report.Removals == nil {
+		report.Removals = []Removal{}
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create report %s: %w", path, err)
+	}
+
+	if err := writeJSON(file, report, true); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write report %s: %w", path, err)
+	}
+
+	return nil
+}
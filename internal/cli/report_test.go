@@ -0,0 +1,263 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// TestWriteReport verifies the report file contents for dry runs and empty sessions.
+func TestWriteReport(t *testing.T) {
+	tests := []struct {
+		name   string
+		report Report
+		want   string
+	}{
+		{
+			name: "dry run",
+			report: Report{
+				DryRun:   true,
+				Removals: []Removal{{Name: "vhs", Path: "/bin/vhs"}},
+			},
+			want: "{\n" +
+				`  "dryRun": true,` + "\n" +
+				`  "removals": [` + "\n" +
+				"    {\n" +
+				`      "name": "vhs",` + "\n" +
+				`      "path": "/bin/vhs",` + "\n" +
+				`      "bytesFreed": 0,` + "\n" +
+				`      "durationMs": 0` + "\n" +
+				"    }\n" +
+				"  ]\n" +
+				"}\n",
+		},
+		{
+			name:   "no removals",
+			report: Report{},
+			want:   "{\n" + `  "dryRun": false,` + "\n" + `  "removals": []` + "\n" + "}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "report.json")
+
+			require.NoError(t, WriteReport(path, tt.report))
+
+			got, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+// TestRun_DryRunReport verifies a dry run leaves the binary in place and reports it.
+func TestRun_DryRunReport(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", mock.Anything).Return([]string{"vhs"})
+	filesystem.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+	filesystem.On("Checksum", "/bin/vhs").Return("abc123", nil)
+	filesystem.On("BinarySize", "/bin/vhs").Return(int64(1500), nil)
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	err := Run(deps, Config{Binary: "vhs", DryRun: true, Report: path})
+	gotOutput := getOutput()
+
+	require.NoError(t, err)
+	assert.Equal(t, "Would remove vhs\n", gotOutput)
+	filesystem.AssertNotCalled(t, "RemoveBinary", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	got, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(got), `"dryRun": true`)
+	assert.Contains(t, string(got), `"name": "vhs"`)
+	assert.Contains(t, string(got), `"checksum": "abc123"`)
+	assert.Contains(t, string(got), `"size": 1500`)
+	assert.Contains(t, string(got), `"bytesFreed": 0`)
+}
+
+// TestRun_DryRunMissing verifies a dry run fails for a binary that is not
+// there, as the real removal would, instead of reporting it as removable.
+func TestRun_DryRunMissing(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", mock.Anything).Return([]string{"age"})
+	filesystem.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+	filesystem.On("BinarySize", "/bin/vhs").Return(int64(0), fmt.Errorf("%w: /bin/vhs", fs.ErrBinaryNotFound))
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	err := Run(deps, Config{Binary: "vhs", DryRun: true})
+
+	require.ErrorIs(t, err, fs.ErrBinaryNotFound)
+	assert.Empty(t, getOutput())
+}
+
+// TestRun_DryRunExitCode verifies a single dry-run removal with --exit-code
+// returns ErrChangesPending after printing its plan.
+func TestRun_DryRunExitCode(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", mock.Anything).Return([]string{"vhs"})
+	filesystem.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+	filesystem.On("BinarySize", "/bin/vhs").Return(int64(1500), nil)
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	err := Run(deps, Config{Binary: "vhs", DryRun: true, ExitCode: true})
+
+	require.ErrorIs(t, err, ErrChangesPending)
+	assert.Equal(t, "Would remove vhs\n", getOutput())
+}
+
+// TestRun_ReportBytesFreed verifies report entries record the bytes freed by a
+// removal, and zero when the size could not be read beforehand.
+func TestRun_ReportBytesFreed(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    int64
+		sizeErr error
+		want    Removal
+	}{
+		{
+			name: "measured",
+			size: 2_500_000,
+			want: Removal{Name: "vhs", Path: "/bin/vhs", Size: 2_500_000, BytesFreed: 2_500_000, Checksum: "abc123"},
+		},
+		{
+			name:    "size unavailable",
+			sizeErr: errors.New("stat failed"),
+			want:    Removal{Name: "vhs", Path: "/bin/vhs", Checksum: "abc123"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesystem := mockFS.NewMockFS(t)
+			filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+			filesystem.On("ListBinaries", "/bin", mock.Anything).Return([]string{"vhs"})
+			filesystem.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+			filesystem.On("Checksum", "/bin/vhs").Return("abc123", nil)
+			filesystem.On("BinarySize", "/bin/vhs").Return(tt.size, tt.sizeErr)
+			filesystem.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).Return(nil)
+
+			path := filepath.Join(t.TempDir(), "report.json")
+
+			captureStdout(t)
+
+			deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+			require.NoError(t, Run(deps, Config{Binary: "vhs", Report: path}))
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			var got Report
+			require.NoError(t, json.Unmarshal(data, &got))
+			require.Len(t, got.Removals, 1)
+			assert.GreaterOrEqual(t, got.Removals[0].DurationMs, 0.0)
+
+			got.Removals[0].DurationMs = 0
+			assert.Equal(t, []Removal{tt.want}, got.Removals)
+		})
+	}
+}
+
+// TestRunBatch_ReportDurations verifies the report times both successful and
+// failed deletions, so slow filesystems show up in either.
+func TestRunBatch_ReportDurations(t *testing.T) {
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+
+	for _, name := range []string{"age", "vhs"} {
+		filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+		filesystem.On("Checksum", "/bin/"+name).Return("abc123", nil)
+		filesystem.On("BinarySize", "/bin/"+name).Return(int64(1000), nil)
+	}
+
+	filesystem.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).Return(nil)
+	filesystem.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).Return(errors.New("permission denied"))
+
+	path := filepath.Join(t.TempDir(), "report.json")
+
+	captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	require.Error(t, RunBatch(context.Background(), deps, Config{Report: path}, []string{"age", "vhs"}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// Decode loosely so a missing field fails rather than reading as zero.
+	var got struct {
+		Removals []map[string]any `json:"removals"`
+		Failures []map[string]any `json:"failures"`
+	}
+	require.NoError(t, json.Unmarshal(data, &got))
+	require.Len(t, got.Removals, 1)
+	require.Len(t, got.Failures, 1)
+
+	for _, entry := range []map[string]any{got.Removals[0], got.Failures[0]} {
+		duration, ok := entry["durationMs"].(float64)
+		require.True(t, ok, "entry %v has no durationMs", entry)
+		assert.GreaterOrEqual(t, duration, 0.0)
+	}
+
+	assert.Equal(t, "vhs", got.Failures[0]["name"])
+	assert.Equal(t, "failed to remove binary vhs: permission denied", got.Failures[0]["error"])
+}
+
+// TestRunBatch_ReportInterrupted verifies a batch canceled partway through
+// still writes a report of what it finished, marked as interrupted.
+func TestRunBatch_ReportInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("AdjustBinaryPath", "/bin", "age").Return("/bin/age")
+	filesystem.On("Checksum", "/bin/age").Return("abc123", nil)
+	filesystem.On("BinarySize", "/bin/age").Return(int64(1000), nil)
+	filesystem.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).
+		Run(func(mock.Arguments) { cancel() }). // Simulate Ctrl+C arriving mid-removal
+		Return(nil)
+
+	path := filepath.Join(t.TempDir(), "report.json")
+
+	captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	err := RunBatch(ctx, deps, Config{Report: path}, []string{"age", "vhs"})
+	require.ErrorIs(t, err, context.Canceled)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var got Report
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.True(t, got.Interrupted)
+	require.Len(t, got.Removals, 1)
+	assert.Equal(t, "age", got.Removals[0].Name)
+	assert.Empty(t, got.Failures)
+}
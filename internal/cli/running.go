@@ -0,0 +1,129 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/nicholas-fedor/go-remove/internal/proc"
+)
+
+// ErrProcessFinderNotInitialized indicates --keep-running was requested without a process finder.
+var ErrProcessFinderNotInitialized = errors.New("process finder not initialized")
+
+// skipRunning drops the names whose binaries in binDir currently have running
+// processes, printing a warning for each. Removing a binary that is in use
+// fails on Windows and can confuse the running process elsewhere.
+func skipRunning(deps Dependencies, binDir string, names []string) ([]string, error) {
+	if deps.Processes == nil {
+		return nil, ErrProcessFinderNotInitialized
+	}
+
+	// Keeping running tools is a safety check, so a failed lookup stops the
+	// batch rather than removing them anyway.
+	running, err := deps.Processes.RunningExecutables()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find running binaries: %w", err)
+	}
+
+	var kept []string
+
+	for _, name := range names {
+		path := deps.FS.AdjustBinaryPath(binDir, name)
+		if !containsPath(running, path) {
+			kept = append(kept, name)
+
+			continue
+		}
+
+		fmt.Fprintf(os.Stdout, "Warning: %s is running; skipping\n", name)
+	}
+
+	return kept, nil
+}
+
+// skipParent drops the names whose binaries in binDir, or whose paths in path
+// mode, are the executable of the process that started go-remove, printing a
+// warning for each. Deleting a tool from under its own wrapper invocation
+// fails on Windows and can break the wrapper elsewhere.
+//
+// The check is best effort: when the parent's executable cannot be determined,
+// including on platforms without a known lookup, every name is kept.
+func skipParent(deps Dependencies, binDir string, names []string) ([]string, error) {
+	if deps.Processes == nil {
+		return nil, ErrProcessFinderNotInitialized
+	}
+
+	parent, err := deps.Processes.ParentExecutable()
+	if err != nil {
+		if errors.Is(err, proc.ErrUnsupportedPlatform) {
+			deps.Logger.Debug().Err(err).Msg("Skipping parent process check")
+		} else {
+			deps.Logger.Warn().Err(err).Msg("Could not determine parent process; not skipping it")
+		}
+
+		return names, nil
+	}
+
+	var kept []string
+
+	for _, name := range names {
+		if !isParentExecutable(parent, targetPath(deps, binDir, name)) {
+			kept = append(kept, name)
+
+			continue
+		}
+
+		fmt.Fprintf(os.Stdout, "Warning: %s started go-remove; skipping\n", name)
+	}
+
+	return kept, nil
+}
+
+// targetPath returns the path of name in binDir, or name made absolute when
+// binDir is empty because names are paths.
+func targetPath(deps Dependencies, binDir, name string) string {
+	if binDir != "" {
+		return deps.FS.AdjustBinaryPath(binDir, name)
+	}
+
+	if abs, err := filepath.Abs(name); err == nil {
+		return abs
+	}
+
+	return name
+}
+
+// isParentExecutable reports whether path names the parent executable, either
+// directly or through a symlink.
+func isParentExecutable(parent, path string) bool {
+	if containsPath([]string{parent}, path) {
+		return true
+	}
+
+	resolved, err := filepath.EvalSymlinks(path)
+
+	return err == nil && containsPath([]string{parent}, resolved)
+}
+
+// containsPath reports whether paths includes path, ignoring case on Windows.
+func containsPath(paths []string, path string) bool {
+	path = filepath.Clean(path)
+
+	for _, candidate := range paths {
+		candidate = filepath.Clean(candidate)
+		if candidate == path || (runtime.GOOS == "windows" && strings.EqualFold(candidate, path)) {
+			return true
+		}
+	}
+
+	return false
+}
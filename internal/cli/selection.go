@@ -0,0 +1,127 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+)
+
+// ReadSelection reads the binary names listed in the file at path.
+//
+// Names are one per line. Surrounding whitespace is trimmed, blank lines and
+// lines starting with "#" are ignored, and repeated names are kept once.
+//
+// Parameters:
+//   - path: Path of the selection file
+//
+// Returns:
+//   - The listed names in file order
+//   - An error if the file cannot be read
+func ReadSelection(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open selection file: %w", err)
+	}
+	defer file.Close()
+
+	var names []string
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" || strings.HasPrefix(name, "#") || slices.Contains(names, name) {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read selection file %s: %w", path, err)
+	}
+
+	return names, nil
+}
+
+// RunSelection removes the binaries listed in config.SelectFile as a batch.
+//
+// Every listed name is checked against the target directory first, and the
+// plan is printed before anything is removed: the binaries that will be
+// removed, then a warning for each name that is not installed. Missing names
+// do not fail the run.
+func RunSelection(ctx context.Context, deps Dependencies, config Config) error {
+	names, err := ReadSelection(config.SelectFile)
+	if err != nil {
+		_ = deps.Logger.Sync() // Flush logs; errors are ignored
+
+		return err
+	}
+
+	binDir, err := deps.FS.DetermineBinDir(config.Goroot)
+	if err != nil {
+		_ = deps.Logger.Sync()
+
+		return fmt.Errorf("failed to determine binary directory: %w", err)
+	}
+
+	if err := checkSafeDir(config, binDir); err != nil {
+		_ = deps.Logger.Sync()
+
+		return err
+	}
+
+	// Hidden files are included: the file names them explicitly.
+	installed := deps.FS.ListBinaries(binDir, fs.ListOptions{
+		ShowHidden:           true,
+		IncludeBundles:       config.IncludeBundles,
+		IncludeNonExecutable: config.IncludeNonExecutable,
+	})
+
+	var present, missing []string
+
+	for _, name := range names {
+		if slices.Contains(installed, onDiskName(deps.FS, binDir, name)) {
+			present = append(present, name)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+
+	writePlan(config.SelectFile, binDir, names, present, missing)
+
+	if len(present) == 0 {
+		_ = deps.Logger.Sync() // Errors are ignored
+
+		return nil
+	}
+
+	return runBatch(ctx, deps, binDir, config, present)
+}
+
+// writePlan prints the binaries a selection will remove and warns about the
+// listed names that are not installed.
+func writePlan(file, binDir string, names, present, missing []string) {
+	if len(present) == 0 {
+		fmt.Fprintf(os.Stdout, "No binaries listed in %s are installed in %s\n", file, binDir)
+	} else {
+		fmt.Fprintf(os.Stdout, "Plan: remove %d of %d binaries listed in %s\n", len(present), len(names), file)
+
+		for _, name := range present {
+			fmt.Fprintln(os.Stdout, "  "+name)
+		}
+	}
+
+	for _, name := range missing {
+		fmt.Fprintf(os.Stdout, "Warning: %s is not installed in %s; skipping\n", name, binDir)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// writeSelection writes content to a selection file in a temporary directory.
+func writeSelection(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "cleanup.txt")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write selection file: %v", err)
+	}
+
+	return path
+}
+
+// TestReadSelection verifies blank lines, comments, whitespace, and repeated
+// names are handled.
+func TestReadSelection(t *testing.T) {
+	path := writeSelection(t, "# old tools\nage\n\n  gopls  \nage\n#vhs\n")
+
+	got, err := ReadSelection(path)
+	if err != nil {
+		t.Fatalf("ReadSelection() error = %v", err)
+	}
+
+	if want := []string{"age", "gopls"}; !slices.Equal(got, want) {
+		t.Errorf("ReadSelection() = %q, want %q", got, want)
+	}
+
+	if _, err := ReadSelection(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Error("ReadSelection() of a missing file error = nil, want an error")
+	}
+}
+
+// TestRunSelection verifies the plan is printed up front, installed names are
+// removed, and names that are not installed are warned about and skipped.
+func TestRunSelection(t *testing.T) {
+	path := writeSelection(t, "age\nold-tool\nvhs\n")
+
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", fs.ListOptions{ShowHidden: true}).
+		Return([]string{"age", "gopls", "vhs"})
+
+	filesystem.On("AdjustBinaryPath", "/bin", "old-tool").Return("/bin/old-tool")
+
+	for _, name := range []string{"age", "vhs"} {
+		filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+		filesystem.On("BinarySize", "/bin/"+name).Return(int64(1000), nil)
+		filesystem.On("RemoveBinary", "/bin/"+name, name, false, mock.Anything).Return(nil)
+	}
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	err := RunSelection(context.Background(), deps, Config{SelectFile: path})
+	gotOutput := getOutput()
+
+	if err != nil {
+		t.Fatalf("RunSelection() error = %v", err)
+	}
+
+	want := "Plan: remove 2 of 3 binaries listed in " + path + "\n  age\n  vhs\n" +
+		"Warning: old-tool is not installed in /bin; skipping\n"
+	if !strings.HasPrefix(gotOutput, want) {
+		t.Errorf("RunSelection() output = %q, want it to start with %q", gotOutput, want)
+	}
+
+	for _, want := range []string{"Successfully removed age\n", "Successfully removed vhs\n"} {
+		if !strings.Contains(gotOutput, want) {
+			t.Errorf("RunSelection() output = %q, want it to contain %q", gotOutput, want)
+		}
+	}
+
+	filesystem.AssertNotCalled(t, "RemoveBinary", "/bin/gopls", "gopls", false, mock.Anything)
+}
+
+// TestRunSelection_NoneInstalled verifies nothing is removed when no listed
+// name is installed.
+func TestRunSelection_NoneInstalled(t *testing.T) {
+	path := writeSelection(t, "old-tool\n")
+
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", fs.ListOptions{ShowHidden: true}).Return([]string{"age"})
+	filesystem.On("AdjustBinaryPath", "/bin", "old-tool").Return("/bin/old-tool")
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	if err := RunSelection(context.Background(), deps, Config{SelectFile: path}); err != nil {
+		t.Fatalf("RunSelection() error = %v", err)
+	}
+
+	want := "No binaries listed in " + path + " are installed in /bin\n" +
+		"Warning: old-tool is not installed in /bin; skipping\n"
+	if got := getOutput(); got != want {
+		t.Errorf("RunSelection() output = %q, want %q", got, want)
+	}
+}
+
+// TestRunSelection_WindowsSuffix verifies a listed name matches its installed
+// .exe file, as the directory is listed on Windows.
+func TestRunSelection_WindowsSuffix(t *testing.T) {
+	path := writeSelection(t, "age\n")
+
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("DetermineBinDir", false).Return("/bin", nil)
+	filesystem.On("ListBinaries", "/bin", fs.ListOptions{ShowHidden: true}).Return([]string{"age.exe"})
+	filesystem.On("AdjustBinaryPath", "/bin", "age").Return("/bin/age.exe")
+	filesystem.On("BinarySize", "/bin/age.exe").Return(int64(1000), nil)
+	filesystem.On("RemoveBinary", "/bin/age.exe", "age", false, mock.Anything).Return(nil)
+
+	getOutput := captureStdout(t)
+
+	deps := Dependencies{FS: filesystem, Logger: newMockLoggerWithDefaults(t)}
+	if err := RunSelection(context.Background(), deps, Config{SelectFile: path}); err != nil {
+		t.Fatalf("RunSelection() error = %v", err)
+	}
+
+	if got := getOutput(); !strings.HasPrefix(got, "Plan: remove 1 of 1 binaries listed in "+path+"\n  age\n") {
+		t.Errorf("RunSelection() output = %q, want age planned for removal", got)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// PathResolver finds the file that runs for a command name, as exec.LookPath does.
+type PathResolver func(file string) (string, error)
+
+// lookPath returns the configured PATH resolver, falling back to exec.LookPath.
+func (d Dependencies) lookPath() PathResolver {
+	if d.LookPath != nil {
+		return d.LookPath
+	}
+
+	return exec.LookPath
+}
+
+// markShadowed records, for each entry whose name resolves on PATH to a
+// different file, the path that actually runs. Binaries not found on PATH are
+// left unmarked, since nothing else runs in their place.
+func markShadowed(lookPath PathResolver, entries []ListEntry) {
+	for i, entry := range entries {
+		resolved, err := lookPath(entry.Name)
+		if err != nil {
+			continue
+		}
+
+		if !samePath(resolved, entry.Path) {
+			entries[i].ShadowedBy = resolved
+		}
+	}
+}
+
+// samePath reports whether a and b name the same file, following symlinks
+// when both exist and comparing cleaned absolute paths otherwise.
+func samePath(a, b string) bool {
+	infoA, errA := os.Stat(a)
+	infoB, errB := os.Stat(b)
+
+	if errA == nil && errB == nil {
+		return os.SameFile(infoA, infoB)
+	}
+
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+
+	if errA != nil || errB != nil {
+		return filepath.Clean(a) == filepath.Clean(b)
+	}
+
+	return absA == absB
+}
+
+// writeShadowed prints each binary that an earlier PATH entry shadows, with
+// the path that runs instead.
+func writeShadowed(entries []ListEntry) {
+	var shadowed []ListEntry
+
+	for _, entry := range entries {
+		if entry.ShadowedBy != "" {
+			shadowed = append(shadowed, entry)
+		}
+	}
+
+	if len(shadowed) == 0 {
+		return
+	}
+
+	fmt.Fprintf(os.Stdout, "\nShadowed on PATH %d:\n", len(shadowed))
+
+	for _, entry := range shadowed {
+		fmt.Fprintf(os.Stdout, "  %s (runs %s)\n", entry.Name, entry.ShadowedBy)
+	}
+}
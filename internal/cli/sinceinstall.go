@@ -0,0 +1,123 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+)
+
+// ErrInvalidInstallDate indicates a --since-install value that is not a date such as 2024-01-31 or <2024-01-31.
+var ErrInvalidInstallDate = errors.New("--since-install must be a date such as 2024-01-31 or <2024-01-31")
+
+// buildTimeMatcher reports whether a binary built at the given time is
+// selected by --since-install.
+type buildTimeMatcher func(built time.Time) bool
+
+// ValidateSinceInstall reports whether spec is a valid --since-install value.
+//
+// A date such as "2024-01-31" selects binaries built on or after the start of
+// that day, local time, and a leading "<" selects binaries built before it,
+// e.g. "<2024-01-31". An RFC 3339 timestamp may be given instead of a date.
+func ValidateSinceInstall(spec string) error {
+	_, err := parseSinceInstall(spec)
+
+	return err
+}
+
+// parseSinceInstall returns the matcher for a --since-install value, or an
+// error wrapping ErrInvalidInstallDate if it is not a date.
+func parseSinceInstall(spec string) (buildTimeMatcher, error) {
+	date, before := strings.CutPrefix(strings.TrimSpace(spec), "<")
+	date = strings.TrimSpace(date)
+
+	cutoff, err := time.ParseInLocation(time.DateOnly, date, time.Local)
+	if err != nil {
+		cutoff, err = time.Parse(time.RFC3339, date)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidInstallDate, spec)
+	}
+
+	if before {
+		return func(built time.Time) bool { return built.Before(cutoff) }, nil
+	}
+
+	return func(built time.Time) bool { return !built.Before(cutoff) }, nil
+}
+
+// selectSinceInstall keeps the names whose binaries in binDir were built in
+// the window config.SinceInstall selects. A binary is dated by the VCS commit
+// time in its build info when it has one, which survives copies and restores
+// that reset the file's modification time; otherwise its modification time
+// is used. Without an extractor every binary is dated by modification time.
+func selectSinceInstall(
+	ctx context.Context,
+	deps Dependencies,
+	binDir string,
+	config Config,
+	names []string,
+) ([]string, error) {
+	matches, err := parseSinceInstall(config.SinceInstall)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := deps.FS.ListBinariesWithInfo(binDir, fs.ListOptions{
+		ShowHidden:           true,
+		IncludeBundles:       true,
+		IncludeNonExecutable: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read modification times: %w", err)
+	}
+
+	modTimes := make(map[string]time.Time, len(infos))
+	for _, info := range infos {
+		modTimes[info.Name] = info.ModTime
+	}
+
+	var kept []string
+
+	for _, name := range names {
+		built, ok := vcsTime(ctx, deps, deps.FS.AdjustBinaryPath(binDir, name))
+		if !ok {
+			built = modTimes[name]
+		}
+
+		if matches(built) {
+			kept = append(kept, name)
+		}
+	}
+
+	return kept, nil
+}
+
+// vcsTime returns the VCS commit time recorded in the build info of the
+// binary at binaryPath, and whether it has a readable one.
+func vcsTime(ctx context.Context, deps Dependencies, binaryPath string) (time.Time, bool) {
+	if deps.Extractor == nil {
+		return time.Time{}, false
+	}
+
+	info, err := deps.Extractor.Extract(ctx, binaryPath)
+	if err != nil || info == nil || info.VCSTime == "" {
+		return time.Time{}, false
+	}
+
+	built, err := time.Parse(time.RFC3339, info.VCSTime)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return built, true
+}
@@ -0,0 +1,130 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
+	mockBuildInfo "github.com/nicholas-fedor/go-remove/internal/buildinfo/mocks"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// Test_parseSinceInstall verifies dates select binaries built on or after
+// them, "<" selects binaries built before, and malformed values are rejected.
+func Test_parseSinceInstall(t *testing.T) {
+	day := time.Date(2024, time.January, 31, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		spec    string
+		match   []time.Time
+		noMatch []time.Time
+		wantErr bool
+	}{
+		{spec: "2024-01-31", match: []time.Time{day, day.AddDate(1, 0, 0)}, noMatch: []time.Time{day.Add(-time.Second)}},
+		{spec: "<2024-01-31", match: []time.Time{day.Add(-time.Second)}, noMatch: []time.Time{day}},
+		{
+			spec:    "2024-01-31T12:00:00Z",
+			match:   []time.Time{time.Date(2024, time.January, 31, 12, 0, 0, 0, time.UTC)},
+			noMatch: []time.Time{time.Date(2024, time.January, 31, 11, 59, 59, 0, time.UTC)},
+		},
+		{spec: "31/01/2024", wantErr: true},
+		{spec: "", wantErr: true},
+		{spec: "<", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			matches, err := parseSinceInstall(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSinceInstall() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				if !errors.Is(err, ErrInvalidInstallDate) {
+					t.Errorf("parseSinceInstall() error = %v, want %v", err, ErrInvalidInstallDate)
+				}
+
+				return
+			}
+
+			for _, built := range tt.match {
+				if !matches(built) {
+					t.Errorf("%s does not match %s, want a match", tt.spec, built)
+				}
+			}
+
+			for _, built := range tt.noMatch {
+				if matches(built) {
+					t.Errorf("%s matches %s, want no match", tt.spec, built)
+				}
+			}
+		})
+	}
+}
+
+// Test_selectSinceInstall verifies the VCS time in build info decides when a
+// binary was built, and the modification time decides when there is none.
+func Test_selectSinceInstall(t *testing.T) {
+	recent := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	old := time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	filesystem := mockFS.NewMockFS(t)
+	filesystem.On("ListBinariesWithInfo", "/bin", mock.Anything).Return([]fs.BinaryInfo{
+		{Name: "age", ModTime: recent},     // Copied recently, but built from an old commit
+		{Name: "gopls", ModTime: old},      // Restored with an old mtime, but built recently
+		{Name: "script", ModTime: old},     // Not a Go binary
+		{Name: "devtool", ModTime: recent}, // Built without VCS information
+	}, nil)
+
+	extractor := mockBuildInfo.NewMockExtractor(t)
+
+	for name, info := range map[string]*buildinfo.BuildInfoData{
+		"age":     {VCSTime: "2022-03-01T10:00:00Z"},
+		"gopls":   {VCSTime: "2025-05-20T08:30:00Z"},
+		"script":  nil,
+		"devtool": {},
+	} {
+		filesystem.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+
+		if info == nil {
+			extractor.On("Extract", mock.Anything, "/bin/"+name).Return(nil, buildinfo.ErrNotGoBinary)
+		} else {
+			extractor.On("Extract", mock.Anything, "/bin/"+name).Return(info, nil)
+		}
+	}
+
+	deps := Dependencies{FS: filesystem, Logger: &tuiMockLogger{}, Extractor: extractor}
+	names := []string{"age", "devtool", "gopls", "script"}
+
+	tests := []struct {
+		spec string
+		want []string
+	}{
+		{spec: "<2024-01-01", want: []string{"age", "script"}},
+		{spec: "2024-01-01", want: []string{"devtool", "gopls"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := selectSinceInstall(context.Background(), deps, "/bin", Config{SinceInstall: tt.spec}, names)
+			if err != nil {
+				t.Fatalf("selectSinceInstall() error = %v", err)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("selectSinceInstall() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
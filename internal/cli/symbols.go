@@ -0,0 +1,73 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Symbol set names accepted by --symbols.
+const (
+	SymbolsUnicode = "unicode" // Check and cross marks
+	SymbolsASCII   = "ascii"   // Plain OK and FAIL tags for terminals without Unicode
+)
+
+// ErrUnknownSymbols indicates an unrecognized symbol set name.
+var ErrUnknownSymbols = errors.New("unknown symbol set")
+
+// SymbolSet holds the glyphs that mark results in output.
+//
+// The zero value marks nothing, leaving output unprefixed.
+type SymbolSet struct {
+	Success  string `json:"success"`  // Prefix for binaries that were removed
+	Failure  string `json:"failure"`  // Prefix for binaries that could not be removed
+	Selected string `json:"selected"` // Marker for binaries selected in the TUI; empty uses the default
+}
+
+// ParseSymbols returns the symbol set with the given name.
+//
+// Parameters:
+//   - name: Symbol set name, either "unicode" or "ascii"
+//
+// Returns:
+//   - The matching symbol set
+//   - An error wrapping ErrUnknownSymbols if the name is not recognized
+func ParseSymbols(name string) (SymbolSet, error) {
+	switch name {
+	case SymbolsUnicode:
+		return SymbolSet{Success: "✓", Failure: "✗", Selected: "✓"}, nil
+	case SymbolsASCII:
+		return SymbolSet{Success: "OK", Failure: "FAIL", Selected: "*"}, nil
+	default:
+		return SymbolSet{}, fmt.Errorf(
+			"%w %q: use %s or %s",
+			ErrUnknownSymbols,
+			name,
+			SymbolsUnicode,
+			SymbolsASCII,
+		)
+	}
+}
+
+// succeeded prefixes line with the success symbol, if any.
+func (s SymbolSet) succeeded(line string) string {
+	return mark(s.Success, line)
+}
+
+// failed prefixes line with the failure symbol, if any.
+func (s SymbolSet) failed(line string) string {
+	return mark(s.Failure, line)
+}
+
+// mark prefixes line with symbol, leaving it unchanged when symbol is empty.
+func mark(symbol, line string) string {
+	if symbol == "" {
+		return line
+	}
+
+	return symbol + " " + line
+}
@@ -0,0 +1,37 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Sleeper waits for duration, returning early with an error if ctx is canceled first.
+type Sleeper func(ctx context.Context, duration time.Duration) error
+
+// sleep returns the configured sleeper, falling back to sleepContext.
+func (d Dependencies) sleep() Sleeper {
+	if d.Sleep != nil {
+		return d.Sleep
+	}
+
+	return sleepContext
+}
+
+// sleepContext waits for duration on a timer, or until ctx is canceled.
+func sleepContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("throttle wait canceled: %w", ctx.Err())
+	}
+}
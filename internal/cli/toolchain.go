@@ -0,0 +1,47 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	"github.com/nicholas-fedor/go-remove/internal/logger"
+)
+
+// goMissingWarning logs the missing toolchain warning at most once per run.
+var goMissingWarning sync.Once
+
+// CheckGoToolchain warns when config uses a feature that runs the go command
+// and there is none on PATH. Those features degrade instead of failing:
+// go env settings come from the process environment and reinstalls are
+// skipped before the binary is removed.
+func CheckGoToolchain(log logger.Logger, config Config) {
+	// --go-version finds its own toolchain and reports a missing one itself.
+	needsGo := (config.DirFromGoEnv && config.GoVersion == "") || config.ReinstallVersion != ""
+	if !needsGo {
+		return
+	}
+
+	if _, err := fs.GoCommand(); err != nil {
+		warnGoMissing(log, err)
+	}
+}
+
+// warnGoMissing logs, once per run, that err reports a missing go command,
+// and reports whether it did.
+func warnGoMissing(log logger.Logger, err error) bool {
+	if !errors.Is(err, fs.ErrGoNotFound) {
+		return false
+	}
+
+	goMissingWarning.Do(func() {
+		log.Warn().Err(err).Msg("Go toolchain unavailable; go env settings and reinstalls will be skipped")
+	})
+
+	return true
+}
@@ -0,0 +1,45 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	mockLogger "github.com/nicholas-fedor/go-remove/internal/logger/mocks"
+)
+
+// Test_warnGoMissing verifies a missing go command is warned about once per
+// run and other errors are left to the caller.
+func Test_warnGoMissing(t *testing.T) {
+	goMissingWarning = sync.Once{}
+
+	t.Cleanup(func() { goMissingWarning = sync.Once{} })
+
+	nopLog := zerolog.New(io.Discard)
+
+	log := mockLogger.NewMockLogger(t)
+	log.On("Warn").Return(nopLog.Warn()).Once()
+
+	missing := fmt.Errorf("%w: %w", fs.ErrGoNotFound, exec.ErrNotFound)
+
+	for range 2 {
+		if !warnGoMissing(log, missing) {
+			t.Error("warnGoMissing() = false for a missing go command, want true")
+		}
+	}
+
+	if warnGoMissing(log, errors.New("exit status 1")) {
+		t.Error("warnGoMissing() = true for another error, want false")
+	}
+}
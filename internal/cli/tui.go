@@ -10,8 +10,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"os"
+	"os/signal"
+	"slices"
 	"sort"
 	"strings"
+	"syscall"
 	"time"
 
 	"charm.land/lipgloss/v2"
@@ -19,6 +24,9 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/nicholas-fedor/go-remove/internal/audit"
+	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
+	"github.com/nicholas-fedor/go-remove/internal/format"
 	"github.com/nicholas-fedor/go-remove/internal/fs"
 	"github.com/nicholas-fedor/go-remove/internal/history"
 	"github.com/nicholas-fedor/go-remove/internal/logger"
@@ -27,16 +35,17 @@ import (
 // Layout constants for TUI rendering.
 // These constants must be kept consistent between updateGrid() and the view functions.
 const (
-	colWidthPadding          = 3                  // Padding added to column width for spacing
+	colWidthPadding          = 1                  // Default spaces between grid columns
 	availWidthAdjustment     = 4                  // Adjustment to width for border and padding
 	minAvailHeightAdjustment = 8                  // Minimum height adjustment for UI elements (title + footer + padding)
-	visibleLenPrefix         = 2                  // Prefix length for cursor visibility
+	visibleLenPrefix         = 2                  // Minimum width reserved for the cursor or selection marker
 	totalHeightBase          = 8                  // Base height for non-grid UI components (must match minAvailHeightAdjustment)
 	footerHeight             = 1                  // Height reserved for footer/instructions
 	leftPadding              = 2                  // Left padding for the entire TUI
 	maxLogLines              = 50                 // Maximum number of log lines to retain
 	maxVisibleLogLines       = 5                  // Maximum number of log lines to display
 	logPanelSeparatorLines   = 2                  // Number of separator lines for log panel
+	maxVisibleRemovedLines   = 5                  // Maximum number of session removals to display
 	maxHistoryEntries        = 100                // Maximum number of history entries to display
 	dateTimeFormat           = "2006-01-02 15:04" // Format for displaying timestamps
 	separatorAdjustment      = 2                  // Extra width for column separator
@@ -50,16 +59,30 @@ const (
 	modeHistory  = "history"  // Mode for history view
 )
 
+// Grid orders for Config.GridOrder.
+const (
+	GridOrderColumn = "column" // Fill down each column before the next, the default
+	GridOrderRow    = "row"    // Fill across each row before the next, like ls
+)
+
 // Confirmation constants for destructive operations.
 const (
 	confirmNone       = ""                 // No confirmation pending
 	confirmClearAll   = "clear_all"        // Confirm clearing all history
 	confirmDeletePerm = "delete_permanent" // Confirm permanent deletion
+	confirmQuit       = "quit"             // Confirm quitting with a pending selection
+	confirmBundle     = "remove_bundle"    // Confirm recursively removing app bundles
 )
 
+// selectedMarker is the default prefix shown next to binaries marked for removal.
+const selectedMarker = "✓ "
+
 // ErrNoBinariesFound signals that no binaries were found in the target directory.
 var ErrNoBinariesFound = errors.New("no binaries found in directory")
 
+// ErrUnknownGridOrder indicates a grid order other than row or column.
+var ErrUnknownGridOrder = errors.New("grid order must be row or column")
+
 // ErrHistoryNotInitialized indicates the history manager was not initialized.
 var ErrHistoryNotInitialized = errors.New("history manager not initialized")
 
@@ -90,21 +113,34 @@ type styleConfig struct {
 	HistoryColor  string // ANSI 256-color code for history table header
 	TrashYesColor string // ANSI 256-color code for "Yes" in trash available column
 	TrashNoColor  string // ANSI 256-color code for "No" in trash available column
+	CorruptColor  string // ANSI 256-color code for binaries flagged by Config.CheckCorrupt
 	Cursor        string // Symbol used for the cursor
+	Selected      string // Marker shown next to selected binaries
+	ColumnPadding int    // Spaces between grid columns; 0 uses colWidthPadding
 }
 
 // model encapsulates the state of the TUI.
 type model struct {
 	// Mode and view state
-	mode         string // Current mode: "binaries" or "history"
-	confirmation string // Pending confirmation for destructive operations
+	mode         string      // Current mode: "binaries" or "history"
+	confirmation string      // Pending confirmation for destructive operations
+	menu         *actionMenu // Open per-binary action menu; nil when closed
 
 	// Binary selection state
-	choices []string // List of available binaries
-	cursorX int      // Horizontal cursor position (column)
-	cursorY int      // Vertical cursor position (row)
-	cols    int      // Number of columns in the grid
-	rows    int      // Number of rows in the grid
+	choices  []string          // List of available binaries
+	selected map[string]bool   // Binaries marked for removal
+	corrupt  map[string]string // Why each checked binary looks corrupt, "" if intact; only with Config.CheckCorrupt
+	removals []Removal         // Binaries removed, or in dry-run mode intended for removal
+	dryRun   map[string]bool   // Binaries a dry run reported as removed, kept out of every listing
+	cursorX  int               // Horizontal cursor position (column)
+	cursorY  int               // Vertical cursor position (row)
+	cols     int               // Number of columns in the grid
+	rows     int               // Number of rows in the grid
+
+	// Initial listing state
+	loading      bool  // Whether the initial directory listing is still running
+	spinnerFrame int   // Index into spinnerFrames shown while loading
+	loadErr      error // Why the session ended before any binaries were shown
 
 	// History state
 	historyEntries []*history.HistoryEntry // History entries for display
@@ -113,18 +149,29 @@ type model struct {
 	historyLoading bool                    // Whether history is being loaded
 
 	// General state
-	dir           string        // Directory containing binaries
-	config        Config        // CLI configuration
-	logger        logger.Logger // Logger instance
-	fs            fs.FS         // Filesystem operations
-	width         int           // Terminal width
-	height        int           // Terminal height
-	status        string        // Status message
-	styles        styleConfig   // TUI appearance settings
-	sortAscending bool          // True for ascending sort, false for descending
-	logs          []string      // Captured log messages (circular buffer)
-	showLogs      bool          // Toggle log panel visibility
-	logChan       chan LogMsg   // Channel for receiving log messages from the logger
+	dir              string              // Directory containing binaries
+	config           Config              // CLI configuration
+	logger           logger.Logger       // Logger instance
+	fs               fs.FS               // Filesystem operations
+	audit            audit.Recorder      // Audit trail each removal attempt is appended to; nil when none is kept
+	width            int                 // Terminal width
+	height           int                 // Terminal height
+	status           string              // Status message
+	styles           styleConfig         // TUI appearance settings
+	sortAscending    bool                // True for ascending sort, false for descending
+	showHidden       bool                // Include hidden (dot-prefixed) binaries
+	filter           string              // Case-insensitive substring binaries must contain to be shown
+	filtering        bool                // Whether keystrokes are being typed into the filter
+	logs             []string            // Captured log messages (circular buffer)
+	showLogs         bool                // Toggle log panel visibility
+	showRemoved      bool                // Toggle removed-this-session panel visibility
+	showKey          bool                // Toggle the line explaining grid markers
+	singleColumn     bool                // List binaries one per line regardless of width
+	reinstallFor     string              // Binary whose reinstall version is being typed; empty when not prompting
+	reinstallVersion string              // Reinstall version typed so far
+	extractor        buildinfo.Extractor // Reads build info for reinstalls; nil uses the default extractor
+	install          Installer           // Runs go install for reinstalls; nil uses goInstall
+	logChan          chan LogMsg         // Channel for receiving log messages from the logger
 }
 
 // DefaultRunner provides the default Bubbletea program runner.
@@ -135,25 +182,34 @@ func NewLogMsg(level, message string) LogMsg {
 	return LogMsg{Level: level, Message: message}
 }
 
-// RunTUI launches the interactive TUI mode for binary selection and removal.
-func RunTUI(
+// NewModel creates a TUI model for the given pre-fetched binary names.
+//
+// It allows library consumers to run the TUI against choices from any source,
+// such as a virtual or remote filesystem, with their own Bubble Tea program.
+// Removals are performed through filesystem; deletion history is not recorded.
+func NewModel(
+	choices []string,
 	dir string,
 	config Config,
 	log logger.Logger,
 	filesystem fs.FS,
-	runner ProgramRunner,
-	historyMgr history.Manager,
-) error {
-	// Fetch available binaries from the specified directory.
-	choices := filesystem.ListBinaries(dir)
-	if len(choices) == 0 && !config.RestoreMode {
-		return fmt.Errorf("%w: %s", ErrNoBinariesFound, dir)
-	}
+) tea.Model {
+	return newModel(choices, dir, config, log, filesystem, nil)
+}
 
-	// Initialize the model with default styles.
+// newModel initializes the TUI model with default styles and log capture.
+func newModel(
+	choices []string,
+	dir string,
+	config Config,
+	log logger.Logger,
+	filesystem fs.FS,
+	historyMgr history.Manager,
+) *model {
 	// Enable log visibility by default when verbose mode is active.
 	m := &model{
 		choices:        choices,
+		corrupt:        make(map[string]string),
 		dir:            dir,
 		config:         config,
 		logger:         log,
@@ -164,12 +220,27 @@ func RunTUI(
 		styles:         defaultStyleConfig(),
 		logs:           make([]string, 0, maxLogLines),
 		showLogs:       config.Verbose,
+		showHidden:     config.ShowHidden,
+		singleColumn:   config.ListLayout,
 		mode:           modeBinaries,
 		historyEntries: make([]*history.HistoryEntry, 0),
 		historyCursor:  0,
 		historyManager: historyMgr,
 	}
 
+	// Apply appearance overrides from the command line.
+	if config.Cursor != "" {
+		m.styles.Cursor = config.Cursor
+	}
+
+	if config.ColumnPadding > 0 {
+		m.styles.ColumnPadding = config.ColumnPadding
+	}
+
+	if config.Symbols.Selected != "" {
+		m.styles.Selected = config.Symbols.Selected + " "
+	}
+
 	// Set up mode based on config
 	if config.RestoreMode {
 		m.mode = modeHistory
@@ -181,24 +252,76 @@ func RunTUI(
 	m.logChan = make(chan LogMsg, maxLogLines)
 	m.setupLogCapture(log)
 
+	return m
+}
+
+// RunTUI launches the interactive TUI mode for binary selection and removal.
+// It returns the binaries removed during the session; in dry-run mode these are
+// the binaries that would have been removed. The directory is listed once the
+// program starts, behind a spinner; an empty listing ends the session with
+// ErrNoBinariesFound. With config.Safe, a directory outside the Go roots is
+// refused with ErrOutsideGoRoots before the program starts. Each removal
+// attempt, including dry runs and failures, is appended to recorder if set.
+func RunTUI(
+	dir string,
+	config Config,
+	log logger.Logger,
+	filesystem fs.FS,
+	runner ProgramRunner,
+	historyMgr history.Manager,
+	recorder audit.Recorder,
+) ([]Removal, error) {
+	if err := checkSafeDir(config, dir); err != nil {
+		return nil, err
+	}
+
+	// The binaries are listed by the model's Init command, behind a spinner.
+	m := newModel(nil, dir, config, log, filesystem, historyMgr)
+	m.loading = true
+	m.audit = recorder
+
+	// Open the view the last --remember-state session left, and keep this
+	// session's view for the next one.
+	m.loadTUIState()
+	defer m.saveTUIState()
+
+	// Send logs back to stderr however the session ends, so nothing logged
+	// afterwards is lost in a channel the TUI no longer reads.
+	defer log.SetCaptureFunc(nil)
+
+	// Cancel the program on SIGINT or SIGTERM even while Bubble Tea is not
+	// listening for them, such as when the terminal is released. Cancelling
+	// shuts the program down, which restores the terminal.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	// Start the TUI program.
-	program, err := runner.RunProgram(m)
+	program, err := runner.RunProgram(m, tea.WithContext(ctx))
 	if err != nil {
-		return fmt.Errorf("failed to start TUI program: %w", err)
+		return nil, fmt.Errorf("failed to start TUI program: %w", err)
 	}
 
 	// Allow mocked runners to return nil for testing purposes.
 	if program == nil {
-		return nil
+		return m.removals, nil
 	}
 
-	// Run the program and capture any runtime errors.
+	// Run the program and capture any runtime errors. An interrupt ends the
+	// session like quitting does, keeping whatever was already removed.
 	_, err = program.Run()
+	if errors.Is(err, tea.ErrInterrupted) || ctx.Err() != nil {
+		return m.removals, nil
+	}
+
 	if err != nil {
-		return fmt.Errorf("failed to run TUI program: %w", err)
+		return m.removals, fmt.Errorf("failed to run TUI program: %w", err)
 	}
 
-	return nil
+	if m.loadErr != nil {
+		return nil, m.loadErr
+	}
+
+	return m.removals, nil
 }
 
 // setupLogCapture configures the logger's capture callback to send messages to the TUI.
@@ -216,17 +339,20 @@ func (m *model) setupLogCapture(log logger.Logger) {
 }
 
 // toggleVerboseLogging toggles verbose logging mode and log panel visibility.
-// It updates both the logger level (Info <-> Debug) and the showLogs state.
+// It updates the logger level (Debug, or back to the --log-level the session
+// started with), the showLogs state, and config.Verbose, so removals made
+// after the toggle log their details too.
 // Returns a command to start polling for logs if verbose mode is being enabled.
 func (m *model) toggleVerboseLogging() tea.Cmd {
 	m.showLogs = !m.showLogs
+	m.config.Verbose = m.showLogs
 
 	if m.showLogs {
 		// Enable verbose logging by setting level to Debug
 		m.logger.Level(zerolog.DebugLevel)
 	} else {
-		// Disable verbose logging by setting level to Info
-		m.logger.Level(zerolog.InfoLevel)
+		// Disable verbose logging by restoring the configured level
+		m.logger.Level(logger.ParseLevel(m.config.LogLevel))
 	}
 
 	// Ensure log capture is set up (lazy initialization for backwards compatibility)
@@ -255,7 +381,10 @@ func defaultStyleConfig() styleConfig {
 		HistoryColor:  "141", // Purple for history header
 		TrashYesColor: "46",  // Green for "Yes"
 		TrashNoColor:  "196", // Red for "No"
+		CorruptColor:  "196", // Red for likely corrupt binaries
 		Cursor:        "❯ ",
+		Selected:      selectedMarker,
+		ColumnPadding: colWidthPadding,
 	}
 }
 
@@ -270,27 +399,31 @@ func (r DefaultRunner) RunProgram(m tea.Model, opts ...tea.ProgramOption) (*tea.
 func (m *model) Init() tea.Cmd {
 	m.sortChoices()
 
-	// Load history if in history mode
-	if m.mode == modeHistory {
-		cmd := m.loadHistory()
+	var cmds []tea.Cmd
 
-		// Start log polling if verbose mode is enabled
-		if m.config.Verbose {
-			return tea.Batch(
-				cmd,
-				m.pollLogChannel(),
-			)
-		}
+	// List the binaries in the background while the spinner runs.
+	if m.loading {
+		cmds = append(cmds, m.loadChoices(), spinnerTick())
+	}
 
-		return cmd
+	// Load history if in history mode
+	if m.mode == modeHistory {
+		cmds = append(cmds, m.loadHistory())
 	}
 
 	// Start log polling if verbose mode is enabled.
 	if m.config.Verbose {
-		return m.pollLogChannel()
+		cmds = append(cmds, m.pollLogChannel())
 	}
 
-	return nil
+	switch len(cmds) {
+	case 0:
+		return nil
+	case 1:
+		return cmds[0]
+	default:
+		return tea.Batch(cmds...)
+	}
 }
 
 // loadHistory returns a command that loads deletion history.
@@ -341,13 +474,36 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m.handleConfirmation(msg)
 		}
 
+		// The action menu is modal and takes every key until it closes.
+		if m.menu != nil {
+			return m.updateActionMenu(msg)
+		}
+
 		// Handle mode-specific key bindings
 		if m.mode == modeHistory {
 			return m.updateHistoryMode(msg)
 		}
 
+		// Only quitting makes sense until the binaries are listed.
+		if m.loading {
+			if msg.String() == "ctrl+c" || msg.String() == "q" {
+				return m, tea.Quit
+			}
+
+			return m, nil
+		}
+
 		return m.updateBinaryMode(msg)
 
+	case choicesLoadedMsg:
+		return m.handleChoicesLoaded(msg)
+
+	case reinstalledMsg:
+		return m.handleReinstalled(msg)
+
+	case spinnerTickMsg:
+		return m.handleSpinnerTick()
+
 	case tea.WindowSizeMsg:
 		// Update dimensions and recalculate grid layout on resize.
 		m.width = msg.Width
@@ -424,6 +580,19 @@ func (m *model) executeConfirmation() (tea.Model, tea.Cmd) {
 			}
 		}
 
+	case confirmQuit:
+		m.confirmation = confirmNone
+
+		return m, tea.Quit
+
+	case confirmBundle:
+		// handleRemove skips the bundle prompt while this confirmation is pending.
+		model, cmd := m.handleRemove()
+		m.confirmation = confirmNone
+		m.menu = nil
+
+		return model, cmd
+
 	case confirmDeletePerm:
 		if m.historyManager != nil && m.historyCursor < len(m.historyEntries) {
 			entry := m.historyEntries[m.historyCursor]
@@ -455,7 +624,7 @@ func (m *model) updateHistoryMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "b":
 		// Back to binary mode
 		m.mode = modeBinaries
-		m.choices = m.fs.ListBinaries(m.dir)
+		m.choices = m.listBinaries()
 		m.sortChoices()
 		m.updateGrid()
 		m.status = ""
@@ -508,10 +677,28 @@ func (m *model) updateHistoryMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // updateBinaryMode processes key events in binary selection mode.
 func (m *model) updateBinaryMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.filtering {
+		return m.updateFilterInput(msg)
+	}
+
+	if m.reinstallFor != "" {
+		return m.updateVersionInput(msg)
+	}
+
 	switch msg.String() {
-	case "ctrl+c", "q":
+	case "ctrl+c":
 		return m, tea.Quit // Exit the TUI
 
+	case "q":
+		// Ask before discarding a pending selection; otherwise exit immediately.
+		if len(m.selected) > 0 {
+			m.confirmation = confirmQuit
+
+			return m, nil
+		}
+
+		return m, tea.Quit
+
 	case "up", "k":
 		// Move cursor up, stopping at the top row.
 		if m.cursorY > 0 {
@@ -522,7 +709,7 @@ func (m *model) updateBinaryMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Move cursor down, respecting grid bounds and item count.
 		newY := m.cursorY + 1
 
-		newIdx := newY + m.cursorX*m.rows // Column-major index (fill down columns)
+		newIdx := m.cellIndex(newY, m.cursorX)
 		if newY < m.rows && newIdx < len(m.choices) {
 			m.cursorY = newY
 		}
@@ -537,7 +724,7 @@ func (m *model) updateBinaryMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Move cursor right, respecting column bounds and item count.
 		newX := m.cursorX + 1
 
-		newIdx := m.cursorY + newX*m.rows // Column-major index
+		newIdx := m.cellIndex(m.cursorY, newX)
 		if newX < m.cols && newIdx < len(m.choices) {
 			m.cursorX = newX
 		}
@@ -554,6 +741,21 @@ func (m *model) updateBinaryMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 		return m, cmd
 
+	case "H":
+		// Toggle the panel listing binaries removed this session.
+		m.showRemoved = !m.showRemoved
+		m.updateGrid()
+
+	case "?":
+		// Toggle the line explaining what each grid marker means.
+		m.showKey = !m.showKey
+		m.updateGrid()
+
+	case "c":
+		// Switch between the grid and a single-column list.
+		m.singleColumn = !m.singleColumn
+		m.updateGrid()
+
 	case "r":
 		// Switch to history view
 		m.mode = modeHistory
@@ -569,60 +771,384 @@ func (m *model) updateBinaryMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Undo most recent deletion
 		return m.handleUndo()
 
+	case ".":
+		// Toggle visibility of hidden (dot-prefixed) binaries.
+		m.showHidden = !m.showHidden
+		m.choices = m.listBinaries()
+		m.sortChoices()
+		m.updateGrid()
+
+		if m.showHidden {
+			m.status = "Showing hidden files"
+		} else {
+			m.status = "Hiding hidden files"
+		}
+
+	case "/":
+		// Start typing a filter; the grid narrows as characters are entered.
+		m.filtering = true
+
+	case "esc":
+		// Clear an applied filter.
+		if m.filter != "" {
+			m.setFilter("")
+		}
+
+	case "space":
+		// Toggle the binary under the cursor in the selection.
+		m.toggleSelection()
+
+	case "a":
+		// Mark every binary the filter leaves shown.
+		m.selectAll()
+
+	case "A":
+		// Clear the selection.
+		clear(m.selected)
+
 	case "enter":
-		// Remove the selected binary and update the TUI state.
-		if len(m.choices) > 0 {
-			idx := m.cursorY + m.cursorX*m.rows // Column-major index
-			if idx < len(m.choices) {
-				binaryPath := m.fs.AdjustBinaryPath(m.dir, m.choices[idx])
-				name := m.choices[idx]
-
-				// Use history manager if available (it handles trash + history)
-				if m.historyManager != nil {
-					ctx := context.Background()
-					if _, err := m.historyManager.RecordDeletion(ctx, binaryPath); err != nil {
-						m.status = fmt.Sprintf("Error recording %s: %v", name, err)
-
-						return m, nil
-					}
-				} else {
-					// Fallback: permanent delete only if no history manager
-					if err := m.fs.RemoveBinary(
-						binaryPath,
-						name,
-						m.config.Verbose,
-						m.logger,
-					); err != nil {
-						m.status = fmt.Sprintf("Error removing %s: %v", name, err)
-
-						return m, nil
-					}
-				}
+		// Remove the selected binaries, or the one under the cursor if none are selected.
+		return m.handleRemove()
 
-				m.status = "Removed " + name
-				m.choices = m.fs.ListBinaries(m.dir)
-				m.sortChoices()
+	case "m":
+		// Open the action menu for the binary under the cursor.
+		m.openActionMenu()
 
-				// Exit if no binaries remain.
-				if len(m.choices) == 0 {
-					return m, tea.Quit
-				}
+	case "R":
+		// Prompt for a version, then remove the binary and go install it at that version.
+		m.startReinstall()
+	}
+
+	return m, nil
+}
+
+// updateFilterInput processes key events while the filter is being typed.
+func (m *model) updateFilterInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc":
+		// Abandon the filter entirely.
+		m.filtering = false
+		m.setFilter("")
 
-				// Adjust cursor if it exceeds remaining choices.
-				if m.cursorY+m.cursorX*m.rows >= len(m.choices) {
-					lastIdx := len(m.choices) - 1
-					m.cursorX = lastIdx / m.rows
-					m.cursorY = lastIdx % m.rows
+	case "backspace":
+		if runes := []rune(m.filter); len(runes) > 0 {
+			m.setFilter(string(runes[:len(runes)-1]))
+		}
+
+	case "enter":
+		m.filtering = false
+
+		// A filter that narrows the grid to a single binary accepts it, like
+		// autocomplete; otherwise Enter just applies the filter.
+		if len(m.choices) == 1 {
+			return m.handleRemove()
+		}
+
+	default:
+		if text := msg.Key().Text; text != "" {
+			m.setFilter(m.filter + text)
+		}
+	}
+
+	return m, nil
+}
+
+// setFilter applies a new filter, re-listing the directory and resetting the cursor.
+func (m *model) setFilter(filter string) {
+	m.filter = filter
+	m.choices = m.listBinaries()
+	m.sortChoices()
+	m.cursorX, m.cursorY = 0, 0
+	m.updateGrid()
+}
+
+// listBinaries lists the binaries in the model's directory, honoring the
+// hidden-file toggle and the active filter. Binaries a dry run reported as
+// removed are left out, as a real run would have deleted them. With
+// Config.CheckCorrupt, newly listed binaries are checked for corruption.
+func (m *model) listBinaries() []string {
+	names := slices.DeleteFunc(m.fs.ListBinaries(m.dir, m.listOptions()), func(name string) bool {
+		return m.dryRun[name]
+	})
+
+	if m.config.CheckCorrupt {
+		maps.Copy(m.corrupt, checkCorrupt(m.fs, m.dir, names, m.corrupt))
+	}
+
+	if m.filter == "" {
+		return names
+	}
+
+	filter := strings.ToLower(m.filter)
+
+	return slices.DeleteFunc(names, func(name string) bool {
+		return !strings.Contains(strings.ToLower(name), filter)
+	})
+}
+
+// listOptions returns the listing rules for the model's directory.
+func (m *model) listOptions() fs.ListOptions {
+	return fs.ListOptions{
+		ShowHidden:           m.showHidden,
+		IncludeBundles:       m.config.IncludeBundles,
+		IncludeNonExecutable: m.config.IncludeNonExecutable,
+		Match:                m.config.Match,
+		MinSize:              m.config.MinSize,
+		MaxSize:              m.config.MaxSize,
+		OlderThan:            m.config.OlderThan,
+		NewerThan:            m.config.NewerThan,
+	}
+}
+
+// currentChoice returns the binary under the cursor, or false if the cursor is out of range.
+func (m *model) currentChoice() (string, bool) {
+	idx := m.cellIndex(m.cursorY, m.cursorX)
+	if idx < 0 || idx >= len(m.choices) {
+		return "", false
+	}
+
+	return m.choices[idx], true
+}
+
+// toggleSelection marks or unmarks the binary under the cursor for removal.
+func (m *model) toggleSelection() {
+	name, ok := m.currentChoice()
+	if !ok {
+		return
+	}
+
+	if m.selected == nil {
+		m.selected = make(map[string]bool)
+	}
+
+	if m.selected[name] {
+		delete(m.selected, name)
+	} else {
+		m.selected[name] = true
+	}
+}
+
+// selectAll marks every binary currently shown. Binaries the filter hides
+// keep whatever mark they had.
+func (m *model) selectAll() {
+	if m.selected == nil {
+		m.selected = make(map[string]bool, len(m.choices))
+	}
+
+	for _, name := range m.choices {
+		m.selected[name] = true
+	}
+}
+
+// visibleSelected returns how many of the binaries currently shown are marked.
+func (m *model) visibleSelected() int {
+	count := 0
+
+	for _, name := range m.choices {
+		if m.selected[name] {
+			count++
+		}
+	}
+
+	return count
+}
+
+// selectionCount describes the selection for the footer, e.g. "3 selected",
+// or "2 of 5 selected" when the filter hides some marked binaries.
+func (m *model) selectionCount() string {
+	if visible := m.visibleSelected(); visible != len(m.selected) {
+		return fmt.Sprintf("%d of %d selected", visible, len(m.selected))
+	}
+
+	return fmt.Sprintf("%d selected", len(m.selected))
+}
+
+// removalTargets returns the binaries to remove: the action menu's binary while
+// the menu is open, else the sorted selection of binaries currently shown when
+// there is one, otherwise the binary under the cursor. Marked binaries the
+// filter hides are never removed.
+func (m *model) removalTargets() []string {
+	// The action menu acts on its own binary regardless of the selection.
+	if m.menu != nil {
+		return []string{m.menu.binary}
+	}
+
+	var targets []string
+
+	for _, name := range m.choices {
+		if m.selected[name] {
+			targets = append(targets, name)
+		}
+	}
+
+	if len(targets) > 0 {
+		sort.Strings(targets)
+
+		return targets
+	}
+
+	if name, ok := m.currentChoice(); ok {
+		return []string{name}
+	}
+
+	return nil
+}
+
+// handleRemove removes the target binaries and updates the TUI state.
+// Removal stops at the first failure; binaries removed before it stay removed.
+// In dry-run mode nothing is deleted; the targets are recorded and hidden from every listing.
+func (m *model) handleRemove() (tea.Model, tea.Cmd) {
+	targets := m.removalTargets()
+
+	// App bundles are deleted recursively, so ask before removing any.
+	if !m.config.DryRun && m.confirmation != confirmBundle &&
+		slices.ContainsFunc(targets, fs.IsBundle) {
+		m.confirmation = confirmBundle
+
+		return m, nil
+	}
+
+	removed := make([]string, 0, len(targets))
+
+	// Binaries deleted from another terminal since the grid was listed.
+	var gone []string
+
+	for _, name := range targets {
+		// The allowlist is checked before the dry-run branch so a dry run
+		// previews exactly what a real run would refuse.
+		binaryPath := m.fs.AdjustBinaryPath(m.dir, name)
+
+		if err := checkAllowed(m.config, name); err != nil {
+			m.auditChoice(name, binaryPath, err)
+			m.status = m.config.Symbols.failed("Error " + err.Error())
+
+			break
+		}
+
+		checksum := removalChecksum(m.fs, m.logger, m.config, binaryPath)
+		size := removalSize(m.fs, binaryPath)
+
+		if !m.config.DryRun {
+			err := m.removeChoice(name, binaryPath)
+			m.auditChoice(name, binaryPath, err)
+
+			if err != nil {
+				if isAlreadyRemoved(err) {
+					delete(m.selected, name)
+
+					gone = append(gone, name)
+
+					continue
 				}
 
-				m.updateGrid()
+				m.status = m.config.Symbols.failed("Error " + err.Error())
+
+				break
 			}
+		} else {
+			m.auditChoice(name, binaryPath, nil)
+		}
+
+		delete(m.selected, name)
+
+		removed = append(removed, name)
+		m.removals = append(m.removals, newRemoval(name, binaryPath, size, checksum, m.config.DryRun))
+	}
+
+	if len(removed) == 0 && len(gone) == 0 {
+		return m, nil
+	}
+
+	verb := "Removed"
+	if m.config.DryRun {
+		verb = "Would remove"
+	}
+
+	if len(removed)+len(gone) == len(targets) {
+		var parts []string
+
+		switch {
+		case len(removed) == 1:
+			parts = append(parts, verb+" "+removed[0])
+		case len(removed) > 1:
+			parts = append(parts, fmt.Sprintf("%s %d binaries", verb, len(removed)))
+		}
+
+		switch {
+		case len(gone) == 1:
+			parts = append(parts, gone[0]+" was already removed")
+		case len(gone) > 1:
+			parts = append(parts, fmt.Sprintf("%d binaries were already removed", len(gone)))
+		}
+
+		m.status = m.config.Symbols.succeeded(strings.Join(parts, "; "))
+	}
+
+	// A dry run deletes nothing, so its removals are hidden from the listing instead.
+	if m.config.DryRun {
+		if m.dryRun == nil {
+			m.dryRun = make(map[string]bool, len(removed))
+		}
+
+		for _, name := range removed {
+			m.dryRun[name] = true
 		}
 	}
 
+	m.choices = m.listBinaries()
+	m.sortChoices()
+
+	// Drop a filter whose matches were all removed rather than exiting.
+	if len(m.choices) == 0 && m.filter != "" {
+		m.setFilter("")
+	}
+
+	// Exit if no binaries remain.
+	if len(m.choices) == 0 {
+		return m, tea.Quit
+	}
+
+	// Adjust cursor if it exceeds remaining choices.
+	if m.cellIndex(m.cursorY, m.cursorX) >= len(m.choices) {
+		m.cursorY, m.cursorX = m.cellPosition(len(m.choices) - 1)
+	}
+
+	m.updateGrid()
+
 	return m, nil
 }
 
+// isAlreadyRemoved reports whether a removal failed only because the binary
+// no longer exists, for example after it was deleted from another terminal.
+func isAlreadyRemoved(err error) bool {
+	return errors.Is(err, fs.ErrBinaryNotFound) || errors.Is(err, buildinfo.ErrPathNotFound)
+}
+
+// removeChoice removes a single binary, moving it to trash when a history manager is available.
+// App bundles are always removed directly since history tracks single files.
+// Callers check the allowlist first, so dry runs refuse the same binaries.
+func (m *model) removeChoice(name, binaryPath string) error {
+	// Use history manager if available (it handles trash + history)
+	if m.historyManager != nil && !fs.IsBundle(binaryPath) {
+		ctx := context.Background()
+		if _, err := m.historyManager.RecordDeletion(ctx, binaryPath); err != nil {
+			return fmt.Errorf("recording %s: %w", name, err)
+		}
+
+		return nil
+	}
+
+	// Fallback: permanent delete only if no history manager
+	if err := m.fs.RemoveBinary(binaryPath, name, m.config.Verbose, m.logger); err != nil {
+		return fmt.Errorf("removing %s: %w", name, err)
+	}
+
+	return nil
+}
+
 // handleRestore handles restoring the selected history entry.
 func (m *model) handleRestore() (tea.Model, tea.Cmd) {
 	if m.historyManager == nil || m.historyCursor >= len(m.historyEntries) {
@@ -657,7 +1183,7 @@ func (m *model) handleRestore() (tea.Model, tea.Cmd) {
 	} else {
 		m.status = fmt.Sprintf("Restored %s to %s", result.BinaryName, result.RestoredTo)
 		// Refresh the binary list to include the restored binary
-		m.choices = m.fs.ListBinaries(m.dir)
+		m.choices = m.listBinaries()
 		m.sortChoices()
 		m.updateGrid()
 		// Refresh history to update trash status
@@ -697,7 +1223,7 @@ func (m *model) handleUndo() (tea.Model, tea.Cmd) {
 		m.status = fmt.Sprintf("Restored %s to %s", result.BinaryName, result.RestoredTo)
 		// Refresh history and binaries if in binary mode
 		if m.mode == modeBinaries {
-			m.choices = m.fs.ListBinaries(m.dir)
+			m.choices = m.listBinaries()
 			m.sortChoices()
 			m.updateGrid()
 		}
@@ -766,6 +1292,172 @@ func (m *model) getVisibleLogs() []string {
 	return m.logs[start:]
 }
 
+// getVisibleRemovals returns the most recent session removals that fit in the
+// removed panel; older removals scroll off the top as new ones are added.
+func (m *model) getVisibleRemovals() []Removal {
+	if !m.showRemoved {
+		return nil
+	}
+
+	start := max(len(m.removals)-maxVisibleRemovedLines, 0)
+
+	return m.removals[start:]
+}
+
+// removedPanelHeight returns the number of lines the removed panel occupies,
+// including its header and trailing separator, or zero when it is hidden.
+func (m *model) removedPanelHeight() int {
+	if !m.showRemoved {
+		return 0
+	}
+
+	// An empty panel shows a placeholder line.
+	return maximum(len(m.getVisibleRemovals()), 1) + logPanelSeparatorLines
+}
+
+// activeModes lists the settings that change what the grid shows or what
+// removing does, in a fixed order for the legend.
+func (m *model) activeModes() []string {
+	var modes []string
+
+	if m.config.DryRun {
+		modes = append(modes, "dry run")
+	}
+
+	if m.config.Goroot {
+		modes = append(modes, "GOROOT")
+	}
+
+	if m.config.GoVersion != "" {
+		modes = append(modes, "go"+strings.TrimPrefix(m.config.GoVersion, "go"))
+	}
+
+	if m.config.DirFromGoEnv {
+		modes = append(modes, "go env")
+	}
+
+	if m.showHidden {
+		modes = append(modes, "hidden files")
+	}
+
+	if m.config.IncludeBundles {
+		modes = append(modes, "app bundles")
+	}
+
+	if m.config.IncludeNonExecutable {
+		modes = append(modes, "non-executable files")
+	}
+
+	if m.config.CheckCorrupt {
+		modes = append(modes, "corrupt check")
+	}
+
+	if m.config.Match != nil {
+		modes = append(modes, "regex: "+m.config.Match.String())
+	}
+
+	if m.config.MinSize > 0 {
+		modes = append(modes, "size ≥ "+format.Bytes(m.config.MinSize))
+	}
+
+	if m.config.MaxSize > 0 {
+		modes = append(modes, "size ≤ "+format.Bytes(m.config.MaxSize))
+	}
+
+	if m.config.OlderThan > 0 {
+		modes = append(modes, "older than "+strings.TrimSuffix(format.Ago(m.config.OlderThan), " ago"))
+	}
+
+	if m.config.NewerThan > 0 {
+		modes = append(modes, "newer than "+strings.TrimSuffix(format.Ago(m.config.NewerThan), " ago"))
+	}
+
+	if m.filter != "" {
+		modes = append(modes, "filter: "+m.filter)
+	}
+
+	return modes
+}
+
+// legend renders the active modes as a single line, or "" when none are active.
+func (m *model) legend() string {
+	modes := m.activeModes()
+	if len(modes) == 0 {
+		return ""
+	}
+
+	return "Active: " + strings.Join(modes, " · ")
+}
+
+// markerKey renders a line explaining each grid marker, drawing each marker
+// in its own style and the explanations in textStyle.
+func (m *model) markerKey(cursorStyle, selectedStyle, textStyle lipgloss.Style) string {
+	key := textStyle.Render("Key: ") +
+		cursorStyle.Render(strings.TrimSpace(m.styles.Cursor)) + textStyle.Render(" cursor · ") +
+		selectedStyle.Render(strings.TrimSpace(m.styles.Selected)) + textStyle.Render(" marked for removal")
+
+	if m.config.CheckCorrupt {
+		corruptStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.CorruptColor))
+		key += textStyle.Render(" · ") + corruptStyle.Render("name") + textStyle.Render(" likely corrupt")
+	}
+
+	return key
+}
+
+// legendHeight returns the number of lines the legend and marker key occupy.
+func (m *model) legendHeight() int {
+	height := 0
+	if len(m.activeModes()) > 0 {
+		height++
+	}
+
+	if m.showKey {
+		height++
+	}
+
+	return height
+}
+
+// renderRemovedPanel renders the binaries removed this session with their sizes.
+func (m *model) renderRemovedPanel(style lipgloss.Style) string {
+	visible := m.getVisibleRemovals()
+
+	header := "─ Removed This Session ─"
+	if m.config.DryRun {
+		header = "─ Would Remove This Session ─"
+	}
+
+	if len(visible) < len(m.removals) {
+		header += fmt.Sprintf(" (last %d of %d)", len(visible), len(m.removals))
+	}
+
+	var s strings.Builder
+
+	s.WriteString(style.Render(header))
+	s.WriteString("\n")
+
+	if len(visible) == 0 {
+		s.WriteString(style.Render("Nothing removed yet"))
+		s.WriteString("\n\n")
+
+		return s.String()
+	}
+
+	nameWidth := 0
+	for _, removal := range visible {
+		nameWidth = maximum(nameWidth, lipgloss.Width(removal.Name))
+	}
+
+	for _, removal := range visible {
+		s.WriteString(style.Render(padRight(removal.Name, nameWidth) + "  " + format.Bytes(removal.Size)))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+
+	return s.String()
+}
+
 // sortChoices sorts the choices based on the current sort order.
 func (m *model) sortChoices() {
 	if len(m.choices) == 0 {
@@ -779,18 +1471,62 @@ func (m *model) sortChoices() {
 	}
 }
 
-// updateGrid recalculates the grid layout based on current state and terminal size.
-func (m *model) updateGrid() {
-	// Determine the maximum length of binary names for column sizing.
+// sortIndicator describes the current sort order for the footer.
+func (m *model) sortIndicator() string {
+	if m.sortAscending {
+		return "sort: A→Z"
+	}
+
+	return "sort: Z→A"
+}
+
+// prefixWidth returns the display width reserved before each name for the
+// cursor or selection marker, so a wider custom cursor keeps columns aligned.
+func (m *model) prefixWidth() int {
+	return maximum(
+		maximum(lipgloss.Width(m.styles.Cursor), lipgloss.Width(m.styles.Selected)),
+		visibleLenPrefix,
+	)
+}
+
+// columnPadding returns the configured spacing between grid columns.
+func (m *model) columnPadding() int {
+	if m.styles.ColumnPadding <= 0 {
+		return colWidthPadding
+	}
+
+	return m.styles.ColumnPadding
+}
+
+// nameWidth returns the display width reserved for binary names: the longest
+// name, capped so a single column still fits the terminal. Names wider than
+// this are truncated when rendered instead of wrapping onto the next line.
+func (m *model) nameWidth() int {
 	maxNameLen := 0
 	for _, choice := range m.choices {
-		if len(choice) > maxNameLen {
-			maxNameLen = len(choice)
-		}
+		maxNameLen = maximum(maxNameLen, lipgloss.Width(choice))
 	}
 
+	// Before the first WindowSizeMsg the width is unknown, so nothing is capped.
+	if m.width <= 0 {
+		return maxNameLen
+	}
+
+	fitWidth := m.width - availWidthAdjustment - m.prefixWidth() - m.columnPadding()
+
+	return minimum(maxNameLen, maximum(fitWidth, 1))
+}
+
+// columnWidth returns the display width of a grid column: the prefix, the
+// longest name as capped by nameWidth, and the configured padding.
+func (m *model) columnWidth() int {
+	return m.prefixWidth() + m.nameWidth() + m.columnPadding()
+}
+
+// updateGrid recalculates the grid layout based on current state and terminal size.
+func (m *model) updateGrid() {
 	// Calculate column width and available space for the grid.
-	colWidth := maxNameLen + colWidthPadding
+	colWidth := m.columnWidth()
 	availWidth := m.width - availWidthAdjustment
 
 	// Account for status line when calculating available height.
@@ -816,6 +1552,8 @@ func (m *model) updateGrid() {
 		availHeight = maximum(availHeight-logPanelHeight, 1)
 	}
 
+	availHeight = maximum(availHeight-m.removedPanelHeight()-m.legendHeight(), 1)
+
 	// Clear grid if no choices remain.
 	if len(m.choices) == 0 {
 		m.rows = 0
@@ -828,13 +1566,22 @@ func (m *model) updateGrid() {
 
 	// Compute grid dimensions: maximize rows, limit columns by width.
 	maxCols := maximum(availWidth/colWidth, 1)
-
-	m.rows = minimum(availHeight, len(m.choices))
-	if m.rows == 0 {
-		m.rows = 1 // Ensure at least one row
+	if m.singleColumn {
+		maxCols = 1
 	}
 
-	m.cols = minimum(maxCols, (len(m.choices)+m.rows-1)/m.rows)
+	if m.rowMajor() {
+		// Fill each row to the width first, then add rows as needed.
+		m.cols = minimum(maxCols, len(m.choices))
+		m.rows = minimum(availHeight, (len(m.choices)+m.cols-1)/m.cols)
+	} else {
+		m.rows = minimum(availHeight, len(m.choices))
+		if m.rows == 0 {
+			m.rows = 1 // Ensure at least one row
+		}
+
+		m.cols = minimum(maxCols, (len(m.choices)+m.rows-1)/m.rows)
+	}
 
 	// Clamp cursor position to valid bounds after resizing.
 	if m.cursorX >= m.cols {
@@ -845,68 +1592,108 @@ func (m *model) updateGrid() {
 		m.cursorY = m.rows - 1
 	}
 
-	currentIdx := m.cursorY + m.cursorX*m.rows
-	if currentIdx >= len(m.choices) {
-		lastIdx := len(m.choices) - 1
-		m.cursorX = lastIdx / m.rows
-		m.cursorY = lastIdx % m.rows
+	if m.cellIndex(m.cursorY, m.cursorX) >= len(m.choices) {
+		m.cursorY, m.cursorX = m.cellPosition(len(m.choices) - 1)
 	}
 }
 
+// rowMajor reports whether the grid fills across rows instead of down columns.
+// A single column reads the same either way.
+func (m *model) rowMajor() bool {
+	return m.config.GridOrder == GridOrderRow && !m.singleColumn
+}
+
+// cellIndex returns the index into choices of the grid cell at row and col.
+// The result may be past the end of choices for cells left empty.
+func (m *model) cellIndex(row, col int) int {
+	if m.rowMajor() {
+		return row*m.cols + col
+	}
+
+	return row + col*m.rows
+}
+
+// cellPosition returns the row and column of the grid cell holding choices[idx].
+func (m *model) cellPosition(idx int) (int, int) {
+	if m.rowMajor() {
+		return idx / m.cols, idx % m.cols
+	}
+
+	return idx % m.rows, idx / m.rows
+}
+
 // View renders the TUI interface as a tea.View.
 func (m *model) View() tea.View {
 	if m.mode == modeHistory {
 		return m.viewHistory()
 	}
 
+	if m.loading {
+		return m.viewLoading()
+	}
+
+	// A pending confirmation from the menu is shown over the grid.
+	if m.menu != nil && m.confirmation == confirmNone {
+		return m.viewActionMenu()
+	}
+
 	return m.viewBinaries()
 }
 
 // viewBinaries renders the binary selection view.
 func (m *model) viewBinaries() tea.View {
-	if len(m.choices) == 0 {
-		view := tea.NewView("No binaries found.\n")
-		view.AltScreen = true
+	if len(m.choices) == 0 && m.filter != "" {
+		return m.newView(fmt.Sprintf("No binaries match %q.\nFilter: %s_\n", m.filter, m.filter))
+	}
 
-		return view
+	if len(m.choices) == 0 {
+		return m.newView("No binaries found.\n")
 	}
 
 	// Apply configured styles for UI elements.
 	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(m.styles.TitleColor))
 	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.CursorColor))
 	footerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.FooterColor))
-	statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.StatusColor))
 	logStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.LogColor))
+	selectedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.StatusColor))
+	corruptStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.CorruptColor))
 
 	// Calculate column width based on the longest binary name.
-	var maxNameLen int
-	for _, choice := range m.choices {
-		if len(choice) > maxNameLen {
-			maxNameLen = len(choice)
-		}
-	}
-
-	colWidth := maxNameLen + colWidthPadding
+	colWidth := m.columnWidth()
+	prefixWidth := m.prefixWidth()
+	nameWidth := m.nameWidth()
 
 	// Build the grid of binary choices with cursor highlighting.
 	var grid strings.Builder
 
 	for row := range m.rows {
 		for col := range m.cols {
-			idx := row + col*m.rows // Column-major index (fill down columns)
+			idx := m.cellIndex(row, col)
 			if idx >= len(m.choices) {
 				break
 			}
 
-			prefix := "  "
-			if row == m.cursorY && col == m.cursorX {
-				prefix = cursorStyle.Render(m.styles.Cursor)
+			item := m.choices[idx]
+
+			// Pad every prefix to the same display width so columns line up.
+			prefix := strings.Repeat(" ", prefixWidth)
+
+			switch {
+			case row == m.cursorY && col == m.cursorX:
+				prefix = cursorStyle.Render(padRight(m.styles.Cursor, prefixWidth))
+			case m.selected[item]:
+				prefix = selectedStyle.Render(padRight(m.styles.Selected, prefixWidth))
 			}
 
-			item := m.choices[idx]
-			visibleLen := visibleLenPrefix + len([]rune(item))
+			// Shorten names that would otherwise overflow the terminal.
+			name := truncateWidth(item, nameWidth)
+			visibleLen := prefixWidth + lipgloss.Width(name)
 			padding := maximum(colWidth-visibleLen, 0)
-			cell := prefix + item + strings.Repeat(" ", padding)
+
+			if m.corrupt[item] != "" {
+				name = corruptStyle.Render(name)
+			}
+			cell := prefix + name + strings.Repeat(" ", padding)
 			grid.WriteString(cell)
 		}
 
@@ -916,11 +1703,29 @@ func (m *model) viewBinaries() tea.View {
 	// Assemble the full TUI layout: title, grid, logs (if visible), status, and footer.
 	var s strings.Builder
 
-	s.WriteString(titleStyle.Render("Select a binary to remove:\n"))
+	s.WriteString(titleStyle.Render("Select a binary to remove:"))
+	s.WriteString("\n")
+
+	// Summarize active modes so a dry run or an unusual target is hard to miss.
+	if legend := m.legend(); legend != "" {
+		s.WriteString(footerStyle.Render(legend))
+		s.WriteString("\n")
+	}
+
+	if m.showKey {
+		s.WriteString(m.markerKey(cursorStyle, selectedStyle, footerStyle))
+		s.WriteString("\n")
+	}
+
 	s.WriteString("\n")
 	s.WriteString(grid.String())
 	s.WriteString("\n")
 
+	// Render the removed panel if enabled
+	if m.showRemoved {
+		s.WriteString(m.renderRemovedPanel(selectedStyle))
+	}
+
 	// Render log panel if enabled
 	if m.showLogs {
 		visibleLogs := m.getVisibleLogs()
@@ -941,17 +1746,59 @@ func (m *model) viewBinaries() tea.View {
 		s.WriteString("\n")
 	}
 
-	if m.status != "" {
-		s.WriteString(statusStyle.Render(m.status))
+	// Show quit confirmation if active
+	switch {
+	case m.confirmation == confirmQuit:
+		s.WriteString(m.renderStatus(
+			fmt.Sprintf("Quit without removing %d selected? [y/N]", len(m.selected)),
+		))
+		s.WriteString("\n")
+	case m.confirmation == confirmBundle:
+		bundles := slices.DeleteFunc(m.removalTargets(), func(name string) bool {
+			return !fs.IsBundle(name)
+		})
+
+		s.WriteString(m.renderStatus(fmt.Sprintf(
+			"Remove app bundle %s and all of its contents? [y/N]",
+			strings.Join(bundles, ", "),
+		)))
+		s.WriteString("\n")
+	case m.filtering:
+		s.WriteString(m.renderStatus("Filter: " + m.filter + "_"))
+		s.WriteString("\n")
+	case m.reinstallFor != "":
+		prompt := fmt.Sprintf("Reinstall %s at version: %s_", m.reinstallFor, m.reinstallVersion)
+		if m.status != "" {
+			prompt += "  (" + m.status + ")"
+		}
+
+		s.WriteString(m.renderStatus(prompt))
+		s.WriteString("\n")
+	case m.status != "":
+		s.WriteString(m.renderStatus(m.status))
 		s.WriteString("\n")
 	}
 
 	// Update footer to include new key bindings
-	footerText := "↑/k: up  ↓/j: down  ←/h: left  →/l: right  Enter: remove  s: sort  r: history  u: undo  L: logs  q: quit"
+	footerText := "↑↓←→/hjkl: move  Space: select  a: all  A: none  Enter: remove  m: actions  s: sort  /: filter  r: history  u: undo  H: removed  L: logs  c: layout  ?: key  q: quit  " +
+		m.sortIndicator()
+	if len(m.selected) > 0 {
+		footerText += "  " + m.selectionCount()
+	}
+
+	switch {
+	case m.confirmation != confirmNone:
+		footerText = "y: confirm  n: cancel"
+	case m.filtering:
+		footerText = "type to filter  Enter: apply (removes a single match)  Esc: clear"
+	case m.reinstallFor != "":
+		footerText = "type a version such as v1.2.3  Enter: remove and reinstall  Esc: cancel"
+	}
+
 	footer := footerStyle.Render(footerText)
 
 	lenStatus := 0
-	if m.status != "" {
+	if m.status != "" || m.confirmation != confirmNone || m.filtering || m.reinstallFor != "" {
 		lenStatus = 1
 	}
 
@@ -969,12 +1816,9 @@ func (m *model) viewBinaries() tea.View {
 		}
 	}
 
-	totalHeight := m.rows + totalHeightBase + lenStatus + logPanelLines
+	totalHeight := m.rows + totalHeightBase + lenStatus + logPanelLines + m.removedPanelHeight() + m.legendHeight()
 
-	// Add padding lines to fill the terminal height.
-	for i := totalHeight; i < m.height; i++ {
-		s.WriteString("\n")
-	}
+	m.padToHeight(&s, totalHeight)
 
 	s.WriteString(footer)
 
@@ -983,10 +1827,7 @@ func (m *model) viewBinaries() tea.View {
 		Width(m.width - leftPadding).
 		Render(s.String())
 
-	view := tea.NewView(content)
-	view.AltScreen = true
-
-	return view
+	return m.newView(content)
 }
 
 // viewHistory renders the history view.
@@ -996,7 +1837,6 @@ func (m *model) viewHistory() tea.View {
 	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(m.styles.HistoryColor))
 	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.CursorColor))
 	footerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.FooterColor))
-	statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.StatusColor))
 	logStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.LogColor))
 	trashYesStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.TrashYesColor))
 	trashNoStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.TrashNoColor))
@@ -1143,19 +1983,19 @@ func (m *model) viewHistory() tea.View {
 	// Show confirmation dialog if active
 	switch m.confirmation {
 	case confirmClearAll:
-		s.WriteString(statusStyle.Render("Clear all history? This cannot be undone. (y/n)"))
+		s.WriteString(m.renderStatus("Clear all history? This cannot be undone. (y/n)"))
 		s.WriteString("\n")
 	case confirmDeletePerm:
 		if m.historyCursor < len(m.historyEntries) {
 			entry := m.historyEntries[m.historyCursor]
 			s.WriteString(
-				statusStyle.Render(fmt.Sprintf("Permanently delete %s? (y/n)", entry.BinaryName)),
+				m.renderStatus(fmt.Sprintf("Permanently delete %s? (y/n)", entry.BinaryName)),
 			)
 			s.WriteString("\n")
 		}
 	default:
 		if m.status != "" {
-			s.WriteString(statusStyle.Render(m.status))
+			s.WriteString(m.renderStatus(m.status))
 			s.WriteString("\n")
 		}
 	}
@@ -1207,10 +2047,7 @@ func (m *model) viewHistory() tea.View {
 
 	totalHeight := contentHeight + totalHeightBase + lenStatus + logPanelLines
 
-	// Add padding lines to fill the terminal height.
-	for i := totalHeight; i < m.height; i++ {
-		s.WriteString("\n")
-	}
+	m.padToHeight(&s, totalHeight)
 
 	s.WriteString(footer)
 
@@ -1219,12 +2056,31 @@ func (m *model) viewHistory() tea.View {
 		Width(m.width - leftPadding).
 		Render(s.String())
 
+	return m.newView(content)
+}
+
+// newView wraps content in a view on the alternate screen, or rendered inline
+// below the prompt when config.Inline is set so it stays in the scrollback.
+func (m *model) newView(content string) tea.View {
 	view := tea.NewView(content)
-	view.AltScreen = true
+	view.AltScreen = !m.config.Inline
 
 	return view
 }
 
+// padToHeight adds blank lines after used lines of content so the footer sits
+// at the bottom of the terminal. Inline views are left at their natural height
+// to avoid pushing the prompt's earlier output off screen.
+func (m *model) padToHeight(s *strings.Builder, used int) {
+	if m.config.Inline {
+		return
+	}
+
+	for i := used; i < m.height; i++ {
+		s.WriteString("\n")
+	}
+}
+
 // maximum returns the larger of two integers.
 func maximum(a, b int) int {
 	if a > b {
@@ -1242,3 +2098,34 @@ func minimum(a, b int) int {
 
 	return b
 }
+
+// renderStatus styles a status line, truncating it to the terminal width so a
+// long message never wraps and throws off the grid height calculations.
+func (m *model) renderStatus(status string) string {
+	statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.StatusColor))
+
+	// The frame is rendered m.width-leftPadding wide, including its left padding.
+	return statusStyle.Render(truncateWidth(status, m.width-2*leftPadding))
+}
+
+// truncateWidth shortens s to at most width display columns, marking the cut
+// with an ellipsis. A non-positive width leaves s unchanged.
+func truncateWidth(s string, width int) string {
+	if width <= 0 || lipgloss.Width(s) <= width {
+		return s
+	}
+
+	const ellipsis = "…"
+
+	runes := []rune(s)
+	for len(runes) > 0 && lipgloss.Width(string(runes))+lipgloss.Width(ellipsis) > width {
+		runes = runes[:len(runes)-1]
+	}
+
+	return string(runes) + ellipsis
+}
+
+// padRight pads s with spaces to the given display width.
+func padRight(s string, width int) string {
+	return s + strings.Repeat(" ", maximum(width-lipgloss.Width(s), 0))
+}
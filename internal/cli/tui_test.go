@@ -7,9 +7,13 @@ package cli
 
 import (
 	"errors"
+	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
 	"unicode/utf8"
@@ -17,13 +21,18 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
 
+	"github.com/nicholas-fedor/go-remove/internal/audit"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
 	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
 	"github.com/nicholas-fedor/go-remove/internal/history"
 	mockHistory "github.com/nicholas-fedor/go-remove/internal/history/mocks"
 	"github.com/nicholas-fedor/go-remove/internal/logger"
+	mockLogger "github.com/nicholas-fedor/go-remove/internal/logger/mocks"
 )
 
 // Constants for key press strings to avoid magic string repetition.
@@ -34,6 +43,7 @@ const (
 	keyLeft  = "left"
 	keyRight = "right"
 	keyCtrlC = "ctrl+c"
+	keySpace = "space"
 )
 
 // tuiMockLogger is a simple mock logger for TUI tests.
@@ -102,12 +112,9 @@ func TestRunTUI(t *testing.T) {
 				dir:    "/bin",
 				config: Config{},
 				logger: &tuiMockLogger{},
-				fs: func() *mockFS.MockFS {
-					m := mockFS.NewMockFS(t)
-					m.On("ListBinaries", "/bin").Return([]string{"vhs"})
-
-					return m
-				}(),
+				// The binaries are listed once the program starts, which
+				// the no-op runner never does.
+				fs:     mockFS.NewMockFS(t),
 				runner: &tuiMockRunner{runProgram: mockNoOpRunner},
 			},
 			wantErr: false,
@@ -120,11 +127,11 @@ func TestRunTUI(t *testing.T) {
 				logger: &tuiMockLogger{},
 				fs: func() *mockFS.MockFS {
 					m := mockFS.NewMockFS(t)
-					m.On("ListBinaries", "/bin").Return([]string{})
+					m.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{})
 
 					return m
 				}(),
-				runner: &tuiMockRunner{runProgram: mockNoOpRunner},
+				runner: &tuiMockRunner{runProgram: headlessRunner},
 			},
 			wantErr: true,
 		},
@@ -134,12 +141,7 @@ func TestRunTUI(t *testing.T) {
 				dir:    "/bin",
 				config: Config{},
 				logger: &tuiMockLogger{},
-				fs: func() *mockFS.MockFS {
-					m := mockFS.NewMockFS(t)
-					m.On("ListBinaries", "/bin").Return([]string{"vhs"})
-
-					return m
-				}(),
+				fs:     mockFS.NewMockFS(t),
 				runner: &tuiMockRunner{
 					runProgram: func(tea.Model, ...tea.ProgramOption) (*tea.Program, error) {
 						return nil, errors.New("runner failed")
@@ -152,13 +154,14 @@ func TestRunTUI(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := RunTUI(
+			_, err := RunTUI(
 				tt.args.dir,
 				tt.args.config,
 				tt.args.logger,
 				tt.args.fs,
 				tt.args.runner,
 				nil,
+				nil,
 			)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("RunTUI() error = %v, wantErr %v", err, tt.wantErr)
@@ -169,6 +172,51 @@ func TestRunTUI(t *testing.T) {
 	}
 }
 
+// TestRunTUI_ReleasesLogCaptureOnError verifies RunTUI hands logging back and
+// passes a cancellable context to the program even when it fails to start.
+func TestRunTUI_ReleasesLogCaptureOnError(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t) // The program never starts, so nothing is listed
+
+	logMock := mockLogger.NewMockLogger(t)
+	logMock.On("SetCaptureFunc", mock.MatchedBy(func(f logger.LogCaptureFunc) bool {
+		return f != nil
+	})).Return().Once()
+	logMock.On("SetCaptureFunc", mock.MatchedBy(func(f logger.LogCaptureFunc) bool {
+		return f == nil
+	})).Return().Once()
+
+	var gotOpts int
+
+	runner := &tuiMockRunner{
+		runProgram: func(_ tea.Model, opts ...tea.ProgramOption) (*tea.Program, error) {
+			gotOpts = len(opts)
+
+			return nil, errors.New("runner failed")
+		},
+	}
+
+	_, err := RunTUI("/bin", Config{}, logMock, fsMock, runner, nil, nil)
+	require.Error(t, err)
+	assert.Equal(t, 1, gotOpts)
+	logMock.AssertExpectations(t)
+}
+
+// TestRunTUI_Safe verifies --safe refuses to open the TUI on a directory
+// outside the Go roots, including one chosen in the directory picker.
+func TestRunTUI_Safe(t *testing.T) {
+	gopath := t.TempDir()
+	t.Setenv("GOPATH", gopath)
+	t.Setenv("GOBIN", "")
+
+	runner := &tuiMockRunner{runProgram: mockNoOpRunner}
+
+	_, err := RunTUI(t.TempDir(), Config{Safe: true}, &tuiMockLogger{}, mockFS.NewMockFS(t), runner, nil, nil)
+	require.ErrorIs(t, err, ErrOutsideGoRoots)
+
+	_, err = RunTUI(filepath.Join(gopath, "bin"), Config{Safe: true}, &tuiMockLogger{}, mockFS.NewMockFS(t), runner, nil, nil)
+	require.NoError(t, err)
+}
+
 // Test_model_Init verifies the Init method's command output.
 func Test_model_Init(t *testing.T) {
 	tests := []struct {
@@ -214,6 +262,8 @@ func keyPressString(s string) tea.KeyPressMsg {
 		return tea.KeyPressMsg{Code: tea.KeyLeft}
 	case keyRight:
 		return tea.KeyPressMsg{Code: tea.KeyRight}
+	case keySpace:
+		return tea.KeyPressMsg{Text: " ", Code: tea.KeySpace}
 	default:
 		if len(s) == 1 {
 			r := rune(s[0])
@@ -349,8 +399,9 @@ func Test_model_Update(t *testing.T) {
 				fs: func() *mockFS.MockFS {
 					m := mockFS.NewMockFS(t)
 					m.On("AdjustBinaryPath", "/bin", "age").Return("/bin/age")
+					m.On("BinarySize", "/bin/age").Return(int64(1500), nil)
 					m.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).Return(nil)
-					m.On("ListBinaries", "/bin").Return([]string{"vhs"})
+					m.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"vhs"})
 
 					return m
 				}(),
@@ -383,6 +434,7 @@ func Test_model_Update(t *testing.T) {
 				fs: func() *mockFS.MockFS {
 					m := mockFS.NewMockFS(t)
 					m.On("AdjustBinaryPath", "/bin", "age").Return("/bin/age")
+					m.On("BinarySize", "/bin/age").Return(int64(1500), nil)
 					m.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).
 						Return(errors.New("remove failed"))
 
@@ -437,7 +489,7 @@ func Test_model_Update(t *testing.T) {
 				gotModel.cols != tt.want.cols ||
 				gotModel.rows != tt.want.rows ||
 				gotModel.dir != tt.want.dir ||
-				gotModel.config != tt.want.config ||
+				!reflect.DeepEqual(gotModel.config, tt.want.config) ||
 				gotModel.width != tt.want.width ||
 				gotModel.height != tt.want.height ||
 				gotModel.status != tt.want.status ||
@@ -592,13 +644,15 @@ func Test_model_View(t *testing.T) {
 					lines = append(lines, leftPaddingStr+pad("", effectiveWidth))
 				}
 
-				footerPart1 := "↑/k: up  ↓/j: down  ←/h: left  →/l: right  Enter: remove  s: sort  r:"
-				footerPart2 := "history  u: undo  L: logs  q: quit"
+				footerPart1 := "↑↓←→/hjkl: move  Space: select  a: all  A: none  Enter: remove  m: actions"
+				footerPart2 := "s: sort  /: filter  r: history  u: undo  H: removed  L: logs  c: layout  ?:"
+				footerPart3 := "key  q: quit  sort: A→Z"
 
 				lines = append(
 					lines,
 					leftPaddingStr+pad(footerPart1, effectiveWidth),
 					leftPaddingStr+pad(footerPart2, effectiveWidth),
+					leftPaddingStr+pad(footerPart3, effectiveWidth),
 				)
 
 				return strings.Join(lines, "\n")
@@ -634,13 +688,15 @@ func Test_model_View(t *testing.T) {
 					lines = append(lines, leftPaddingStr+pad("", effectiveWidth))
 				}
 
-				footerPart1 := "↑/k: up  ↓/j: down  ←/h: left  →/l: right  Enter: remove  s: sort  r:"
-				footerPart2 := "history  u: undo  L: logs  q: quit"
+				footerPart1 := "↑↓←→/hjkl: move  Space: select  a: all  A: none  Enter: remove  m: actions"
+				footerPart2 := "s: sort  /: filter  r: history  u: undo  H: removed  L: logs  c: layout  ?:"
+				footerPart3 := "key  q: quit  sort: A→Z"
 
 				lines = append(
 					lines,
 					leftPaddingStr+pad(footerPart1, effectiveWidth),
 					leftPaddingStr+pad(footerPart2, effectiveWidth),
+					leftPaddingStr+pad(footerPart3, effectiveWidth),
 				)
 
 				return strings.Join(lines, "\n")
@@ -715,9 +771,10 @@ func Test_model_Update_EnterWithHistoryManager(t *testing.T) {
 
 	// Setup expectations
 	fsMock.On("AdjustBinaryPath", "/bin", "test").Return("/bin/test")
+	fsMock.On("BinarySize", "/bin/test").Return(int64(1500), nil)
 	historyMock.On("RecordDeletion", mock.Anything, "/bin/test").
 		Return(&history.HistoryEntry{ID: "123", BinaryName: "test"}, nil)
-	fsMock.On("ListBinaries", "/bin").Return([]string{"other"})
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"other"})
 
 	m := &model{
 		choices:        []string{"test"},
@@ -748,6 +805,8 @@ func Test_model_Update_HistoryRecordError(t *testing.T) {
 	historyMock := mockHistory.NewMockManager(t)
 
 	fsMock.On("AdjustBinaryPath", "/bin", "test").Return("/bin/test")
+
+	fsMock.On("BinarySize", "/bin/test").Return(int64(1500), nil)
 	historyMock.On("RecordDeletion", mock.Anything, "/bin/test").
 		Return(nil, errors.New("history storage full"))
 
@@ -781,9 +840,11 @@ func Test_model_Update_BinaryRemovedFromChoicesAfterHistoryRecord(t *testing.T)
 	historyMock := mockHistory.NewMockManager(t)
 
 	fsMock.On("AdjustBinaryPath", "/bin", "binary1").Return("/bin/binary1")
+
+	fsMock.On("BinarySize", "/bin/binary1").Return(int64(1500), nil)
 	historyMock.On("RecordDeletion", mock.Anything, "/bin/binary1").
 		Return(&history.HistoryEntry{ID: "entry1", BinaryName: "binary1"}, nil)
-	fsMock.On("ListBinaries", "/bin").Return([]string{"binary2", "binary3"})
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"binary2", "binary3"})
 
 	m := &model{
 		choices:        []string{"binary1", "binary2", "binary3"},
@@ -823,7 +884,7 @@ func Test_handleRestore_RefreshesBinaryList(t *testing.T) {
 
 	historyMock.On("Restore", mock.Anything, "entry1").
 		Return(&history.RestoreResult{BinaryName: "restored_binary", RestoredTo: "/bin/restored_binary"}, nil)
-	fsMock.On("ListBinaries", "/bin").Return([]string{"restored_binary", "existing"})
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"restored_binary", "existing"})
 
 	m := &model{
 		choices:        []string{"existing"},
@@ -861,7 +922,7 @@ func Test_handleRestore_BinaryAppearsInChoices(t *testing.T) {
 
 	historyMock.On("Restore", mock.Anything, "entry1").
 		Return(&history.RestoreResult{BinaryName: "newbinary", RestoredTo: "/bin/newbinary"}, nil)
-	fsMock.On("ListBinaries", "/bin").Return([]string{"newbinary"})
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"newbinary"})
 
 	m := &model{
 		choices:        []string{},
@@ -899,7 +960,7 @@ func Test_handleRestore_HistoryRefreshed(t *testing.T) {
 		Return(&history.RestoreResult{BinaryName: "testbin", RestoredTo: "/bin/testbin"}, nil)
 
 	fsMock := mockFS.NewMockFS(t)
-	fsMock.On("ListBinaries", "/bin").Return([]string{"testbin"})
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"testbin"})
 
 	m := &model{
 		choices:        []string{},
@@ -1026,7 +1087,7 @@ func Test_handleUndo_BinaryModeRefresh(t *testing.T) {
 
 	historyMock.On("UndoMostRecent", mock.Anything).
 		Return(&history.RestoreResult{BinaryName: "undone_binary", RestoredTo: "/bin/undone_binary"}, nil)
-	fsMock.On("ListBinaries", "/bin").Return([]string{"undone_binary", "existing"})
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"undone_binary", "existing"})
 
 	m := &model{
 		choices:        []string{"existing"},
@@ -1179,7 +1240,7 @@ func Test_model_Update_ModeSwitchToHistory(t *testing.T) {
 // Test_model_Update_ModeSwitchToBinaries verifies 'b' returns to binary mode.
 func Test_model_Update_ModeSwitchToBinaries(t *testing.T) {
 	fsMock := mockFS.NewMockFS(t)
-	fsMock.On("ListBinaries", "/bin").Return([]string{"test"})
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"test"})
 
 	m := &model{
 		choices:       []string{"test"},
@@ -1276,7 +1337,7 @@ func Test_updateHistoryMode_EnterRestore(t *testing.T) {
 
 	historyMock.On("Restore", mock.Anything, "entry1").
 		Return(&history.RestoreResult{BinaryName: "restoreme", RestoredTo: "/bin/restoreme"}, nil)
-	fsMock.On("ListBinaries", "/bin").Return([]string{"restoreme"})
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"restoreme"})
 
 	m := &model{
 		choices:        []string{},
@@ -1482,6 +1543,220 @@ func Test_model_updateGrid_VerifyState(t *testing.T) {
 	}
 }
 
+// Test_model_updateGrid_SingleColumn verifies the list layout forces one
+// column even when the binaries would otherwise wrap into several.
+func Test_model_updateGrid_SingleColumn(t *testing.T) {
+	m := &model{
+		choices: []string{"a", "b", "c", "d", "e", "f"},
+		width:   80,
+		height:  minAvailHeightAdjustment + 3,
+	}
+
+	m.updateGrid()
+	assert.Equal(t, 2, m.cols)
+	assert.Equal(t, 3, m.rows)
+
+	m.cursorX = 1
+	m.Update(keyPress('c'))
+	assert.True(t, m.singleColumn)
+	assert.Equal(t, 1, m.cols)
+	assert.Equal(t, 3, m.rows)
+	assert.Equal(t, 0, m.cursorX)
+
+	m.Update(keyPress('c'))
+	assert.False(t, m.singleColumn)
+	assert.Equal(t, 2, m.cols)
+}
+
+// Test_model_Update_SingleColumnNavigation verifies the cursor moves only
+// vertically in the list layout and Enter removes the binary under it.
+func Test_model_Update_SingleColumnNavigation(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t)
+	fsMock.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+	fsMock.On("BinarySize", "/bin/vhs").Return(int64(1500), nil)
+	fsMock.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).Return(nil)
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"age", "zed"})
+
+	m := newModel([]string{"age", "vhs", "zed"}, "/bin", Config{ListLayout: true}, &tuiMockLogger{}, fsMock, nil)
+	m.width = 200
+	m.height = 24
+	m.updateGrid()
+
+	assert.Equal(t, 1, m.cols)
+	assert.Equal(t, 3, m.rows)
+
+	m.Update(keyPress('l'))
+	assert.Equal(t, 0, m.cursorX)
+
+	m.Update(keyPress('j'))
+	assert.Equal(t, 1, m.cursorY)
+
+	got, _ := m.Update(keyPressString(keyEnter))
+	gotModel := got.(*model)
+
+	assert.Equal(t, "Removed vhs", gotModel.status)
+	assert.Equal(t, []string{"age", "zed"}, gotModel.choices)
+	assert.Equal(t, 1, gotModel.cols)
+	fsMock.AssertExpectations(t)
+}
+
+// Test_model_Update_Loading verifies the spinner is shown and keys other than
+// quit are ignored until the initial listing arrives, and that an empty
+// listing ends the session with ErrNoBinariesFound.
+func Test_model_Update_Loading(t *testing.T) {
+	newLoadingModel := func(t *testing.T, names []string) *model {
+		t.Helper()
+
+		fsMock := mockFS.NewMockFS(t)
+		fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return(names)
+
+		m := newModel(nil, "/bin", Config{}, &tuiMockLogger{}, fsMock, nil)
+		m.loading = true
+		m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+		return m
+	}
+
+	t.Run("shows the spinner until listed", func(t *testing.T) {
+		m := newLoadingModel(t, []string{"vhs", "age"})
+		assert.NotNil(t, m.Init())
+		assert.Equal(t, spinnerFrames[0]+" Scanning /bin...\n", stripANSI(m.View().Content))
+
+		_, cmd := m.Update(spinnerTickMsg{})
+		assert.NotNil(t, cmd)
+		assert.Equal(t, 1, m.spinnerFrame)
+
+		_, cmd = m.Update(keyPress('j'))
+		assert.Nil(t, cmd)
+
+		_, cmd = m.Update(m.loadChoices()())
+		assert.Nil(t, cmd)
+		assert.False(t, m.loading)
+		assert.Equal(t, []string{"age", "vhs"}, m.choices)
+		assert.Contains(t, stripANSI(m.View().Content), "age")
+
+		// The spinner stops once the listing is in.
+		_, cmd = m.Update(spinnerTickMsg{})
+		assert.Nil(t, cmd)
+	})
+
+	t.Run("empty directory quits", func(t *testing.T) {
+		m := newLoadingModel(t, []string{})
+
+		_, cmd := m.Update(m.loadChoices()())
+		assert.Equal(t, tea.QuitMsg{}, cmd())
+		assert.ErrorIs(t, m.loadErr, ErrNoBinariesFound)
+	})
+}
+
+// Test_model_CheckCorrupt verifies binaries found corrupt while loading are
+// colored and explained in the marker key, and binaries listed later are
+// checked too.
+func Test_model_CheckCorrupt(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t)
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"vhs", "age"}).Once()
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{ShowHidden: true}).Return([]string{"vhs", "age", ".tool"})
+
+	for name, reason := range map[string]string{"age": "", "vhs": fs.CorruptMalformed, ".tool": fs.CorruptEmpty} {
+		fsMock.On("AdjustBinaryPath", "/bin", name).Return("/bin/" + name)
+		fsMock.On("CorruptReason", "/bin/"+name).Return(reason, nil).Once()
+	}
+
+	m := newModel(nil, "/bin", Config{CheckCorrupt: true}, &tuiMockLogger{}, fsMock, nil)
+	m.loading = true
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	m.Update(m.loadChoices()())
+
+	assert.Equal(t, map[string]string{"age": "", "vhs": fs.CorruptMalformed}, m.corrupt)
+
+	m.showKey = true
+	view := m.View().Content
+	assert.Contains(t, stripANSI(view), "name likely corrupt")
+	assert.Contains(t, stripANSI(view), "corrupt check")
+
+	corruptStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.styles.CorruptColor))
+	assert.Contains(t, view, corruptStyle.Render("vhs"))
+	assert.NotContains(t, view, corruptStyle.Render("age"))
+
+	// Showing hidden files checks only the newly listed binary.
+	m.showHidden = true
+	m.choices = m.listBinaries()
+	assert.Equal(t, fs.CorruptEmpty, m.corrupt[".tool"])
+}
+
+// Test_model_Update_GridOrder verifies both grid orders lay out, navigate,
+// and remove the binary shown under the cursor.
+func Test_model_Update_GridOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		order     string
+		wantGrid  [][]string // Names by row as rendered
+		wantAfter string     // Binary under the cursor after moving right then down
+		wantNext  string     // Binary under the cursor after removing it
+	}{
+		{
+			name:      "column",
+			order:     GridOrderColumn,
+			wantGrid:  [][]string{{"a", "c", "e"}, {"b", "d"}},
+			wantAfter: "d",
+			wantNext:  "e",
+		},
+		{
+			name:      "row",
+			order:     GridOrderRow,
+			wantGrid:  [][]string{{"a", "b", "c"}, {"d", "e"}},
+			wantAfter: "e",
+			wantNext:  "d",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsMock := mockFS.NewMockFS(t)
+			fsMock.On("AdjustBinaryPath", "/bin", tt.wantAfter).Return("/bin/" + tt.wantAfter)
+			fsMock.On("BinarySize", "/bin/"+tt.wantAfter).Return(int64(1500), nil)
+			fsMock.On("ListBinaries", "/bin", mock.Anything).Return(func(string, fs.ListOptions) []string {
+				return []string{"a", "b", "c", "d", "e"}
+			})
+
+			choices := []string{"a", "b", "c", "d", "e"}
+			m := newModel(choices, "/bin", Config{GridOrder: tt.order, DryRun: true}, &tuiMockLogger{}, fsMock, nil)
+
+			// Three 4-column cells fit in the width, and two rows fit beside the
+			// dry-run legend and the status line left by the removal.
+			m.status = "Ready"
+			m.Update(tea.WindowSizeMsg{Width: 16, Height: minAvailHeightAdjustment + m.legendHeight() + 3})
+			assert.Equal(t, 3, m.cols)
+			assert.Equal(t, 2, m.rows)
+
+			for row, names := range tt.wantGrid {
+				for col, name := range names {
+					assert.Equal(t, name, m.choices[m.cellIndex(row, col)], "cell %d,%d", row, col)
+
+					gotRow, gotCol := m.cellPosition(m.cellIndex(row, col))
+					assert.Equal(t, [2]int{row, col}, [2]int{gotRow, gotCol})
+				}
+			}
+
+			m.Update(keyPress('l'))
+			m.Update(keyPress('j'))
+
+			name, ok := m.currentChoice()
+			require.True(t, ok)
+			assert.Equal(t, tt.wantAfter, name)
+
+			m.Update(keyPressString(keyEnter))
+			assert.Equal(t, "Would remove "+tt.wantAfter, m.status)
+			assert.NotContains(t, m.choices, tt.wantAfter)
+
+			name, ok = m.currentChoice()
+			require.True(t, ok)
+			assert.Equal(t, tt.wantNext, name)
+			fsMock.AssertExpectations(t)
+		})
+	}
+}
+
 // Test_model_cursorBounds verifies cursor stays within bounds after list changes.
 func Test_model_cursorBounds(t *testing.T) {
 	tests := []struct {
@@ -1557,8 +1832,10 @@ func Test_model_statusUpdates(t *testing.T) {
 	fsMock := mockFS.NewMockFS(t)
 
 	fsMock.On("AdjustBinaryPath", "/bin", "test").Return("/bin/test")
+
+	fsMock.On("BinarySize", "/bin/test").Return(int64(1500), nil)
 	fsMock.On("RemoveBinary", "/bin/test", "test", false, mock.Anything).Return(nil)
-	fsMock.On("ListBinaries", "/bin").Return([]string{})
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{})
 
 	m := &model{
 		choices:       []string{"test"},
@@ -1724,6 +2001,33 @@ func Test_model_Update_AlternateScreen(t *testing.T) {
 	assert.True(t, view.AltScreen)
 }
 
+// Test_model_View_Inline verifies inline mode renders on the main screen at
+// its natural height instead of filling the terminal, in both views.
+func Test_model_View_Inline(t *testing.T) {
+	for _, mode := range []string{modeBinaries, modeHistory} {
+		t.Run(mode, func(t *testing.T) {
+			m := &model{
+				mode:          mode,
+				choices:       []string{"test"},
+				dir:           "/bin",
+				config:        Config{Inline: true},
+				fs:            mockFS.NewMockFS(t),
+				logger:        &tuiMockLogger{},
+				cols:          1,
+				rows:          1,
+				width:         80,
+				height:        24,
+				sortAscending: true,
+			}
+
+			view := m.View()
+
+			assert.False(t, view.AltScreen)
+			assert.Less(t, strings.Count(view.Content, "\n")+1, m.height)
+		})
+	}
+}
+
 // Additional tests for handleClearEntry
 
 // Test_handleClearEntry_ErrorHandling verifies error handling when clearing entry fails.
@@ -1899,6 +2203,39 @@ func Test_model_Init_WithVerboseMode(t *testing.T) {
 	assert.NotNil(t, cmd)
 }
 
+// Test_model_toggleVerboseLogging verifies "L" turns on debug logging and
+// verbose removals mid-session, and turning it off restores the configured
+// log level rather than assuming info.
+func Test_model_toggleVerboseLogging(t *testing.T) {
+	log := mockLogger.NewMockLogger(t)
+	log.On("Level", zerolog.DebugLevel).Return().Once()
+	log.On("Level", zerolog.WarnLevel).Return().Once()
+
+	m := &model{
+		choices:       []string{},
+		dir:           "/bin",
+		config:        Config{LogLevel: "warn"},
+		fs:            mockFS.NewMockFS(t),
+		logger:        log,
+		mode:          modeBinaries,
+		logChan:       make(chan LogMsg, maxLogLines),
+		sortAscending: true,
+	}
+
+	got, cmd := m.Update(keyPress('L'))
+	gotModel := got.(*model)
+
+	assert.True(t, gotModel.showLogs)
+	assert.True(t, gotModel.config.Verbose)
+	assert.NotNil(t, cmd)
+
+	got, _ = gotModel.Update(keyPress('L'))
+	gotModel = got.(*model)
+
+	assert.False(t, gotModel.showLogs)
+	assert.False(t, gotModel.config.Verbose)
+}
+
 // Test_model_handleConfirmation_ExecuteClearAllError verifies error handling when ClearHistory fails.
 func Test_model_handleConfirmation_ExecuteClearAllError(t *testing.T) {
 	historyMock := mockHistory.NewMockManager(t)
@@ -2128,6 +2465,239 @@ func Test_model_Update_ToggleLogs(t *testing.T) {
 	assert.False(t, gotModel.showLogs)
 }
 
+// Test_model_Update_ToggleRemoved verifies H toggles the removed panel and that
+// it lists the binaries removed this session with their sizes.
+func Test_model_Update_ToggleRemoved(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t)
+	fsMock.On("AdjustBinaryPath", "/bin", "age").Return("/bin/age")
+	fsMock.On("BinarySize", "/bin/age").Return(int64(1500), nil)
+	fsMock.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).Return(nil)
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"vhs"})
+
+	m := &model{
+		choices:       []string{"age", "vhs"},
+		dir:           "/bin",
+		fs:            fsMock,
+		logger:        &tuiMockLogger{},
+		mode:          modeBinaries,
+		cols:          1,
+		rows:          2,
+		width:         80,
+		height:        24,
+		sortAscending: true,
+		styles:        defaultStyleConfig(),
+	}
+
+	got, _ := m.Update(keyPress('H'))
+	gotModel := got.(*model)
+	assert.True(t, gotModel.showRemoved)
+	assert.Contains(t, stripANSI(gotModel.View().Content), "Nothing removed yet")
+
+	got, _ = gotModel.Update(keyPressString(keyEnter))
+	gotModel = got.(*model)
+
+	assert.Equal(t, []Removal{{Name: "age", Path: "/bin/age", Size: 1500, BytesFreed: 1500}}, gotModel.removals)
+	assert.Contains(t, stripANSI(gotModel.View().Content), "age  1.5 kB")
+
+	got, _ = gotModel.Update(keyPress('H'))
+	gotModel = got.(*model)
+	assert.False(t, gotModel.showRemoved)
+	assert.NotContains(t, stripANSI(gotModel.View().Content), "Removed This Session")
+}
+
+// Test_model_legend verifies the legend lists active modes in a fixed order
+// and is omitted when nothing is active.
+func Test_model_legend(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     Config
+		showHidden bool
+		filter     string
+		want       string
+	}{
+		{
+			name: "defaults",
+			want: "",
+		},
+		{
+			name:   "dry run",
+			config: Config{DryRun: true},
+			want:   "Active: dry run",
+		},
+		{
+			name:   "dry run targeting GOROOT",
+			config: Config{DryRun: true, Goroot: true},
+			want:   "Active: dry run · GOROOT",
+		},
+		{
+			name:       "go version with hidden files and a filter",
+			config:     Config{GoVersion: "1.22.3"},
+			showHidden: true,
+			filter:     "lint",
+			want:       "Active: go1.22.3 · hidden files · filter: lint",
+		},
+		{
+			name:   "listing options",
+			config: Config{IncludeBundles: true, IncludeNonExecutable: true, Match: regexp.MustCompile("^go")},
+			want:   "Active: app bundles · non-executable files · regex: ^go",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &model{config: tt.config, showHidden: tt.showHidden, filter: tt.filter}
+
+			assert.Equal(t, tt.want, m.legend())
+			assert.Equal(t, min(len(tt.want), 1), m.legendHeight())
+		})
+	}
+}
+
+// Test_model_View_Legend verifies the legend is drawn under the title without
+// changing the overall height of the view.
+func Test_model_View_Legend(t *testing.T) {
+	render := func(config Config) []string {
+		m := &model{
+			choices:       []string{"vhs"},
+			config:        config,
+			styles:        defaultStyleConfig(),
+			sortAscending: true,
+			width:         80,
+			height:        24,
+		}
+		m.updateGrid()
+
+		return strings.Split(stripANSI(m.View().Content), "\n")
+	}
+
+	plain := render(Config{})
+	lines := render(Config{DryRun: true, Goroot: true})
+
+	require.GreaterOrEqual(t, len(lines), 2)
+	assert.Contains(t, lines[0], "Select a binary to remove:")
+	assert.Contains(t, lines[1], "Active: dry run · GOROOT")
+	assert.Len(t, lines, len(plain))
+}
+
+// Test_model_View_UnusualNames verifies names with spaces or wide characters
+// stay in one cell and keep the grid's columns aligned.
+func Test_model_View_UnusualNames(t *testing.T) {
+	m := &model{
+		choices:       []string{"my tool", "工具", "vhs", "x"},
+		styles:        defaultStyleConfig(),
+		sortAscending: true,
+		width:         80,
+		height:        10,
+	}
+	m.updateGrid()
+
+	require.Equal(t, 2, m.rows)
+	require.Equal(t, 2, m.cols)
+
+	lines := strings.Split(stripANSI(m.View().Content), "\n")
+	colWidth := m.columnWidth()
+
+	// Each second-column name starts exactly one column width after the
+	// first-column name in its row.
+	for row := range m.rows {
+		first, second := m.choices[row], m.choices[row+m.rows]
+
+		var line string
+
+		for _, candidate := range lines {
+			if strings.Contains(candidate, first) && strings.Contains(candidate, second) {
+				line = candidate
+			}
+		}
+
+		require.NotEmpty(t, line, "no grid row holds %q and %q", first, second)
+
+		firstStart := lipgloss.Width(line[:strings.Index(line, first)])
+		secondStart := lipgloss.Width(line[:strings.LastIndex(line, second)])
+		assert.Equal(t, colWidth, secondStart-firstStart, "row %q", line)
+	}
+}
+
+// Test_model_View_LongNameNarrowTerminal verifies a name wider than the
+// terminal is cut with an ellipsis instead of wrapping onto another line.
+func Test_model_View_LongNameNarrowTerminal(t *testing.T) {
+	long := "golangci-lint-with-a-very-long-custom-build-suffix"
+
+	m := &model{
+		choices:       []string{"age", long},
+		styles:        defaultStyleConfig(),
+		sortAscending: true,
+		width:         24,
+		height:        24,
+	}
+	m.updateGrid()
+
+	assert.Equal(t, 1, m.cols)
+	assert.LessOrEqual(t, m.columnWidth(), m.width-availWidthAdjustment)
+
+	content := stripANSI(m.View().Content)
+	assert.NotContains(t, content, "suffix")
+
+	var cut []string
+
+	for _, line := range strings.Split(content, "\n") {
+		if strings.Contains(line, "golangci") {
+			cut = append(cut, line)
+		}
+	}
+
+	require.Len(t, cut, 1)
+	assert.Contains(t, cut[0], "…")
+	assert.LessOrEqual(t, lipgloss.Width(strings.TrimRight(cut[0], " ")), m.width-leftPadding)
+}
+
+// Test_model_View_MarkerKey verifies "?" toggles the marker key without
+// changing the overall view height.
+func Test_model_View_MarkerKey(t *testing.T) {
+	m := &model{
+		choices:       []string{"age", "vhs"},
+		selected:      map[string]bool{},
+		styles:        defaultStyleConfig(),
+		sortAscending: true,
+		width:         80,
+		height:        24,
+	}
+	m.updateGrid()
+
+	before := stripANSI(m.View().Content)
+	assert.NotContains(t, before, "Key:")
+
+	m.Update(keyPress('?'))
+
+	after := strings.Split(stripANSI(m.View().Content), "\n")
+	require.GreaterOrEqual(t, len(after), 2)
+	assert.Equal(t, "Key: ❯ cursor · ✓ marked for removal", strings.TrimSpace(after[1]))
+	assert.Len(t, after, strings.Count(before, "\n")+1)
+
+	m.Update(keyPress('?'))
+	assert.NotContains(t, stripANSI(m.View().Content), "Key:")
+}
+
+// Test_model_getVisibleRemovals verifies the removed panel keeps only the most
+// recent removals once the session outgrows it.
+func Test_model_getVisibleRemovals(t *testing.T) {
+	removals := make([]Removal, 0, maxVisibleRemovedLines+2)
+	for i := range maxVisibleRemovedLines + 2 {
+		removals = append(removals, Removal{Name: fmt.Sprintf("tool%d", i)})
+	}
+
+	m := &model{removals: removals, showRemoved: true}
+
+	got := m.getVisibleRemovals()
+	assert.Len(t, got, maxVisibleRemovedLines)
+	assert.Equal(t, "tool2", got[0].Name)
+	assert.Contains(t, m.renderRemovedPanel(lipgloss.NewStyle()), "(last 5 of 7)")
+
+	m.showRemoved = false
+	assert.Nil(t, m.getVisibleRemovals())
+	assert.Zero(t, m.removedPanelHeight())
+}
+
 // Test_model_Update_UndoKey verifies u key triggers undo.
 func Test_model_Update_UndoKey(t *testing.T) {
 	fsMock := mockFS.NewMockFS(t)
@@ -2135,7 +2705,7 @@ func Test_model_Update_UndoKey(t *testing.T) {
 
 	historyMock.On("UndoMostRecent", mock.Anything).
 		Return(&history.RestoreResult{BinaryName: "undone", RestoredTo: "/bin/undone"}, nil)
-	fsMock.On("ListBinaries", "/bin").Return([]string{"undone"})
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"undone"})
 
 	m := &model{
 		choices:        []string{},
@@ -2300,7 +2870,7 @@ func Test_handleRestore_HistoryModeRefresh(t *testing.T) {
 
 	historyMock.On("Restore", mock.Anything, "entry1").
 		Return(&history.RestoreResult{BinaryName: "restoreme", RestoredTo: "/bin/restoreme"}, nil)
-	fsMock.On("ListBinaries", "/bin").Return([]string{"restoreme"})
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"restoreme"})
 
 	m := &model{
 		choices:        []string{},
@@ -2324,3 +2894,727 @@ func Test_handleRestore_HistoryModeRefresh(t *testing.T) {
 	fsMock.AssertExpectations(t)
 	historyMock.AssertExpectations(t)
 }
+
+// Test_model_Update_ToggleSelection verifies space marks and unmarks the binary under the cursor.
+func Test_model_Update_ToggleSelection(t *testing.T) {
+	m := &model{
+		choices: []string{"age", "vhs"},
+		cols:    1,
+		rows:    2,
+		cursorY: 1,
+		logger:  &tuiMockLogger{},
+	}
+
+	m.Update(keyPressString(keySpace))
+	assert.Equal(t, map[string]bool{"vhs": true}, m.selected)
+
+	m.Update(keyPressString(keySpace))
+	assert.Empty(t, m.selected)
+}
+
+// Test_model_Update_SelectAll verifies a marks only the binaries the filter
+// leaves visible and keeps marks on hidden ones, which the footer counts.
+func Test_model_Update_SelectAll(t *testing.T) {
+	m := &model{
+		choices:       []string{"golangci-lint", "gopls"},
+		filter:        "go",
+		mode:          modeBinaries,
+		selected:      map[string]bool{"gopls": true, "vhs": true},
+		cols:          1,
+		rows:          2,
+		width:         80,
+		height:        24,
+		sortAscending: true,
+		logger:        &tuiMockLogger{},
+		styles:        defaultStyleConfig(),
+	}
+
+	m.Update(keyPress('a'))
+	assert.Equal(t, map[string]bool{"golangci-lint": true, "gopls": true, "vhs": true}, m.selected)
+	assert.Contains(t, stripANSI(m.View().Content), "2 of 3 selected")
+	assert.Nil(t, m.menu, "a no longer opens the action menu")
+
+	// Selecting again changes nothing.
+	m.Update(keyPress('a'))
+	assert.Len(t, m.selected, 3)
+}
+
+// Test_model_Update_ClearSelection verifies A clears the whole selection,
+// including marks the filter hides.
+func Test_model_Update_ClearSelection(t *testing.T) {
+	m := &model{
+		choices:       []string{"golangci-lint", "gopls"},
+		filter:        "go",
+		mode:          modeBinaries,
+		selected:      map[string]bool{"gopls": true, "vhs": true},
+		cols:          1,
+		rows:          2,
+		width:         80,
+		height:        24,
+		sortAscending: true,
+		logger:        &tuiMockLogger{},
+		styles:        defaultStyleConfig(),
+	}
+
+	m.Update(keyPress('A'))
+	assert.Empty(t, m.selected)
+	assert.NotContains(t, stripANSI(m.View().Content), "selected")
+
+	// Clearing an empty selection is harmless.
+	m.Update(keyPress('A'))
+	assert.Empty(t, m.selected)
+}
+
+// Test_model_Update_EnterRemovesSelection verifies Enter removes every selected binary.
+func Test_model_Update_EnterRemovesSelection(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t)
+	fsMock.On("AdjustBinaryPath", "/bin", "age").Return("/bin/age")
+	fsMock.On("BinarySize", "/bin/age").Return(int64(1500), nil)
+	fsMock.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+	fsMock.On("BinarySize", "/bin/vhs").Return(int64(1500), nil)
+	fsMock.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).Return(nil)
+	fsMock.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).Return(nil)
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"gopls"})
+
+	m := &model{
+		choices:  []string{"age", "gopls", "vhs"},
+		selected: map[string]bool{"age": true, "vhs": true},
+		dir:      "/bin",
+		fs:       fsMock,
+		logger:   &tuiMockLogger{},
+		cols:     1,
+		rows:     3,
+		width:    80,
+		height:   24,
+	}
+
+	got, _ := m.Update(keyPressString(keyEnter))
+	gotModel := got.(*model)
+
+	assert.Equal(t, "Removed 2 binaries", gotModel.status)
+	assert.Empty(t, gotModel.selected)
+	assert.Equal(t, []string{"gopls"}, gotModel.choices)
+	fsMock.AssertExpectations(t)
+}
+
+// auditRecorder collects the audit entries a test's removals produce.
+type auditRecorder struct {
+	entries []audit.Entry
+}
+
+// Record appends entry to the collected entries.
+func (r *auditRecorder) Record(entry audit.Entry) error {
+	r.entries = append(r.entries, entry)
+
+	return nil
+}
+
+// Test_model_Update_AuditLog verifies every removal attempted from the TUI,
+// failed or not, is appended to the audit trail.
+func Test_model_Update_AuditLog(t *testing.T) {
+	removeErr := errors.New("permission denied")
+
+	fsMock := mockFS.NewMockFS(t)
+	fsMock.On("AdjustBinaryPath", "/bin", "age").Return("/bin/age")
+	fsMock.On("BinarySize", "/bin/age").Return(int64(1500), nil)
+	fsMock.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+	fsMock.On("BinarySize", "/bin/vhs").Return(int64(1500), nil)
+	fsMock.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).Return(nil)
+	fsMock.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).Return(removeErr)
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"gopls", "vhs"})
+
+	recorder := &auditRecorder{}
+
+	m := &model{
+		choices:  []string{"age", "gopls", "vhs"},
+		selected: map[string]bool{"age": true, "vhs": true},
+		dir:      "/bin",
+		fs:       fsMock,
+		audit:    recorder,
+		logger:   &tuiMockLogger{},
+		cols:     1,
+		rows:     3,
+		width:    80,
+		height:   24,
+	}
+
+	m.Update(keyPressString(keyEnter))
+
+	assert.Equal(t, []audit.Entry{
+		{Name: "age", Path: "/bin/age"},
+		{Name: "vhs", Path: "/bin/vhs", Err: fmt.Errorf("removing vhs: %w", removeErr)},
+	}, recorder.entries)
+}
+
+// Test_model_Update_AlreadyRemoved verifies a binary deleted from another
+// terminal is dropped quietly instead of being reported as an error.
+func Test_model_Update_AlreadyRemoved(t *testing.T) {
+	notFound := fmt.Errorf("%w: vhs at /bin/vhs", fs.ErrBinaryNotFound)
+
+	tests := []struct {
+		name       string
+		selected   map[string]bool
+		cursorY    int
+		wantStatus string
+	}{
+		{
+			name:       "single binary",
+			cursorY:    2,
+			wantStatus: "vhs was already removed",
+		},
+		{
+			name:       "with other removals",
+			selected:   map[string]bool{"age": true, "vhs": true},
+			wantStatus: "Removed age; vhs was already removed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsMock := mockFS.NewMockFS(t)
+			fsMock.On("AdjustBinaryPath", "/bin", mock.Anything).Return(func(dir, name string) string {
+				return dir + "/" + name
+			})
+			fsMock.On("BinarySize", mock.Anything).Return(int64(1500), nil)
+			fsMock.On("RemoveBinary", "/bin/age", "age", false, mock.Anything).Return(nil).Maybe()
+			fsMock.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).Return(notFound)
+			fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"gopls"})
+
+			m := &model{
+				choices:  []string{"age", "gopls", "vhs"},
+				selected: tt.selected,
+				cursorY:  tt.cursorY,
+				dir:      "/bin",
+				fs:       fsMock,
+				logger:   &tuiMockLogger{},
+				cols:     1,
+				rows:     3,
+				width:    80,
+				height:   24,
+			}
+
+			m.Update(keyPressString(keyEnter))
+
+			assert.Equal(t, tt.wantStatus, m.status)
+			assert.Equal(t, []string{"gopls"}, m.choices)
+			assert.Empty(t, m.selected)
+		})
+	}
+}
+
+// Test_model_Update_QuitWithSelection verifies quitting with a pending selection asks for confirmation.
+func Test_model_Update_QuitWithSelection(t *testing.T) {
+	newModel := func() *model {
+		return &model{
+			choices:  []string{"age", "vhs"},
+			selected: map[string]bool{"age": true},
+			mode:     modeBinaries,
+			logger:   &tuiMockLogger{},
+			cols:     1,
+			rows:     2,
+			width:    80,
+			height:   24,
+			styles:   defaultStyleConfig(),
+		}
+	}
+
+	t.Run("q asks for confirmation", func(t *testing.T) {
+		m := newModel()
+
+		_, cmd := m.Update(keyPress('q'))
+
+		assert.Nil(t, cmd)
+		assert.Equal(t, confirmQuit, m.confirmation)
+		assert.Contains(t, stripANSI(m.View().Content), "Quit without removing 1 selected? [y/N]")
+	})
+
+	t.Run("y quits", func(t *testing.T) {
+		m := newModel()
+		m.confirmation = confirmQuit
+
+		_, cmd := m.Update(keyPress('y'))
+
+		require.NotNil(t, cmd)
+		assert.IsType(t, tea.QuitMsg{}, cmd())
+	})
+
+	t.Run("n keeps selection", func(t *testing.T) {
+		m := newModel()
+		m.confirmation = confirmQuit
+
+		_, cmd := m.Update(keyPress('n'))
+
+		assert.Nil(t, cmd)
+		assert.Equal(t, confirmNone, m.confirmation)
+		assert.Equal(t, map[string]bool{"age": true}, m.selected)
+	})
+}
+
+// Test_model_Update_DryRunNotAllowed verifies a dry run refuses binaries
+// outside the allowlist, as a real run would, instead of reporting them.
+func Test_model_Update_DryRunNotAllowed(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t) // Nothing is measured or removed
+	fsMock.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+
+	m := &model{
+		choices:       []string{"gopls", "vhs"},
+		selected:      map[string]bool{"vhs": true},
+		dir:           "/bin",
+		config:        Config{DryRun: true, Allow: []*regexp.Regexp{regexp.MustCompile("^go")}},
+		fs:            fsMock,
+		logger:        &tuiMockLogger{},
+		cols:          1,
+		rows:          2,
+		width:         80,
+		height:        24,
+		sortAscending: true,
+	}
+
+	m.Update(keyPressString(keyEnter))
+
+	assert.Equal(t, "Error vhs is not in the allowed set", m.status)
+	assert.Empty(t, m.removals)
+	assert.Equal(t, []string{"gopls", "vhs"}, m.choices)
+}
+
+// Test_model_Update_DryRunRecordsRemovals verifies dry-run removals are recorded without deleting anything.
+func Test_model_Update_DryRunRecordsRemovals(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t)
+	fsMock.On("AdjustBinaryPath", "/bin", "age").Return("/bin/age")
+	fsMock.On("BinarySize", "/bin/age").Return(int64(1500), nil)
+	fsMock.On("AdjustBinaryPath", "/bin", "gopls").Return("/bin/gopls")
+	fsMock.On("BinarySize", "/bin/gopls").Return(int64(1500), nil)
+	fsMock.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+	fsMock.On("BinarySize", "/bin/vhs").Return(int64(1500), nil)
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return(func(string, fs.ListOptions) []string {
+		return []string{"age", "gopls", "vhs"} // Nothing is deleted
+	})
+
+	m := &model{
+		choices:       []string{"age", "gopls", "vhs"},
+		selected:      map[string]bool{"age": true, "vhs": true},
+		dir:           "/bin",
+		config:        Config{DryRun: true},
+		fs:            fsMock,
+		logger:        &tuiMockLogger{},
+		cols:          1,
+		rows:          3,
+		width:         80,
+		height:        24,
+		sortAscending: true,
+	}
+
+	assert.Contains(t, stripANSI(m.View().Content), "Active: dry run")
+
+	m.Update(keyPressString(keyEnter))
+
+	assert.Equal(t, "Would remove 2 binaries", m.status)
+	assert.Equal(t, []string{"gopls"}, m.choices)
+
+	m.Update(keyPressString(keyEnter))
+
+	assert.Equal(t, "Would remove gopls", m.status)
+	assert.Equal(t, []Removal{
+		{Name: "age", Path: "/bin/age", Size: 1500},
+		{Name: "vhs", Path: "/bin/vhs", Size: 1500},
+		{Name: "gopls", Path: "/bin/gopls", Size: 1500},
+	}, m.removals)
+	fsMock.AssertExpectations(t) // RemoveBinary is never called
+}
+
+// Test_model_Update_DryRunFilter verifies a dry run that hides every match of
+// the filter clears the filter as a real run does, and that relisting does not
+// bring the binaries it reported back.
+func Test_model_Update_DryRunFilter(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t)
+	fsMock.On("AdjustBinaryPath", "/bin", "gopls").Return("/bin/gopls")
+	fsMock.On("BinarySize", "/bin/gopls").Return(int64(1500), nil)
+	fsMock.On("ListBinaries", "/bin", mock.Anything).Return(func(string, fs.ListOptions) []string {
+		return []string{"age", "gopls", "vhs"} // Nothing is deleted
+	})
+
+	m := &model{
+		choices:       []string{"gopls"},
+		filter:        "gop",
+		dir:           "/bin",
+		config:        Config{DryRun: true},
+		fs:            fsMock,
+		logger:        &tuiMockLogger{},
+		cols:          1,
+		rows:          3,
+		width:         80,
+		height:        24,
+		sortAscending: true,
+	}
+
+	_, cmd := m.Update(keyPressString(keyEnter))
+
+	assert.Nil(t, cmd, "the session must not quit")
+	assert.Equal(t, "Would remove gopls", m.status)
+	assert.Empty(t, m.filter)
+	assert.Equal(t, []string{"age", "vhs"}, m.choices)
+
+	// Showing hidden files relists the directory without bringing gopls back.
+	m.Update(keyPress('.'))
+	assert.NotContains(t, m.choices, "gopls")
+}
+
+// TestNewModel verifies a model built from pre-fetched choices renders them without listing the directory.
+func TestNewModel(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t) // ListBinaries must not be called
+
+	got := NewModel([]string{"remote-b", "remote-a"}, "/virtual/bin", Config{}, &tuiMockLogger{}, fsMock)
+
+	m, ok := got.(*model)
+	require.True(t, ok, "NewModel() should return a *model")
+	assert.Equal(t, modeBinaries, m.mode)
+	assert.Equal(t, "/virtual/bin", m.dir)
+	assert.Nil(t, m.historyManager)
+
+	m.Init()
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	view := stripANSI(m.View().Content)
+	assert.Contains(t, view, "❯ remote-a")
+	assert.Contains(t, view, "remote-b")
+	fsMock.AssertExpectations(t)
+}
+
+// Test_model_Update_ToggleHidden verifies "." re-lists the directory with hidden files shown, then hidden.
+func Test_model_Update_ToggleHidden(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t)
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{ShowHidden: true}).
+		Return([]string{"vhs", ".envrc"}).Once()
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"vhs"}).Once()
+
+	m := &model{
+		choices:       []string{"vhs"},
+		dir:           "/bin",
+		fs:            fsMock,
+		logger:        &tuiMockLogger{},
+		cols:          1,
+		rows:          1,
+		width:         80,
+		height:        24,
+		sortAscending: true,
+	}
+
+	m.Update(keyPress('.'))
+	assert.True(t, m.showHidden)
+	assert.Equal(t, []string{".envrc", "vhs"}, m.choices)
+	assert.Equal(t, "Showing hidden files", m.status)
+
+	m.Update(keyPress('.'))
+	assert.False(t, m.showHidden)
+	assert.Equal(t, []string{"vhs"}, m.choices)
+	assert.Equal(t, "Hiding hidden files", m.status)
+	fsMock.AssertExpectations(t)
+}
+
+// Test_model_Update_BundleConfirmation verifies Enter asks before removing an app bundle.
+func Test_model_Update_BundleConfirmation(t *testing.T) {
+	newModel := func(fsMock *mockFS.MockFS) *model {
+		return &model{
+			choices: []string{"Viewer.app", "vhs"},
+			dir:     "/bin",
+			config:  Config{IncludeBundles: true},
+			mode:    modeBinaries,
+			fs:      fsMock,
+			logger:  &tuiMockLogger{},
+			cols:    1,
+			rows:    2,
+			width:   80,
+			height:  24,
+			styles:  defaultStyleConfig(),
+		}
+	}
+
+	t.Run("y removes bundle", func(t *testing.T) {
+		fsMock := mockFS.NewMockFS(t)
+		fsMock.On("AdjustBinaryPath", "/bin", "Viewer.app").Return("/bin/Viewer.app")
+		fsMock.On("RemoveBinary", "/bin/Viewer.app", "Viewer.app", false, mock.Anything).Return(nil)
+		fsMock.On("ListBinaries", "/bin", fs.ListOptions{IncludeBundles: true}).Return([]string{"vhs"})
+
+		m := newModel(fsMock)
+
+		m.Update(keyPressString(keyEnter))
+		assert.Equal(t, confirmBundle, m.confirmation)
+		assert.Contains(
+			t,
+			stripANSI(m.View().Content),
+			"Remove app bundle Viewer.app and all of its contents? [y/N]",
+		)
+
+		m.Update(keyPress('y'))
+		assert.Equal(t, confirmNone, m.confirmation)
+		assert.Equal(t, "Removed Viewer.app", m.status)
+		assert.Equal(t, []string{"vhs"}, m.choices)
+		fsMock.AssertExpectations(t)
+	})
+
+	t.Run("n keeps bundle", func(t *testing.T) {
+		fsMock := mockFS.NewMockFS(t) // RemoveBinary must not be called
+
+		m := newModel(fsMock)
+
+		m.Update(keyPressString(keyEnter))
+		m.Update(keyPress('n'))
+
+		assert.Equal(t, confirmNone, m.confirmation)
+		assert.Equal(t, []string{"Viewer.app", "vhs"}, m.choices)
+		fsMock.AssertExpectations(t)
+	})
+}
+
+// Test_model_View_SortIndicator verifies the footer reflects the current sort direction.
+func Test_model_View_SortIndicator(t *testing.T) {
+	m := &model{
+		choices:       []string{"age", "vhs"},
+		mode:          modeBinaries,
+		logger:        &tuiMockLogger{},
+		cols:          1,
+		rows:          2,
+		width:         80,
+		height:        24,
+		sortAscending: true,
+		styles:        defaultStyleConfig(),
+	}
+
+	assert.Contains(t, stripANSI(m.View().Content), "sort: A→Z")
+
+	m.Update(keyPress('s'))
+	assert.Contains(t, stripANSI(m.View().Content), "sort: Z→A")
+}
+
+// Test_model_Update_FilterEnter verifies Enter removes the only filtered match
+// and only applies the filter when several binaries match.
+func Test_model_Update_FilterEnter(t *testing.T) {
+	all := []string{"age", "gopls", "golangci-lint", "vhs"}
+
+	newModel := func(fsMock *mockFS.MockFS) *model {
+		return &model{
+			choices:       slices.Clone(all),
+			dir:           "/bin",
+			mode:          modeBinaries,
+			fs:            fsMock,
+			logger:        &tuiMockLogger{},
+			cols:          1,
+			rows:          4,
+			width:         80,
+			height:        24,
+			sortAscending: true,
+			styles:        defaultStyleConfig(),
+		}
+	}
+
+	typeFilter := func(m *model, filter string) {
+		m.Update(keyPress('/'))
+
+		for _, r := range filter {
+			m.Update(keyPress(r))
+		}
+	}
+
+	t.Run("single match is removed", func(t *testing.T) {
+		fsMock := mockFS.NewMockFS(t)
+		fsMock.EXPECT().ListBinaries("/bin", fs.ListOptions{}).
+			RunAndReturn(func(string, fs.ListOptions) []string { return slices.Clone(all) }).Times(2)
+		fsMock.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+		fsMock.On("BinarySize", "/bin/vhs").Return(int64(1500), nil)
+		fsMock.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).Return(nil)
+		fsMock.EXPECT().ListBinaries("/bin", fs.ListOptions{}).
+			RunAndReturn(func(string, fs.ListOptions) []string {
+				return []string{"age", "gopls", "golangci-lint"}
+			}).Times(2)
+
+		m := newModel(fsMock)
+		typeFilter(m, "VH")
+		assert.Equal(t, []string{"vhs"}, m.choices)
+		assert.Contains(t, stripANSI(m.View().Content), "Filter: VH_")
+
+		m.Update(keyPressString(keyEnter))
+
+		assert.False(t, m.filtering)
+		assert.Equal(t, "Removed vhs", m.status)
+		assert.Empty(t, m.filter, "a filter with no remaining matches is cleared")
+		assert.Equal(t, []string{"age", "golangci-lint", "gopls"}, m.choices)
+		fsMock.AssertExpectations(t)
+	})
+
+	t.Run("single match ignores hidden selection", func(t *testing.T) {
+		fsMock := mockFS.NewMockFS(t)
+		fsMock.EXPECT().ListBinaries("/bin", fs.ListOptions{}).
+			RunAndReturn(func(string, fs.ListOptions) []string { return slices.Clone(all) }).Times(2)
+		fsMock.On("AdjustBinaryPath", "/bin", "vhs").Return("/bin/vhs")
+		fsMock.On("BinarySize", "/bin/vhs").Return(int64(1500), nil)
+		fsMock.On("RemoveBinary", "/bin/vhs", "vhs", false, mock.Anything).Return(nil)
+		fsMock.EXPECT().ListBinaries("/bin", fs.ListOptions{}).
+			RunAndReturn(func(string, fs.ListOptions) []string {
+				return []string{"age", "gopls", "golangci-lint"}
+			}).Times(2)
+
+		m := newModel(fsMock)
+		m.selected = map[string]bool{"age": true, "vhs": true}
+		typeFilter(m, "vh")
+
+		m.Update(keyPressString(keyEnter))
+
+		assert.Equal(t, "Removed vhs", m.status)
+		assert.Equal(t, map[string]bool{"age": true}, m.selected, "the hidden selection is kept")
+		assert.Equal(t, []string{"age", "golangci-lint", "gopls"}, m.choices)
+		fsMock.AssertExpectations(t)
+	})
+
+	t.Run("multiple matches keep cursor behavior", func(t *testing.T) {
+		fsMock := mockFS.NewMockFS(t) // RemoveBinary must not be called
+		fsMock.EXPECT().ListBinaries("/bin", fs.ListOptions{}).
+			RunAndReturn(func(string, fs.ListOptions) []string { return slices.Clone(all) })
+
+		m := newModel(fsMock)
+		typeFilter(m, "go")
+		assert.Equal(t, []string{"golangci-lint", "gopls"}, m.choices)
+
+		m.Update(keyPressString(keyEnter))
+
+		assert.False(t, m.filtering)
+		assert.Equal(t, "go", m.filter)
+		assert.Equal(t, []string{"golangci-lint", "gopls"}, m.choices)
+		assert.Contains(t, stripANSI(m.View().Content), "Active: filter: go")
+		fsMock.AssertExpectations(t)
+	})
+}
+
+// Test_model_View_CustomCursor verifies custom cursors and column padding keep
+// grid columns aligned whichever cell the cursor is on.
+func Test_model_View_CustomCursor(t *testing.T) {
+	for _, cursor := range []string{">", "=>> "} {
+		t.Run(cursor, func(t *testing.T) {
+			styles := defaultStyleConfig()
+			styles.Cursor = cursor
+			styles.ColumnPadding = 2
+
+			m := &model{
+				choices:       []string{"age", "gopls", "vhs", "yq"},
+				mode:          modeBinaries,
+				logger:        &tuiMockLogger{},
+				cols:          2,
+				rows:          2,
+				width:         80,
+				height:        24,
+				sortAscending: true,
+				selected:      map[string]bool{"yq": true},
+				styles:        styles,
+			}
+
+			lines := strings.Split(stripANSI(m.View().Content), "\n")
+			column := func(name string) int {
+				for _, line := range lines {
+					if i := strings.Index(line, name); i >= 0 {
+						return utf8.RuneCountInString(line[:i])
+					}
+				}
+
+				t.Fatalf("%q not rendered", name)
+
+				return -1
+			}
+
+			prefix := maximum(utf8.RuneCountInString(cursor), 2)
+			assert.Equal(t, column("age"), column("gopls"), "first column misaligned")
+			assert.Equal(t, column("vhs"), column("yq"), "second column misaligned")
+			assert.Equal(t, len("gopls")+2+prefix, column("vhs")-column("age"))
+		})
+	}
+}
+
+// Test_model_View_LongStatus verifies a long status is truncated to the
+// terminal width instead of wrapping onto extra lines.
+func Test_model_View_LongStatus(t *testing.T) {
+	const width = 40
+
+	m := &model{
+		choices:       []string{"age", "vhs"},
+		mode:          modeBinaries,
+		logger:        &tuiMockLogger{},
+		cols:          1,
+		rows:          2,
+		width:         width,
+		height:        24,
+		sortAscending: true,
+		styles:        defaultStyleConfig(),
+	}
+
+	m.status = "Removed age"
+	short := strings.Split(stripANSI(m.View().Content), "\n")
+
+	m.status = "Error removing very-long-binary-name: failed to remove /very/long/path: permission denied"
+	long := strings.Split(stripANSI(m.View().Content), "\n")
+
+	assert.Len(t, long, len(short), "long status changed the view height")
+
+	for _, line := range long {
+		assert.LessOrEqual(t, utf8.RuneCountInString(line), width, "line overflows: %q", line)
+	}
+
+	assert.Contains(t, strings.Join(long, "\n"), "Error removing very-long-binary-nam…")
+}
+
+// Test_truncateWidth verifies truncation by display width.
+func Test_truncateWidth(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		want  string
+	}{
+		{name: "fits", s: "vhs", width: 3, want: "vhs"},
+		{name: "truncated", s: "golangci-lint", width: 8, want: "golangc…"},
+		{name: "wide runes", s: "日本語テキスト", width: 7, want: "日本語…"},
+		{name: "no width", s: "vhs", width: 0, want: "vhs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, truncateWidth(tt.s, tt.width))
+		})
+	}
+}
+
+// updateGolden rewrites the golden files under testdata instead of comparing
+// against them: go test ./internal/cli -run RenderString -update.
+var updateGolden = flag.Bool("update", false, "rewrite golden files")
+
+// TestRenderString verifies RenderString output against golden files, so
+// layout changes show up as reviewable diffs.
+func TestRenderString(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		width  int
+		height int
+	}{
+		{name: "grid", width: 60, height: 12},
+		{name: "list_layout", config: Config{ListLayout: true}, width: 60, height: 12},
+		{name: "dry_run_legend", config: Config{DryRun: true, Goroot: true}, width: 40, height: 18},
+	}
+
+	choices := []string{"vhs", "age", "gopls", "golangci-lint", "staticcheck", "dlv", "mockery"}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel(slices.Clone(choices), "/home/user/go/bin", tt.config, &tuiMockLogger{}, nil)
+
+			got := RenderString(m, tt.width, tt.height)
+			assert.NotContains(t, got, "\x1b", "RenderString() output should not contain escape sequences")
+
+			path := filepath.Join("testdata", "render_"+tt.name+".golden")
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(path, []byte(got), 0o600))
+			}
+
+			want, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), got)
+		})
+	}
+}
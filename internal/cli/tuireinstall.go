@@ -0,0 +1,216 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+)
+
+// reinstalledMsg reports the outcome of reinstalling a binary from the TUI.
+type reinstalledMsg struct {
+	name    string  // Binary that was reinstalled
+	target  string  // go install argument, e.g. "golang.org/x/tools/gopls@v0.16.0"; empty if never built
+	removal Removal // The removal, set once the old binary is gone
+	removed bool    // Whether the old binary was removed before any failure
+	err     error   // Why the reinstall failed; nil on success
+}
+
+// startReinstall prompts for the version to reinstall the binary under the
+// cursor at. App bundles have no Go build info, so they are never offered.
+func (m *model) startReinstall() {
+	name, ok := m.currentChoice()
+	if !ok {
+		return
+	}
+
+	if fs.IsBundle(name) {
+		m.status = "Cannot reinstall app bundle " + name
+
+		return
+	}
+
+	m.reinstallFor = name
+	m.reinstallVersion = ""
+	m.status = ""
+}
+
+// updateVersionInput processes key events while a reinstall version is typed.
+// An invalid version keeps the prompt open with the reason shown beside it.
+func (m *model) updateVersionInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc":
+		m.reinstallFor = ""
+		m.status = "Reinstall cancelled"
+
+	case "backspace":
+		if runes := []rune(m.reinstallVersion); len(runes) > 0 {
+			m.reinstallVersion = string(runes[:len(runes)-1])
+		}
+
+		m.status = ""
+
+	case "enter":
+		if err := ValidateVersion(m.reinstallVersion); err != nil {
+			m.status = err.Error()
+
+			return m, nil
+		}
+
+		name := m.reinstallFor
+		m.reinstallFor = ""
+		m.status = fmt.Sprintf("Reinstalling %s at %s…", name, m.reinstallVersion)
+
+		return m, m.reinstallChoice(name, m.reinstallVersion)
+
+	default:
+		if text := msg.Key().Text; text != "" {
+			m.reinstallVersion += text
+			m.status = ""
+		}
+	}
+
+	return m, nil
+}
+
+// reinstallChoice removes name and installs its package at version in the
+// background, as --reinstall-version does. The package is read from the
+// binary's build info before anything is removed, so a binary that cannot be
+// reinstalled is left in place.
+func (m *model) reinstallChoice(name, version string) tea.Cmd {
+	return func() tea.Msg {
+		binaryPath := m.fs.AdjustBinaryPath(m.dir, name)
+
+		if err := checkAllowed(m.config, name); err != nil {
+			m.auditChoice(name, binaryPath, err)
+
+			return reinstalledMsg{name: name, err: err}
+		}
+
+		extractor, err := m.buildInfoExtractor()
+		if err != nil {
+			return reinstalledMsg{name: name, err: fmt.Errorf("skipping %s: %w", name, err)}
+		}
+
+		info, err := extractor.Extract(context.Background(), binaryPath)
+		if err != nil {
+			return reinstalledMsg{name: name, err: fmt.Errorf("skipping %s: %w", name, ErrNoBuildInfo)}
+		}
+
+		target, err := installTarget(info, version)
+		if err != nil {
+			return reinstalledMsg{name: name, err: fmt.Errorf("skipping %s: %w", name, err)}
+		}
+
+		if m.config.DryRun {
+			m.auditChoice(name, binaryPath, nil)
+
+			return reinstalledMsg{name: name, target: target}
+		}
+
+		checksum := removalChecksum(m.fs, m.logger, m.config, binaryPath)
+		size := removalSize(m.fs, binaryPath)
+
+		err = m.removeChoice(name, binaryPath)
+		m.auditChoice(name, binaryPath, err)
+
+		if err != nil {
+			return reinstalledMsg{name: name, target: target, err: err}
+		}
+
+		removal := newRemoval(name, binaryPath, size, checksum, false)
+
+		output, err := m.installer()(context.Background(), target)
+		if err != nil {
+			return reinstalledMsg{
+				name:    name,
+				target:  target,
+				removal: removal,
+				removed: true,
+				err:     fmt.Errorf("%w for %s: %w: %s", ErrReinstallFailed, target, err, bytes.TrimSpace(output)),
+			}
+		}
+
+		return reinstalledMsg{name: name, target: target, removal: removal, removed: true}
+	}
+}
+
+// handleReinstalled reports a finished reinstall and refreshes the grid. A
+// binary removed before go install failed is listed as removed this session.
+func (m *model) handleReinstalled(msg reinstalledMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.err != nil && msg.removed:
+		m.removals = append(m.removals, msg.removal)
+
+		status := "Error " + msg.err.Error()
+		if m.historyManager != nil {
+			status += "; press u to restore " + msg.name
+		}
+
+		m.status = m.config.Symbols.failed(status)
+	case msg.err != nil:
+		m.status = m.config.Symbols.failed("Error " + msg.err.Error())
+	case m.config.DryRun:
+		m.status = m.config.Symbols.succeeded(fmt.Sprintf("Would remove %s and reinstall %s", msg.name, msg.target))
+
+		return m, nil
+	default:
+		m.status = m.config.Symbols.succeeded("Reinstalled " + msg.target)
+	}
+
+	if !msg.removed {
+		return m, nil
+	}
+
+	delete(m.selected, msg.name)
+
+	m.choices = m.listBinaries()
+	m.sortChoices()
+	m.updateGrid()
+
+	if len(m.choices) == 0 {
+		return m, tea.Quit
+	}
+
+	if m.cellIndex(m.cursorY, m.cursorX) >= len(m.choices) {
+		m.cursorY, m.cursorX = m.cellPosition(len(m.choices) - 1)
+	}
+
+	return m, nil
+}
+
+// buildInfoExtractor returns the model's build info extractor, or a new
+// default one. It runs inside commands, so it never writes to the model.
+func (m *model) buildInfoExtractor() (buildinfo.Extractor, error) {
+	if m.extractor != nil {
+		return m.extractor, nil
+	}
+
+	extractor, err := buildinfo.NewExtractor()
+	if err != nil {
+		return nil, fmt.Errorf("initializing build info extractor: %w", err)
+	}
+
+	return extractor, nil
+}
+
+// installer returns the model's installer, falling back to goInstall.
+func (m *model) installer() Installer {
+	if m.install != nil {
+		return m.install
+	}
+
+	return goInstall
+}
@@ -0,0 +1,112 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/go-remove/internal/buildinfo"
+	mockBuildInfo "github.com/nicholas-fedor/go-remove/internal/buildinfo/mocks"
+	"github.com/nicholas-fedor/go-remove/internal/fs"
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// typeText sends each character of text to m as a key press.
+func typeText(m *model, text string) {
+	for _, r := range text {
+		m.Update(keyPress(r))
+	}
+}
+
+// Test_model_Reinstall verifies R prompts for a version, rejects malformed
+// versions without closing the prompt, and then removes the binary and
+// installs its package at the typed version.
+func Test_model_Reinstall(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t)
+	fsMock.On("AdjustBinaryPath", "/bin", "gopls").Return("/bin/gopls")
+	fsMock.On("BinarySize", "/bin/gopls").Return(int64(1000), nil)
+	fsMock.On("RemoveBinary", "/bin/gopls", "gopls", false, mock.Anything).Return(nil)
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"gopls", "vhs"})
+
+	extractor := mockBuildInfo.NewMockExtractor(t)
+	extractor.On("Extract", mock.Anything, "/bin/gopls").
+		Return(&buildinfo.BuildInfoData{PackagePath: "golang.org/x/tools/gopls", ModulePath: "golang.org/x/tools/gopls"}, nil)
+
+	var installed string
+
+	m := newModel([]string{"gopls", "vhs"}, "/bin", Config{}, &tuiMockLogger{}, fsMock, nil)
+	m.extractor = extractor
+	m.install = func(_ context.Context, target string) ([]byte, error) {
+		installed = target
+
+		return nil, nil
+	}
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	m.Update(keyPress('R'))
+	require.Equal(t, "gopls", m.reinstallFor)
+
+	typeText(m, "latest")
+	_, cmd := m.Update(keyPressString(keyEnter))
+	assert.Nil(t, cmd)
+	assert.Equal(t, "gopls", m.reinstallFor, "an invalid version keeps the prompt open")
+	assert.Contains(t, stripANSI(m.View().Content), "Reinstall gopls at version: latest_  (version must look like v1.2.3")
+
+	for range "latest" {
+		m.Update(tea.KeyPressMsg{Code: tea.KeyBackspace})
+	}
+
+	typeText(m, "v0.16.0")
+	_, cmd = m.Update(keyPressString(keyEnter))
+	require.NotNil(t, cmd)
+	assert.Empty(t, m.reinstallFor)
+
+	m.Update(cmd())
+
+	assert.Equal(t, "golang.org/x/tools/gopls@v0.16.0", installed)
+	assert.Equal(t, "Reinstalled golang.org/x/tools/gopls@v0.16.0", m.status)
+	assert.Empty(t, m.removals)
+}
+
+// Test_model_Reinstall_InstallFails verifies a binary removed before go
+// install failed is recorded as removed and leaves the grid.
+func Test_model_Reinstall_InstallFails(t *testing.T) {
+	fsMock := mockFS.NewMockFS(t)
+	fsMock.On("AdjustBinaryPath", "/bin", "gopls").Return("/bin/gopls")
+	fsMock.On("BinarySize", "/bin/gopls").Return(int64(1000), nil)
+	fsMock.On("RemoveBinary", "/bin/gopls", "gopls", false, mock.Anything).Return(nil)
+	fsMock.On("ListBinaries", "/bin", fs.ListOptions{}).Return([]string{"vhs"})
+
+	extractor := mockBuildInfo.NewMockExtractor(t)
+	extractor.On("Extract", mock.Anything, "/bin/gopls").
+		Return(&buildinfo.BuildInfoData{ModulePath: "golang.org/x/tools/gopls"}, nil)
+
+	m := newModel([]string{"gopls", "vhs"}, "/bin", Config{}, &tuiMockLogger{}, fsMock, nil)
+	m.extractor = extractor
+	m.install = func(context.Context, string) ([]byte, error) {
+		return []byte("no matching versions"), errors.New("exit status 1")
+	}
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	m.Update(keyPress('R'))
+	typeText(m, "v9.9.9")
+	_, cmd := m.Update(keyPressString(keyEnter))
+	require.NotNil(t, cmd)
+
+	m.Update(cmd())
+
+	assert.Contains(t, m.status, ErrReinstallFailed.Error())
+	assert.Equal(t, []string{"vhs"}, m.choices)
+	require.Len(t, m.removals, 1)
+	assert.Equal(t, "gopls", m.removals[0].Name)
+}
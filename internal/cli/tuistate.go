@@ -0,0 +1,59 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"github.com/nicholas-fedor/go-remove/internal/userconfig"
+)
+
+// loadTUIState applies the view remembered in config.StateFile to m. A
+// missing file keeps the default view, and an unreadable one is logged and
+// ignored, so a bad state file never keeps the TUI from opening. Toggles
+// turned on from the command line stay on.
+func (m *model) loadTUIState() {
+	if m.config.StateFile == "" {
+		return
+	}
+
+	state, err := userconfig.LoadState(m.config.StateFile)
+	if err != nil {
+		m.logger.Warn().Err(err).Msg("Ignoring saved TUI state")
+
+		return
+	}
+
+	m.sortAscending = !state.SortDescending
+	m.showHidden = m.showHidden || state.ShowHidden
+	m.showRemoved = state.ShowRemoved
+	m.showKey = state.ShowKey
+	m.singleColumn = m.singleColumn || state.SingleColumn
+
+	// The log panel brings verbose logging with it, as the L key does.
+	if state.ShowLogs && !m.showLogs {
+		m.toggleVerboseLogging()
+	}
+}
+
+// saveTUIState remembers m's view in config.StateFile for the next session.
+// A failure to save is logged and does not fail the session.
+func (m *model) saveTUIState() {
+	if m.config.StateFile == "" {
+		return
+	}
+
+	state := userconfig.TUIState{
+		SortDescending: !m.sortAscending,
+		ShowLogs:       m.showLogs,
+		ShowHidden:     m.showHidden,
+		ShowRemoved:    m.showRemoved,
+		ShowKey:        m.showKey,
+		SingleColumn:   m.singleColumn,
+	}
+
+	if err := userconfig.SaveState(m.config.StateFile, state); err != nil {
+		m.logger.Warn().Err(err).Msg("Failed to save TUI state")
+	}
+}
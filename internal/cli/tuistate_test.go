@@ -0,0 +1,52 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mockFS "github.com/nicholas-fedor/go-remove/internal/fs/mocks"
+)
+
+// Test_model_loadTUIState_Missing verifies the TUI opens with its default view
+// when no state has been saved yet.
+func Test_model_loadTUIState_Missing(t *testing.T) {
+	config := Config{StateFile: filepath.Join(t.TempDir(), "tui-state.yaml")}
+
+	m := newModel(nil, "/bin", config, &tuiMockLogger{}, mockFS.NewMockFS(t), nil)
+	m.loadTUIState()
+
+	assert.True(t, m.sortAscending)
+	assert.False(t, m.showLogs)
+	assert.False(t, m.showKey)
+	assert.False(t, m.singleColumn)
+}
+
+// Test_model_saveTUIState_RoundTrip verifies the view one session leaves is
+// the view the next session opens with, log panel and verbose logging included.
+func Test_model_saveTUIState_RoundTrip(t *testing.T) {
+	config := Config{StateFile: filepath.Join(t.TempDir(), "go-remove", "tui-state.yaml")}
+
+	first := newModel(nil, "/bin", config, &tuiMockLogger{}, mockFS.NewMockFS(t), nil)
+	first.sortAscending = false
+	first.showKey = true
+	first.singleColumn = true
+	first.toggleVerboseLogging()
+	first.saveTUIState()
+
+	second := newModel(nil, "/bin", config, &tuiMockLogger{}, mockFS.NewMockFS(t), nil)
+	second.loadTUIState()
+
+	assert.False(t, second.sortAscending)
+	assert.True(t, second.showKey)
+	assert.True(t, second.singleColumn)
+	assert.True(t, second.showLogs)
+	assert.True(t, second.config.Verbose)
+	assert.False(t, second.showRemoved)
+}
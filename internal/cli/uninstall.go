@@ -0,0 +1,55 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import (
+	"path"
+	"strings"
+)
+
+// BinaryNameFromPackage reduces a go install-style argument to the binary name
+// that go install would have produced.
+//
+// The "@version" suffix is dropped and the last path element is used, except
+// that a trailing major version element ("v2", "v3", ...) is skipped in favor
+// of the element before it, matching go install's naming. Plain binary names
+// are returned unchanged.
+//
+// Examples:
+//   - "github.com/foo/bar/cmd/baz@v1.2.3" -> "baz"
+//   - "github.com/foo/bar/v2@latest" -> "bar"
+//   - "vhs" -> "vhs"
+func BinaryNameFromPackage(pkg string) string {
+	// Drop any version query such as @latest or @v1.2.3.
+	if at := strings.LastIndex(pkg, "@"); at >= 0 {
+		pkg = pkg[:at]
+	}
+
+	pkg = strings.TrimSuffix(pkg, "/")
+
+	name := path.Base(pkg)
+	if name != pkg && isMajorVersionElement(name) {
+		name = path.Base(path.Dir(pkg))
+	}
+
+	return name
+}
+
+// isMajorVersionElement reports whether elem is a module major version suffix
+// such as "v2" or "v10". "v0" and "v1" never appear as path suffixes.
+func isMajorVersionElement(elem string) bool {
+	if len(elem) < 2 || elem[0] != 'v' || elem[1] == '0' || (elem[1] == '1' && len(elem) == 2) {
+		return false
+	}
+
+	for _, r := range elem[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
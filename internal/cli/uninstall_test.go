@@ -0,0 +1,37 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package cli
+
+import "testing"
+
+// TestBinaryNameFromPackage verifies module paths reduce to the names go install produces.
+func TestBinaryNameFromPackage(t *testing.T) {
+	tests := []struct {
+		pkg  string
+		want string
+	}{
+		{pkg: "github.com/foo/bar/cmd/baz@v1.2.3", want: "baz"},
+		{pkg: "github.com/foo/bar/cmd/baz@latest", want: "baz"},
+		{pkg: "github.com/foo/bar/cmd/baz", want: "baz"},
+		{pkg: "github.com/charmbracelet/vhs@v0.9.0", want: "vhs"},
+		{pkg: "github.com/foo/bar/v2@v2.0.1", want: "bar"},
+		{pkg: "github.com/foo/bar/v10", want: "bar"},
+		{pkg: "github.com/foo/bar/v1", want: "v1"},
+		{pkg: "github.com/foo/bar/v2beta", want: "v2beta"},
+		{pkg: "golang.org/x/tools/gopls@master", want: "gopls"},
+		{pkg: "github.com/foo/bar/cmd/baz/", want: "baz"},
+		{pkg: "vhs", want: "vhs"},
+		{pkg: "v2", want: "v2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pkg, func(t *testing.T) {
+			if got := BinaryNameFromPackage(tt.pkg); got != tt.want {
+				t.Errorf("BinaryNameFromPackage(%q) = %q, want %q", tt.pkg, got, tt.want)
+			}
+		})
+	}
+}
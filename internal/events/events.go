@@ -0,0 +1,110 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+// Package events streams go-remove progress to external processes.
+//
+// Events are written as newline-delimited JSON objects, one per line, so a
+// listener can decode them incrementally as a run progresses. A session emits
+// a start event, one removal event per binary attempted, and a summary event.
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// Event types emitted during a removal session.
+const (
+	TypeStart   = "start"   // Session began; Names lists the targets
+	TypeRemoval = "removal" // A single binary was removed, or failed to be
+	TypeSummary = "summary" // Session finished; Count, Failed, and Freed total the session
+)
+
+// Event describes one step of a removal session.
+type Event struct {
+	Type   string    `json:"type"`
+	Time   time.Time `json:"time"`
+	DryRun bool      `json:"dryRun,omitempty"`
+	Names  []string  `json:"names,omitempty"`
+	Name   string    `json:"name,omitempty"`
+	Path   string    `json:"path,omitempty"`
+	Size   int64     `json:"size,omitempty"`
+	Error  string    `json:"error,omitempty"`
+	Count  int       `json:"count,omitempty"`
+	Failed int       `json:"failed,omitempty"`
+	Freed  int64     `json:"freed,omitempty"`
+}
+
+// Emitter publishes events to a listener.
+type Emitter interface {
+	Emit(event Event) error
+	Close() error
+}
+
+// Nop is an Emitter that discards every event.
+type Nop struct{}
+
+// Emit discards event.
+func (Nop) Emit(Event) error { return nil }
+
+// Close does nothing.
+func (Nop) Close() error { return nil }
+
+// ConnEmitter writes events as JSON lines to a connection.
+type ConnEmitter struct {
+	mu      sync.Mutex
+	conn    net.Conn
+	encoder *json.Encoder
+}
+
+// NewConnEmitter creates an emitter that writes to conn and closes it on Close.
+func NewConnEmitter(conn net.Conn) *ConnEmitter {
+	return &ConnEmitter{conn: conn, encoder: json.NewEncoder(conn)}
+}
+
+// Dial connects to the Unix domain socket at path and returns an emitter for it.
+//
+// Parameters:
+//   - path: Filesystem path of a socket a listener is accepting on
+//
+// Returns:
+//   - An emitter writing to the socket
+//   - An error if the socket cannot be reached
+func Dial(path string) (*ConnEmitter, error) {
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to event socket %s: %w", path, err)
+	}
+
+	return NewConnEmitter(conn), nil
+}
+
+// Emit stamps event with the current time if unset and writes it as one JSON line.
+func (e *ConnEmitter) Emit(event Event) error {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if err := e.encoder.Encode(event); err != nil {
+		return fmt.Errorf("failed to emit %s event: %w", event.Type, err)
+	}
+
+	return nil
+}
+
+// Close closes the underlying connection.
+func (e *ConnEmitter) Close() error {
+	if err := e.conn.Close(); err != nil {
+		return fmt.Errorf("failed to close event socket: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// TestConnEmitter verifies events are written as one JSON object per line.
+func TestConnEmitter(t *testing.T) {
+	client, server := net.Pipe()
+	emitter := NewConnEmitter(client)
+
+	sent := []Event{
+		{Type: TypeStart, Names: []string{"age", "vhs"}},
+		{Type: TypeRemoval, Name: "age", Path: "/bin/age", Size: 1500},
+		{Type: TypeSummary, Count: 1, Freed: 1500, Time: time.Unix(0, 0).UTC()},
+	}
+
+	// net.Pipe is unbuffered, so writes block until the reader consumes them.
+	errc := make(chan error, 1)
+
+	go func() {
+		for _, event := range sent {
+			if err := emitter.Emit(event); err != nil {
+				errc <- err
+
+				return
+			}
+		}
+
+		errc <- emitter.Close()
+	}()
+
+	var got []Event
+
+	scanner := bufio.NewScanner(server)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("invalid event line %q: %v", scanner.Text(), err)
+		}
+
+		got = append(got, event)
+	}
+
+	if err := <-errc; err != nil {
+		t.Fatalf("Emit() error = %v", err)
+	}
+
+	if len(got) != len(sent) {
+		t.Fatalf("received %d events, want %d", len(got), len(sent))
+	}
+
+	for i, event := range got {
+		if event.Type != sent[i].Type || event.Name != sent[i].Name || event.Count != sent[i].Count {
+			t.Errorf("event %d = %+v, want %+v", i, event, sent[i])
+		}
+
+		if event.Time.IsZero() {
+			t.Errorf("event %d has no timestamp", i)
+		}
+	}
+
+	if !got[2].Time.Equal(time.Unix(0, 0)) {
+		t.Errorf("explicit timestamp overwritten: %v", got[2].Time)
+	}
+}
+
+// TestConnEmitter_ClosedListener verifies Emit reports a listener that went away.
+func TestConnEmitter_ClosedListener(t *testing.T) {
+	client, server := net.Pipe()
+	server.Close()
+
+	emitter := NewConnEmitter(client)
+	defer emitter.Close()
+
+	if err := emitter.Emit(Event{Type: TypeStart}); !errors.Is(err, io.ErrClosedPipe) {
+		t.Errorf("Emit() error = %v, want %v", err, io.ErrClosedPipe)
+	}
+}
+
+// TestDial verifies Dial connects to a listening Unix socket and fails without one.
+func TestDial(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Unix domain sockets are not available on all Windows versions")
+	}
+
+	// Socket paths are limited to about 100 bytes, which t.TempDir can exceed on macOS.
+	dir, err := os.MkdirTemp("", "events")
+	if err != nil {
+		t.Fatalf("failed to create socket directory: %v", err)
+	}
+
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "events.sock")
+
+	if _, err := Dial(path); err == nil {
+		t.Fatal("Dial() succeeded without a listener")
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatalf("failed to listen on %s: %v", path, err)
+	}
+	defer listener.Close()
+
+	emitter, err := Dial(path)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+
+	if err := emitter.Close(); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+}
+
+// TestNop verifies the no-op emitter accepts events without error.
+func TestNop(t *testing.T) {
+	var emitter Emitter = Nop{}
+
+	if err := emitter.Emit(Event{Type: TypeStart}); err != nil {
+		t.Errorf("Emit() error = %v", err)
+	}
+
+	if err := emitter.Close(); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+// Package format renders sizes, durations, ages, and timestamps for display so
+// that every part of go-remove reports them in the same units.
+package format
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Decimal byte size units, used for display by default.
+const (
+	KB = 1000      // Bytes in a kilobyte
+	MB = KB * 1000 // Bytes in a megabyte
+	GB = MB * 1000 // Bytes in a gigabyte
+	TB = GB * 1000 // Bytes in a terabyte
+)
+
+// Binary byte size units.
+const (
+	KiB = 1024       // Bytes in a kibibyte
+	MiB = KiB * 1024 // Bytes in a mebibyte
+	GiB = MiB * 1024 // Bytes in a gibibyte
+	TiB = GiB * 1024 // Bytes in a tebibyte
+)
+
+// DurationPrecision is the rounding applied by Duration.
+const DurationPrecision = time.Microsecond
+
+// Age thresholds; months and years are approximate.
+const (
+	day   = 24 * time.Hour
+	month = 30 * day
+	year  = 365 * day
+)
+
+// Timestamp format shortcuts accepted by Time in place of a Go layout.
+const (
+	TimeRelative = "relative" // Coarse relative time, e.g. "3 days ago"
+	TimeRFC3339  = "rfc3339"  // RFC 3339, e.g. "2026-03-10T12:00:00Z"
+	TimeUnix     = "unix"     // Seconds since the Unix epoch, e.g. "1773144000"
+)
+
+// ErrInvalidTimeFormat indicates a timestamp format that is neither a shortcut
+// nor a Go layout such as "2006-01-02 15:04".
+var ErrInvalidTimeFormat = errors.New("time format must be relative, rfc3339, unix, or a Go layout such as 2006-01-02")
+
+// layoutProbe is a time whose every field differs from the Go reference time,
+// so formatting it changes any layout that contains a layout element.
+var layoutProbe = time.Date(1999, time.December, 31, 23, 58, 59, 0, time.UTC)
+
+// unit pairs a size in bytes with its display suffix.
+type unit struct {
+	size   float64
+	suffix string
+}
+
+// Units from largest to smallest, as used by Bytes and IECBytes.
+var (
+	decimalUnits = []unit{{TB, "TB"}, {GB, "GB"}, {MB, "MB"}, {KB, "kB"}}
+	binaryUnits  = []unit{{TiB, "TiB"}, {GiB, "GiB"}, {MiB, "MiB"}, {KiB, "KiB"}}
+)
+
+// Bytes renders a byte count using decimal units, e.g. "1.5 kB" or "210.0 MB".
+// Counts under a kilobyte are printed exactly; negative counts keep their sign.
+func Bytes(size int64) string {
+	return bytes(size, decimalUnits)
+}
+
+// IECBytes renders a byte count using binary units, e.g. "1.5 KiB" or "200.3 MiB".
+func IECBytes(size int64) string {
+	return bytes(size, binaryUnits)
+}
+
+// bytes renders size using the largest of units it reaches.
+func bytes(size int64, units []unit) string {
+	sign := ""
+	magnitude := float64(size)
+
+	if size < 0 {
+		sign = "-"
+		magnitude = -magnitude
+	}
+
+	for _, u := range units {
+		if magnitude >= u.size {
+			return fmt.Sprintf("%s%.1f %s", sign, magnitude/u.size, u.suffix)
+		}
+	}
+
+	return fmt.Sprintf("%d B", size)
+}
+
+// Duration renders an elapsed time rounded to DurationPrecision, e.g. "1.234ms".
+func Duration(elapsed time.Duration) string {
+	return elapsed.Round(DurationPrecision).String()
+}
+
+// Age renders the time since then as a coarse relative time, e.g. "3 days ago".
+func Age(then time.Time) string {
+	return Ago(time.Since(then))
+}
+
+// Ago renders an age as a coarse relative time, e.g. "3 days ago".
+// Ages under a minute, including negative ones from clock skew, are "just now".
+func Ago(age time.Duration) string {
+	switch {
+	case age < time.Minute:
+		return "just now"
+	case age < time.Hour:
+		return plural(int64(age/time.Minute), "minute")
+	case age < day:
+		return plural(int64(age/time.Hour), "hour")
+	case age < month:
+		return plural(int64(age/day), "day")
+	case age < year:
+		return plural(int64(age/month), "month")
+	default:
+		return plural(int64(age/year), "year")
+	}
+}
+
+// ValidateTimeFormat reports whether timeFormat is a shortcut or a Go layout.
+// A layout without any layout element, such as "yesterday", is rejected, since
+// it would print the same text for every time.
+func ValidateTimeFormat(timeFormat string) error {
+	switch timeFormat {
+	case TimeRelative, TimeRFC3339, TimeUnix:
+		return nil
+	}
+
+	if layoutProbe.Format(timeFormat) == timeFormat {
+		return fmt.Errorf("%w: %q", ErrInvalidTimeFormat, timeFormat)
+	}
+
+	return nil
+}
+
+// Time renders t in timeFormat, a shortcut or Go layout already checked with
+// ValidateTimeFormat. Relative times are measured back from now.
+func Time(t, now time.Time, timeFormat string) string {
+	switch timeFormat {
+	case TimeRelative:
+		return Ago(now.Sub(t))
+	case TimeRFC3339:
+		return t.Format(time.RFC3339)
+	case TimeUnix:
+		return strconv.FormatInt(t.Unix(), 10)
+	default:
+		return t.Format(timeFormat)
+	}
+}
+
+// plural renders "1 day ago" or "N days ago" for the given unit.
+func plural(count int64, unit string) string {
+	if count != 1 {
+		unit += "s"
+	}
+
+	return fmt.Sprintf("%d %s ago", count, unit)
+}
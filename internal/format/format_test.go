@@ -0,0 +1,160 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package format
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// TestBytes verifies byte counts are rendered with decimal units.
+func TestBytes(t *testing.T) {
+	tests := []struct {
+		size int64
+		want string
+	}{
+		{size: 0, want: "0 B"},
+		{size: 999, want: "999 B"},
+		{size: 1000, want: "1.0 kB"},
+		{size: 1500, want: "1.5 kB"},
+		{size: 210_000_000, want: "210.0 MB"},
+		{size: 1_200_000_000, want: "1.2 GB"},
+		{size: 3_000_000_000_000, want: "3.0 TB"},
+		{size: -999, want: "-999 B"},
+		{size: -1500, want: "-1.5 kB"},
+		{size: math.MaxInt64, want: "9223372.0 TB"},
+		{size: math.MinInt64, want: "-9223372.0 TB"},
+	}
+
+	for _, tt := range tests {
+		if got := Bytes(tt.size); got != tt.want {
+			t.Errorf("Bytes(%d) = %q, want %q", tt.size, got, tt.want)
+		}
+	}
+}
+
+// TestIECBytes verifies byte counts are rendered with binary units.
+func TestIECBytes(t *testing.T) {
+	tests := []struct {
+		size int64
+		want string
+	}{
+		{size: 0, want: "0 B"},
+		{size: 1023, want: "1023 B"},
+		{size: KiB, want: "1.0 KiB"},
+		{size: 1536, want: "1.5 KiB"},
+		{size: 5 * MiB, want: "5.0 MiB"},
+		{size: 2 * GiB, want: "2.0 GiB"},
+		{size: TiB, want: "1.0 TiB"},
+		{size: -MiB, want: "-1.0 MiB"},
+		{size: math.MaxInt64, want: "8388608.0 TiB"},
+	}
+
+	for _, tt := range tests {
+		if got := IECBytes(tt.size); got != tt.want {
+			t.Errorf("IECBytes(%d) = %q, want %q", tt.size, got, tt.want)
+		}
+	}
+}
+
+// TestDuration verifies durations are rounded to DurationPrecision.
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		elapsed time.Duration
+		want    string
+	}{
+		{elapsed: 0, want: "0s"},
+		{elapsed: 400 * time.Nanosecond, want: "0s"},
+		{elapsed: 1234567 * time.Nanosecond, want: "1.235ms"},
+		{elapsed: 90 * time.Second, want: "1m30s"},
+		{elapsed: -2 * time.Millisecond, want: "-2ms"},
+	}
+
+	for _, tt := range tests {
+		if got := Duration(tt.elapsed); got != tt.want {
+			t.Errorf("Duration(%d) = %q, want %q", tt.elapsed, got, tt.want)
+		}
+	}
+}
+
+// TestAgo verifies the unit boundaries of relative times.
+func TestAgo(t *testing.T) {
+	tests := []struct {
+		age  time.Duration
+		want string
+	}{
+		{age: -time.Minute, want: "just now"},
+		{age: 59 * time.Second, want: "just now"},
+		{age: time.Minute, want: "1 minute ago"},
+		{age: 59 * time.Minute, want: "59 minutes ago"},
+		{age: time.Hour, want: "1 hour ago"},
+		{age: 23 * time.Hour, want: "23 hours ago"},
+		{age: day, want: "1 day ago"},
+		{age: 29 * day, want: "29 days ago"},
+		{age: 30 * day, want: "1 month ago"},
+		{age: 364 * day, want: "12 months ago"},
+		{age: 365 * day, want: "1 year ago"},
+		{age: 3 * 365 * day, want: "3 years ago"},
+		{age: math.MaxInt64, want: "292 years ago"},
+	}
+
+	for _, tt := range tests {
+		if got := Ago(tt.age); got != tt.want {
+			t.Errorf("Ago(%s) = %q, want %q", tt.age, got, tt.want)
+		}
+	}
+}
+
+// TestAge verifies ages are measured from the current time.
+func TestAge(t *testing.T) {
+	if got, want := Age(time.Now().Add(-49*time.Hour)), "2 days ago"; got != want {
+		t.Errorf("Age() = %q, want %q", got, want)
+	}
+
+	if got, want := Age(time.Now().Add(time.Hour)), "just now"; got != want {
+		t.Errorf("Age() of a future time = %q, want %q", got, want)
+	}
+}
+
+// TestValidateTimeFormat verifies shortcuts and Go layouts are accepted and
+// text without layout elements is rejected.
+func TestValidateTimeFormat(t *testing.T) {
+	for _, timeFormat := range []string{TimeRelative, TimeRFC3339, TimeUnix, "2006-01-02 15:04", time.Kitchen} {
+		if err := ValidateTimeFormat(timeFormat); err != nil {
+			t.Errorf("ValidateTimeFormat(%q) error = %v", timeFormat, err)
+		}
+	}
+
+	for _, timeFormat := range []string{"", "yesterday", "now"} {
+		if err := ValidateTimeFormat(timeFormat); !errors.Is(err, ErrInvalidTimeFormat) {
+			t.Errorf("ValidateTimeFormat(%q) error = %v, want %v", timeFormat, err, ErrInvalidTimeFormat)
+		}
+	}
+}
+
+// TestTime verifies each shortcut and a custom layout.
+func TestTime(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	modified := now.Add(-72 * time.Hour)
+
+	tests := []struct {
+		timeFormat string
+		want       string
+	}{
+		{timeFormat: TimeRelative, want: "3 days ago"},
+		{timeFormat: TimeRFC3339, want: "2026-03-07T12:00:00Z"},
+		{timeFormat: TimeUnix, want: "1772884800"},
+		{timeFormat: "2006-01-02 15:04", want: "2026-03-07 12:00"},
+	}
+
+	for _, tt := range tests {
+		if got := Time(modified, now, tt.timeFormat); got != tt.want {
+			t.Errorf("Time(%q) = %q, want %q", tt.timeFormat, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package fs
+
+import (
+	"bytes"
+	"debug/buildinfo"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Reasons CorruptReason gives for a file that is likely corrupt.
+const (
+	CorruptEmpty     = "empty file"               // Zero bytes, as left by an interrupted install
+	CorruptNoHeader  = "no executable header"     // Not an executable format or a script
+	CorruptMalformed = "malformed executable"     // An executable header whose file does not parse, e.g. truncated
+	CorruptBuildInfo = "unreadable Go build info" // A Go binary whose build info cannot be read
+)
+
+// errNotGoExecutable is the message debug/buildinfo reports for a valid
+// executable that simply was not built by Go.
+const errNotGoExecutable = "not a Go executable"
+
+// CorruptReason reports why the file at path is likely corrupt, such as a
+// zero-byte or truncated binary left by a failed install, or "" if it looks
+// intact. Scripts and directories are never reported.
+func (r *RealFS) CorruptReason(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("%w: %s", ErrBinaryNotFound, path)
+		}
+
+		return "", fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+
+	if info.IsDir() {
+		return "", nil
+	}
+
+	if info.Size() == 0 {
+		return CorruptEmpty, nil
+	}
+
+	header, err := readHeader(path)
+	if err != nil {
+		return "", err
+	}
+
+	if bytes.HasPrefix(header, shebang) {
+		return "", nil
+	}
+
+	if _, err := describeHeader(header); errors.Is(err, ErrUnknownFormat) {
+		return CorruptNoHeader, nil
+	}
+
+	if err := parseExecutable(path, header); err != nil {
+		return CorruptMalformed, nil //nolint:nilerr // A parse failure is the finding, not an error
+	}
+
+	if _, err := buildinfo.ReadFile(path); err != nil && !strings.Contains(err.Error(), errNotGoExecutable) {
+		return CorruptBuildInfo, nil
+	}
+
+	return "", nil
+}
+
+// parseExecutable parses the whole header and section table of the ELF, PE,
+// or Mach-O file at path, failing for files cut short after their magic.
+func parseExecutable(path string, header []byte) error {
+	var closer interface{ Close() error }
+
+	var err error
+
+	switch {
+	case bytes.HasPrefix(header, elfMagic):
+		closer, err = elf.Open(path)
+	case bytes.HasPrefix(header, peMagic):
+		closer, err = pe.Open(path)
+	case binary.BigEndian.Uint32(header) == machoFatMagic:
+		closer, err = macho.OpenFat(path)
+	default:
+		closer, err = macho.Open(path)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return closer.Close()
+}
@@ -0,0 +1,73 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package fs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestRealFS_CorruptReason verifies empty, headerless, and truncated files
+// are reported while intact binaries, scripts, and directories are not.
+func TestRealFS_CorruptReason(t *testing.T) {
+	r := &RealFS{}
+
+	self, err := os.Executable()
+	if err != nil {
+		t.Skipf("test executable unavailable: %v", err)
+	}
+
+	contents, err := os.ReadFile(self)
+	if err != nil {
+		t.Fatalf("failed to read test executable: %v", err)
+	}
+
+	dir := t.TempDir()
+
+	files := map[string][]byte{
+		"empty":     {},
+		"text":      []byte("not a program\n"),
+		"script":    []byte("#!/bin/sh\necho hi\n"),
+		"truncated": contents[:len(contents)/2],
+	}
+
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o755); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: self, want: ""},
+		{path: dir, want: ""},
+		{path: filepath.Join(dir, "script"), want: ""},
+		{path: filepath.Join(dir, "empty"), want: CorruptEmpty},
+		{path: filepath.Join(dir, "text"), want: CorruptNoHeader},
+		{path: filepath.Join(dir, "truncated"), want: CorruptMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(filepath.Base(tt.path), func(t *testing.T) {
+			got, err := r.CorruptReason(tt.path)
+			if err != nil {
+				t.Fatalf("CorruptReason() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("CorruptReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := r.CorruptReason(filepath.Join(dir, "missing")); !errors.Is(err, ErrBinaryNotFound) {
+		t.Errorf("CorruptReason() error = %v, want %v", err, ErrBinaryNotFound)
+	}
+}
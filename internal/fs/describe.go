@@ -0,0 +1,231 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package fs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// describeHeaderSize is how much of a file DescribeBinary reads; it covers the
+// ELF and Mach-O headers and the PE header of any Go-built executable.
+const describeHeaderSize = 4096
+
+// Offsets of the fields DescribeBinary reads from each format's header.
+const (
+	elfClassOffset   = 4    // EI_CLASS: 1 for 32-bit, 2 for 64-bit
+	elfDataOffset    = 5    // EI_DATA: 1 for little-endian, 2 for big-endian
+	elfMachineOffset = 18   // e_machine
+	elfHeaderMinSize = 20   // Bytes needed to read e_machine
+	peOffsetField    = 0x3c // e_lfanew: offset of the PE signature
+	peMagicOffset    = 24   // Optional header magic, relative to the PE signature
+	machoCPUOffset   = 4    // cputype
+	machoHeaderSize  = 8    // Bytes needed to read cputype
+	fatArchCountMax  = 32   // Larger counts in a 0xcafebabe file are Java class versions, not architectures
+)
+
+// Header magic numbers.
+var (
+	elfMagic    = []byte("\x7fELF")
+	peMagic     = []byte("MZ")
+	peSignature = []byte("PE\x00\x00")
+	shebang     = []byte("#!")
+)
+
+// Mach-O magic numbers, as read big-endian from the start of the file.
+const (
+	machoMagic32  = 0xfeedface // 32-bit, big-endian
+	machoMagic64  = 0xfeedfacf // 64-bit, big-endian
+	machoCigam32  = 0xcefaedfe // 32-bit, little-endian
+	machoCigam64  = 0xcffaedfe // 64-bit, little-endian
+	machoFatMagic = 0xcafebabe // Universal binary
+)
+
+// Header field values DescribeBinary distinguishes.
+const (
+	elfClass64     = 2          // EI_CLASS of 64-bit files
+	elfBigEndian   = 2          // EI_DATA of big-endian files
+	pe32PlusMagic  = 0x20b      // Optional header magic of 64-bit PE files
+	machoArch64Bit = 0x01000000 // CPU_ARCH_ABI64 flag in a Mach-O cputype
+)
+
+// ErrUnknownFormat indicates a file is not an executable format DescribeBinary recognizes.
+var ErrUnknownFormat = errors.New("not a recognized executable format")
+
+// Architecture names, by the machine field of each format, spelled as GOARCH.
+var (
+	elfMachines = map[uint16]string{
+		0x03:  "386",
+		0x08:  "mips",
+		0x14:  "ppc",
+		0x15:  "ppc64",
+		0x16:  "s390x",
+		0x28:  "arm",
+		0x3e:  "amd64",
+		0xb7:  "arm64",
+		0xf3:  "riscv64",
+		0x102: "loong64",
+	}
+	peMachines = map[uint16]string{
+		0x14c:  "386",
+		0x1c4:  "arm",
+		0x8664: "amd64",
+		0xaa64: "arm64",
+	}
+	machoCPUs = map[uint32]string{
+		7:                   "386",
+		7 | machoArch64Bit:  "amd64",
+		12:                  "arm",
+		12 | machoArch64Bit: "arm64",
+		18:                  "ppc",
+		18 | machoArch64Bit: "ppc64",
+	}
+)
+
+// DescribeBinary identifies the executable format and architecture of the file
+// at path from its magic bytes, e.g. "ELF 64-bit executable, amd64".
+// Scripts are described by their interpreter line.
+func (r *RealFS) DescribeBinary(path string) (string, error) {
+	header, err := readHeader(path)
+	if err != nil {
+		return "", err
+	}
+
+	description, err := describeHeader(header)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", err, path)
+	}
+
+	return description, nil
+}
+
+// readHeader reads up to describeHeaderSize leading bytes of the file at path.
+func readHeader(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s", ErrBinaryNotFound, path)
+		}
+
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	header := make([]byte, describeHeaderSize)
+
+	n, err := io.ReadFull(file, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return header[:n], nil
+}
+
+// describeHeader identifies the format of a file from its leading bytes.
+func describeHeader(header []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(header, elfMagic):
+		return describeELF(header), nil
+	case bytes.HasPrefix(header, peMagic):
+		return describePE(header), nil
+	case bytes.HasPrefix(header, shebang):
+		line, _, _ := bytes.Cut(header, []byte("\n"))
+
+		return "script (" + strings.TrimSpace(string(line)) + ")", nil
+	}
+
+	if len(header) >= machoHeaderSize {
+		if description, ok := describeMachO(header); ok {
+			return description, nil
+		}
+	}
+
+	return "", ErrUnknownFormat
+}
+
+// describeELF describes an ELF header's word size and machine.
+func describeELF(header []byte) string {
+	if len(header) < elfHeaderMinSize {
+		return "ELF executable"
+	}
+
+	bits := "32-bit"
+	if header[elfClassOffset] == elfClass64 {
+		bits = "64-bit"
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if header[elfDataOffset] == elfBigEndian {
+		order = binary.BigEndian
+	}
+
+	return withArch("ELF "+bits+" executable", elfMachines[order.Uint16(header[elfMachineOffset:])])
+}
+
+// describePE describes a PE header's word size and machine. Files whose PE
+// header lies beyond the bytes read are reported as a bare DOS/PE executable.
+func describePE(header []byte) string {
+	if len(header) < peOffsetField+4 {
+		return "PE executable"
+	}
+
+	offset := int(binary.LittleEndian.Uint32(header[peOffsetField:]))
+	if offset < 0 || offset+peMagicOffset+2 > len(header) || !bytes.Equal(header[offset:offset+4], peSignature) {
+		return "PE executable"
+	}
+
+	kind := "PE32 executable"
+	if binary.LittleEndian.Uint16(header[offset+peMagicOffset:]) == pe32PlusMagic {
+		kind = "PE32+ executable"
+	}
+
+	return withArch(kind, peMachines[binary.LittleEndian.Uint16(header[offset+4:])])
+}
+
+// describeMachO describes a Mach-O or universal binary header, reporting
+// false if header is neither.
+func describeMachO(header []byte) (string, bool) {
+	var (
+		order binary.ByteOrder
+		bits  string
+	)
+
+	switch binary.BigEndian.Uint32(header) {
+	case machoMagic32:
+		order, bits = binary.BigEndian, "32-bit"
+	case machoMagic64:
+		order, bits = binary.BigEndian, "64-bit"
+	case machoCigam32:
+		order, bits = binary.LittleEndian, "32-bit"
+	case machoCigam64:
+		order, bits = binary.LittleEndian, "64-bit"
+	case machoFatMagic:
+		count := binary.BigEndian.Uint32(header[machoCPUOffset:])
+		if count == 0 || count > fatArchCountMax {
+			return "", false
+		}
+
+		return fmt.Sprintf("Mach-O universal binary with %d architectures", count), true
+	default:
+		return "", false
+	}
+
+	return withArch("Mach-O "+bits+" executable", machoCPUs[order.Uint32(header[machoCPUOffset:])]), true
+}
+
+// withArch appends arch to kind, or "unknown architecture" if arch is empty.
+func withArch(kind, arch string) string {
+	if arch == "" {
+		arch = "unknown architecture"
+	}
+
+	return kind + ", " + arch
+}
@@ -0,0 +1,137 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package fs
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// elfHeader crafts the leading bytes of an ELF file.
+func elfHeader(class, data byte, machine uint16) []byte {
+	header := make([]byte, 64)
+	copy(header, elfMagic)
+	header[elfClassOffset] = class
+	header[elfDataOffset] = data
+
+	if data == elfBigEndian {
+		binary.BigEndian.PutUint16(header[elfMachineOffset:], machine)
+	} else {
+		binary.LittleEndian.PutUint16(header[elfMachineOffset:], machine)
+	}
+
+	return header
+}
+
+// peHeader crafts a DOS stub pointing at a PE header with the given machine
+// and optional header magic.
+func peHeader(machine, magic uint16) []byte {
+	const offset = 0x80
+
+	header := make([]byte, 256)
+	copy(header, peMagic)
+	binary.LittleEndian.PutUint32(header[peOffsetField:], offset)
+	copy(header[offset:], peSignature)
+	binary.LittleEndian.PutUint16(header[offset+4:], machine)
+	binary.LittleEndian.PutUint16(header[offset+peMagicOffset:], magic)
+
+	return header
+}
+
+// machoHeader crafts the leading bytes of a Mach-O file.
+func machoHeader(order binary.ByteOrder, magic, cpu uint32) []byte {
+	header := make([]byte, 32)
+	order.PutUint32(header, magic)
+	order.PutUint32(header[machoCPUOffset:], cpu)
+
+	return header
+}
+
+// Test_describeHeader verifies formats and architectures are identified from
+// crafted header bytes.
+func Test_describeHeader(t *testing.T) {
+	fat := make([]byte, 8)
+	binary.BigEndian.PutUint32(fat, machoFatMagic)
+	binary.BigEndian.PutUint32(fat[4:], 2)
+
+	javaClass := make([]byte, 8)
+	binary.BigEndian.PutUint32(javaClass, machoFatMagic)
+	binary.BigEndian.PutUint32(javaClass[4:], 0x34) // Class file version, not an arch count
+
+	tests := []struct {
+		name    string
+		header  []byte
+		want    string
+		wantErr error
+	}{
+		{name: "ELF amd64", header: elfHeader(elfClass64, 1, 0x3e), want: "ELF 64-bit executable, amd64"},
+		{name: "ELF arm", header: elfHeader(1, 1, 0x28), want: "ELF 32-bit executable, arm"},
+		{name: "ELF big-endian s390x", header: elfHeader(elfClass64, elfBigEndian, 0x16), want: "ELF 64-bit executable, s390x"},
+		{name: "ELF unknown machine", header: elfHeader(elfClass64, 1, 0xffff), want: "ELF 64-bit executable, unknown architecture"},
+		{name: "ELF truncated", header: elfMagic, want: "ELF executable"},
+		{name: "PE32+ amd64", header: peHeader(0x8664, pe32PlusMagic), want: "PE32+ executable, amd64"},
+		{name: "PE32 386", header: peHeader(0x14c, 0x10b), want: "PE32 executable, 386"},
+		{name: "DOS stub only", header: []byte("MZ\x90\x00"), want: "PE executable"},
+		{
+			name:   "Mach-O arm64",
+			header: machoHeader(binary.LittleEndian, machoMagic64, 12|machoArch64Bit),
+			want:   "Mach-O 64-bit executable, arm64",
+		},
+		{
+			name:   "Mach-O big-endian ppc",
+			header: machoHeader(binary.BigEndian, machoMagic32, 18),
+			want:   "Mach-O 32-bit executable, ppc",
+		},
+		{name: "universal binary", header: fat, want: "Mach-O universal binary with 2 architectures"},
+		{name: "Java class file", header: javaClass, wantErr: ErrUnknownFormat},
+		{name: "script", header: []byte("#!/bin/sh \necho hi\n"), want: "script (#!/bin/sh)"},
+		{name: "text", header: []byte("hello world"), wantErr: ErrUnknownFormat},
+		{name: "empty", header: nil, wantErr: ErrUnknownFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := describeHeader(tt.header)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("describeHeader() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("describeHeader() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRealFS_DescribeBinary verifies the running test binary is described with
+// its own architecture and that missing files are reported.
+func TestRealFS_DescribeBinary(t *testing.T) {
+	r := &RealFS{}
+
+	self, err := os.Executable()
+	if err != nil {
+		t.Skipf("test executable unavailable: %v", err)
+	}
+
+	got, err := r.DescribeBinary(self)
+	if err != nil {
+		t.Fatalf("DescribeBinary() error = %v", err)
+	}
+
+	if !strings.HasSuffix(got, ", "+runtime.GOARCH) {
+		t.Errorf("DescribeBinary() = %q, want the %s architecture", got, runtime.GOARCH)
+	}
+
+	missing := filepath.Join(t.TempDir(), "missing")
+	if _, err := r.DescribeBinary(missing); !errors.Is(err, ErrBinaryNotFound) {
+		t.Errorf("DescribeBinary() error = %v, want %v", err, ErrBinaryNotFound)
+	}
+}
@@ -7,12 +7,19 @@ SPDX-License-Identifier: AGPL-3.0-or-later
 package fs
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/nicholas-fedor/go-remove/internal/logger"
 )
@@ -21,6 +28,24 @@ import (
 const (
 	windowsOS  = "windows" // Operating system identifier for Windows
 	windowsExt = ".exe"    // File extension for Windows executables
+	bundleExt  = ".app"    // Directory suffix for macOS app bundles
+	execBits   = 0o111     // Permission bits marking a file executable by anyone
+)
+
+// windowsExecutableExts lists the file extensions that mark Windows executables.
+var windowsExecutableExts = []string{windowsExt}
+
+// Reasons reported by ListSkipped for excluded directory entries.
+const (
+	SkipHidden         = "hidden"          // Name starts with "." and hidden files were not requested
+	SkipDirectory      = "is a directory"  // Directory that is not an included app bundle
+	SkipNotExecutable  = "not executable"  // Regular file without any execute permission bit
+	SkipWrongExtension = "wrong extension" // File without the .exe extension on Windows
+	SkipNoMatch        = "does not match"  // Name not matched by ListOptions.Match
+	SkipTooSmall       = "too small"       // File smaller than ListOptions.MinSize
+	SkipTooLarge       = "too large"       // File larger than ListOptions.MaxSize
+	SkipTooNew         = "too new"         // File modified more recently than ListOptions.OlderThan allows
+	SkipTooOld         = "too old"         // File modified longer ago than ListOptions.NewerThan allows
 )
 
 // ErrGorootNotSet indicates that GOROOT is not set when required.
@@ -29,27 +54,149 @@ var ErrGorootNotSet = errors.New("GOROOT is not set")
 // ErrBinaryNotFound indicates that a binary does not exist at the specified path.
 var ErrBinaryNotFound = errors.New("binary not found")
 
+// ErrNotRegularFile indicates that a path exists but is not a regular file.
+var ErrNotRegularFile = errors.New("not a regular file")
+
+// ErrProtectedDir indicates that a standard Go directory was targeted for pruning.
+var ErrProtectedDir = errors.New("refusing to prune a standard Go directory")
+
+// ErrGoEnvOutput indicates `go env` did not report one value per requested setting.
+var ErrGoEnvOutput = errors.New("unexpected go env output")
+
+// ErrNotWritable indicates that a binary's directory does not allow removing it.
+var ErrNotWritable = errors.New("directory is not writable")
+
+// ErrNotBundle indicates that a directory was targeted for removal but is not an app bundle.
+var ErrNotBundle = errors.New("directory is not an app bundle")
+
 // FS defines filesystem operations for go-remove.
 type FS interface {
 	DetermineBinDir(useGoroot bool) (string, error)
 	AdjustBinaryPath(dir, binary string) string
 	RemoveBinary(binaryPath, name string, verbose bool, logger logger.Logger) error
-	ListBinaries(dir string) []string
+	ListBinaries(dir string, opts ListOptions) []string
+	ListBinariesWithInfo(dir string, opts ListOptions) ([]BinaryInfo, error)
+	BinarySize(binaryPath string) (int64, error)
+	ResolveFilePath(path string) (string, error)
+	ListSkipped(dir string, opts ListOptions) []SkippedFile
+	Checksum(path string) (string, error)
+	PruneEmptyDir(dir string) (bool, error)
+	IsExecutable(info os.FileInfo, name string) bool
+	DescribeBinary(path string) (string, error)
+	CorruptReason(path string) (string, error)
+	Symlinks(dir string) ([]Symlink, error)
+}
+
+// ListOptions controls which directory entries ListBinaries returns.
+type ListOptions struct {
+	ShowHidden     bool // Include names starting with "." (hidden by default)
+	IncludeBundles bool // Include app bundle directories (names ending in ".app")
+
+	// IncludeNonExecutable includes regular files without an execute permission
+	// bit. It has no effect on Windows, where the extension marks executables.
+	IncludeNonExecutable bool
+
+	// Match limits the listing to names the expression matches; nil lists all.
+	Match *regexp.Regexp
+
+	// MinSize and MaxSize bound file sizes in bytes, inclusively; 0 means no
+	// bound. Symlinks are measured by their target. App bundles are not sized.
+	MinSize int64
+	MaxSize int64
+
+	// OlderThan and NewerThan bound how long ago files were last modified;
+	// 0 means no bound. Together they form a window, e.g. between one week and
+	// one year old. Symlinks are judged by their target. App bundles are not
+	// filtered by age.
+	OlderThan time.Duration
+	NewerThan time.Duration
 }
 
+// BinaryInfo describes a listed binary along with the metadata gathered while
+// scanning its directory.
+type BinaryInfo struct {
+	Name      string    // File name within the directory
+	Path      string    // Full path to the binary
+	Size      int64     // Size in bytes; for symlinks, the size of the target
+	ModTime   time.Time // Last modification time; for symlinks, that of the target
+	IsSymlink bool      // Whether the entry is a symbolic link
+}
+
+// Symlink describes a symbolic link found in a directory.
+type Symlink struct {
+	Path     string // Full path to the link
+	Target   string // Absolute, cleaned path the link points to
+	Dangling bool   // Whether the target no longer exists
+}
+
+// SkippedFile describes a directory entry that ListBinaries excluded.
+type SkippedFile struct {
+	Name   string // Entry name
+	Reason string // Why the entry was excluded, one of the Skip* constants
+}
+
+// goEnvKeys are the toolchain settings that decide the binary directory.
+var goEnvKeys = []string{"GOBIN", "GOPATH", "GOROOT"}
+
+// GoEnvFunc returns the toolchain's values for keys, in order, as reported by `go env`.
+type GoEnvFunc func(keys ...string) ([]string, error)
+
 // RealFS implements the FS interface using real filesystem operations.
-type RealFS struct{}
+type RealFS struct {
+	goEnv          GoEnvFunc // Source of toolchain settings; nil reads the process environment
+	requireGoEnv   bool      // Report goEnv failures instead of falling back to the environment
+	assumeWritable bool      // Skip RemoveBinary's writability pre-check and rely on the removal's own error
+}
 
 // NewRealFS creates a new RealFS instance.
 func NewRealFS() FS {
 	return &RealFS{}
 }
 
+// NewRealFSFromGoEnv creates a RealFS that determines the binary directory from
+// `go env` rather than the process environment, so settings made with
+// `go env -w` or a version manager are honored.
+func NewRealFSFromGoEnv() FS {
+	return &RealFS{goEnv: runGoEnv}
+}
+
+// AssumeWritable returns filesystem with RemoveBinary's directory writability
+// pre-check turned off, saving a system call per removal in large batches. A
+// permission error from the removal itself is still reported as ErrNotWritable.
+// Filesystems other than RealFS are returned unchanged.
+func AssumeWritable(filesystem FS) FS {
+	if r, ok := filesystem.(*RealFS); ok {
+		r.assumeWritable = true
+	}
+
+	return filesystem
+}
+
 // DetermineBinDir resolves the binary directory based on GOROOT or GOPATH/GOBIN.
+// When the RealFS reads `go env` and the go command fails, the process
+// environment is used instead, unless a specific Go version was requested.
 func (r *RealFS) DetermineBinDir(useGoroot bool) (string, error) {
+	getenv := os.Getenv
+
+	if r.goEnv != nil {
+		lookup, err := goEnvLookup(r.goEnv)
+
+		switch {
+		case err == nil:
+			getenv = lookup
+		case r.requireGoEnv:
+			return "", err
+		}
+	}
+
+	return binDirFrom(getenv, useGoroot)
+}
+
+// binDirFrom resolves the binary directory from the settings returned by getenv.
+func binDirFrom(getenv func(string) string, useGoroot bool) (string, error) {
 	// Use GOROOT/bin if specified and available.
 	if useGoroot {
-		gorootDir := os.Getenv("GOROOT")
+		gorootDir := getenv("GOROOT")
 		if gorootDir == "" {
 			return "", ErrGorootNotSet
 		}
@@ -58,9 +205,9 @@ func (r *RealFS) DetermineBinDir(useGoroot bool) (string, error) {
 	}
 
 	// Fall back to GOBIN or GOPATH/bin, defaulting to ~/go/bin if neither is set.
-	goBin := os.Getenv("GOBIN")
+	goBin := getenv("GOBIN")
 	if goBin == "" {
-		gopath := os.Getenv("GOPATH")
+		gopath := firstGopath(getenv("GOPATH"))
 		if gopath == "" {
 			home := os.Getenv("HOME")
 			if runtime.GOOS == windowsOS && home == "" {
@@ -76,6 +223,66 @@ func (r *RealFS) DetermineBinDir(useGoroot bool) (string, error) {
 	return goBin, nil
 }
 
+// firstGopath returns the first non-empty entry of a GOPATH list, which is
+// where go install puts binaries when GOBIN is unset.
+func firstGopath(gopath string) string {
+	for _, entry := range filepath.SplitList(gopath) {
+		if entry != "" {
+			return entry
+		}
+	}
+
+	return ""
+}
+
+// goEnvLookup reads the binary directory settings with a single goEnv call and
+// returns a getenv-style lookup over them.
+func goEnvLookup(goEnv GoEnvFunc) (func(string) string, error) {
+	values, err := goEnv(goEnvKeys...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) != len(goEnvKeys) {
+		return nil, fmt.Errorf("%w: got %d values for %d keys", ErrGoEnvOutput, len(values), len(goEnvKeys))
+	}
+
+	settings := make(map[string]string, len(goEnvKeys))
+	for i, key := range goEnvKeys {
+		settings[key] = values[i]
+	}
+
+	return func(key string) string { return settings[key] }, nil
+}
+
+// runGoEnv runs `go env` for keys with the go command on PATH and returns one
+// value per key.
+func runGoEnv(keys ...string) ([]string, error) {
+	goCmd, err := GoCommand()
+	if err != nil {
+		return nil, err
+	}
+
+	return goEnvFor(goCmd)(keys...)
+}
+
+// goEnvFor returns a GoEnvFunc that runs `env` with the go command at goCmd.
+func goEnvFor(goCmd string) GoEnvFunc {
+	return func(keys ...string) ([]string, error) {
+		output, err := exec.Command(goCmd, append([]string{"env"}, keys...)...).Output()
+		if err != nil {
+			return nil, fmt.Errorf("failed to run %s env: %w", goCmd, err)
+		}
+
+		lines := strings.Split(strings.TrimRight(string(output), "\r\n"), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight(line, "\r")
+		}
+
+		return lines, nil
+	}
+}
+
 // AdjustBinaryPath constructs a full binary path, adding .exe on Windows if needed.
 func (r *RealFS) AdjustBinaryPath(dir, binary string) string {
 	// Join the directory and binary name into a single path.
@@ -89,13 +296,33 @@ func (r *RealFS) AdjustBinaryPath(dir, binary string) string {
 	return path
 }
 
+// IsBundle reports whether name looks like a macOS app bundle directory.
+func IsBundle(name string) bool {
+	return strings.HasSuffix(name, bundleExt)
+}
+
 // RemoveBinary deletes a binary file from the filesystem.
+// App bundle directories are removed recursively; any other directory is refused.
 func (r *RealFS) RemoveBinary(binaryPath, name string, verbose bool, log logger.Logger) error {
 	// Verify the binary exists before attempting removal.
-	if _, err := os.Stat(binaryPath); os.IsNotExist(err) {
+	info, err := os.Lstat(binaryPath)
+	if os.IsNotExist(err) {
 		return fmt.Errorf("%w: %s at %s", ErrBinaryNotFound, name, binaryPath)
 	}
 
+	// Report an unwritable directory before touching anything, unless the
+	// caller vouched for it.
+	if !r.assumeWritable {
+		if err := checkWritable(filepath.Dir(binaryPath)); err != nil {
+			return notWritable(binaryPath, name, err)
+		}
+	}
+
+	// Never recurse into a directory unless it is an app bundle.
+	if err == nil && info.IsDir() {
+		return removeBundle(binaryPath, name, verbose, log)
+	}
+
 	// Log debug and info messages if verbose mode is enabled.
 	if verbose {
 		log.Debug().Msgf("Constructed binary path: %s", binaryPath)
@@ -104,6 +331,10 @@ func (r *RealFS) RemoveBinary(binaryPath, name string, verbose bool, log logger.
 
 	// Perform the removal operation and handle any errors.
 	if err := os.Remove(binaryPath); err != nil {
+		if os.IsPermission(err) {
+			return notWritable(binaryPath, name, err)
+		}
+
 		return fmt.Errorf("failed to remove %s: %w", binaryPath, err)
 	}
 
@@ -115,23 +346,443 @@ func (r *RealFS) RemoveBinary(binaryPath, name string, verbose bool, log logger.
 	return nil
 }
 
+// removeBundle recursively deletes an app bundle directory.
+func removeBundle(bundlePath, name string, verbose bool, log logger.Logger) error {
+	if !IsBundle(bundlePath) {
+		return fmt.Errorf("%w: %s", ErrNotBundle, bundlePath)
+	}
+
+	if verbose {
+		log.Info().Msgf("Removing app bundle: %s", bundlePath)
+	}
+
+	if err := os.RemoveAll(bundlePath); err != nil {
+		if os.IsPermission(err) {
+			return notWritable(bundlePath, name, err)
+		}
+
+		return fmt.Errorf("failed to remove %s: %w", bundlePath, err)
+	}
+
+	if verbose {
+		log.Info().Msgf("Successfully removed app bundle: %s", name)
+	}
+
+	return nil
+}
+
+// notWritable explains that name cannot be removed because the directory
+// holding binaryPath denies it, keeping the underlying cause for errors.Is.
+func notWritable(binaryPath, name string, err error) error {
+	return fmt.Errorf("cannot remove %s: %w: %s: %w", name, ErrNotWritable, filepath.Dir(binaryPath), err)
+}
+
 // ListBinaries retrieves a list of executable binaries from a directory.
-func (r *RealFS) ListBinaries(dir string) []string {
-	// Read directory contents, returning an empty list on error.
+// Hidden files (names starting with ".") are skipped unless opts.ShowHidden is set,
+// and app bundle directories are included only when opts.IncludeBundles is set.
+// Outside Windows, files without an execute permission bit are skipped unless
+// opts.IncludeNonExecutable is set.
+func (r *RealFS) ListBinaries(dir string, opts ListOptions) []string {
+	entries, _, _ := r.scanDir(dir, opts)
+
+	choices := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		choices = append(choices, entry.Name)
+	}
+
+	return choices
+}
+
+// ListBinariesWithInfo returns the binaries ListBinaries would list, along with
+// their size and modification time, gathered in a single pass over dir.
+// Only symlinks need a second stat, to describe their target.
+// Entries that vanish or cannot be read during the scan are omitted.
+func (r *RealFS) ListBinariesWithInfo(dir string, opts ListOptions) ([]BinaryInfo, error) {
+	entries, _, err := r.scanDir(dir, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	binaries := make([]BinaryInfo, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.readable {
+			binaries = append(binaries, entry.BinaryInfo)
+		}
+	}
+
+	return binaries, nil
+}
+
+// ListSkipped returns the entries in dir that ListBinaries excludes with the same
+// options, along with the reason each was excluded.
+func (r *RealFS) ListSkipped(dir string, opts ListOptions) []SkippedFile {
+	_, skipped, _ := r.scanDir(dir, opts)
+
+	return skipped
+}
+
+// scannedEntry is a directory entry scanDir kept, described by the stat it
+// was filtered with.
+type scannedEntry struct {
+	BinaryInfo
+
+	readable bool // Whether the entry could be stat'ed; if not, only Name and Path are set
+}
+
+// scanDir splits the entries in dir into binaries and skipped entries.
+// An error is returned if the directory cannot be read.
+func (r *RealFS) scanDir(dir string, opts ListOptions) ([]scannedEntry, []SkippedFile, error) {
 	files, err := os.ReadDir(dir)
 	if err != nil {
-		return []string{}
+		return nil, nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
 	}
 
-	// Filter for executable files, including .exe on Windows.
-	var choices []string
+	var (
+		choices []scannedEntry
+		skipped []SkippedFile
+	)
 
 	for _, file := range files {
-		if !file.IsDir() &&
-			(runtime.GOOS != windowsOS || strings.HasSuffix(file.Name(), windowsExt)) {
-			choices = append(choices, file.Name())
+		entry, reason := r.inspect(dir, file, opts)
+		if reason != "" {
+			skipped = append(skipped, SkippedFile{Name: entry.Name, Reason: reason})
+
+			continue
 		}
+
+		choices = append(choices, entry)
 	}
 
-	return choices
+	return choices, skipped, nil
+}
+
+// inspect stats file in dir once, or twice for a symlink to describe its
+// target, and returns it along with why it should be excluded from the binary
+// list, or an empty reason if it is a binary. Executables are recognized with
+// IsExecutable, so every listing applies the same platform rules.
+func (r *RealFS) inspect(dir string, file os.DirEntry, opts ListOptions) (scannedEntry, string) {
+	name := file.Name()
+	entry := scannedEntry{BinaryInfo: BinaryInfo{Name: name, Path: filepath.Join(dir, name)}}
+
+	switch {
+	case opts.Match != nil && !opts.Match.MatchString(name):
+		return entry, SkipNoMatch
+	case !opts.ShowHidden && strings.HasPrefix(name, "."):
+		return entry, SkipHidden
+	case file.IsDir() && !(opts.IncludeBundles && IsBundle(name)):
+		return entry, SkipDirectory
+	}
+
+	info, err := file.Info()
+	if err != nil {
+		return entry, "" // Entry vanished or cannot be read; let removal report the problem
+	}
+
+	entry.readable = true
+	entry.Size, entry.ModTime = info.Size(), info.ModTime()
+
+	// Bundles are not held to the executable, size, or age rules.
+	if file.IsDir() {
+		return entry, ""
+	}
+
+	if !r.IsExecutable(info, name) {
+		switch {
+		case runtime.GOOS == windowsOS:
+			return entry, SkipWrongExtension
+		case !opts.IncludeNonExecutable:
+			return entry, SkipNotExecutable
+		}
+	}
+
+	// A symlink is described, and judged, by the binary it points to. One
+	// whose target cannot be read is kept, as removal only needs the link.
+	if info.Mode()&os.ModeSymlink != 0 {
+		entry.IsSymlink = true
+
+		info, err = os.Stat(entry.Path)
+		if err != nil {
+			return entry, ""
+		}
+
+		entry.Size, entry.ModTime = info.Size(), info.ModTime()
+	}
+
+	if reason := sizeReason(info, opts); reason != "" {
+		return entry, reason
+	}
+
+	return entry, ageReason(info, opts, time.Now())
+}
+
+// Symlinks lists the symbolic links directly inside dir with the paths they
+// point to, relative targets resolved against dir, and whether each target
+// still exists. Links to links are followed when checking for a target.
+func (r *RealFS) Symlinks(dir string) ([]Symlink, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
+	}
+
+	var links []Symlink
+
+	for _, entry := range entries {
+		if entry.Type()&os.ModeSymlink == 0 {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+
+		target, err := os.Readlink(path)
+		if err != nil {
+			continue // Removed or replaced since the directory was read
+		}
+
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(dir, target)
+		}
+
+		_, statErr := os.Stat(path)
+
+		links = append(links, Symlink{
+			Path:     path,
+			Target:   filepath.Clean(target),
+			Dangling: os.IsNotExist(statErr),
+		})
+	}
+
+	return links, nil
+}
+
+// IsExecutable reports whether the file described by info and named name is
+// an executable under the current platform's rules.
+func (r *RealFS) IsExecutable(info os.FileInfo, name string) bool {
+	return isExecutable(runtime.GOOS, info, name)
+}
+
+// isExecutable applies goos's rules for recognizing an executable.
+//
+// Windows has no execute bit, so the extension marks executables. Elsewhere a
+// regular file needs an execute permission bit; symlinks carry their own
+// permission bits, so they are always accepted.
+func isExecutable(goos string, info os.FileInfo, name string) bool {
+	if goos == windowsOS {
+		return slices.Contains(windowsExecutableExts, filepath.Ext(name))
+	}
+
+	return !info.Mode().IsRegular() || info.Mode().Perm()&execBits != 0
+}
+
+// sizeReason returns why the file described by info falls outside the size
+// bounds in opts, or an empty string if it is within them.
+func sizeReason(info os.FileInfo, opts ListOptions) string {
+	switch {
+	case opts.MinSize > 0 && info.Size() < opts.MinSize:
+		return SkipTooSmall
+	case opts.MaxSize > 0 && info.Size() > opts.MaxSize:
+		return SkipTooLarge
+	}
+
+	return ""
+}
+
+// ageReason returns why the file described by info falls outside the age
+// bounds in opts as of now, or an empty string if it is within them.
+func ageReason(info os.FileInfo, opts ListOptions, now time.Time) string {
+	if (opts.OlderThan <= 0 && opts.NewerThan <= 0) || info.IsDir() {
+		return ""
+	}
+
+	age := now.Sub(info.ModTime())
+
+	switch {
+	case opts.OlderThan > 0 && age < opts.OlderThan:
+		return SkipTooNew
+	case opts.NewerThan > 0 && age > opts.NewerThan:
+		return SkipTooOld
+	}
+
+	return ""
+}
+
+// BinarySize returns the size in bytes of the binary at the given path.
+func (r *RealFS) BinarySize(binaryPath string) (int64, error) {
+	info, err := os.Stat(binaryPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, fmt.Errorf("%w: %s", ErrBinaryNotFound, binaryPath)
+		}
+
+		return 0, fmt.Errorf("failed to stat %s: %w", binaryPath, err)
+	}
+
+	return info.Size(), nil
+}
+
+// Checksum returns the hex-encoded SHA-256 digest of the file at path.
+// The file is streamed through the hash rather than read into memory.
+func (r *RealFS) Checksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("%w: %s", ErrBinaryNotFound, path)
+		}
+
+		return "", fmt.Errorf("failed to open %s: %w", path, err)
+	}
+
+	defer func() { _ = file.Close() }() // Read-only; close errors are irrelevant
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// PruneEmptyDir removes dir if it is empty, reporting whether it was removed.
+//
+// GOBIN, GOPATH, GOROOT, their bin directories, the default ~/go tree, and the
+// home directory are never removed, even when empty; ErrProtectedDir is
+// returned instead. A directory that still has entries is left alone.
+func (r *RealFS) PruneEmptyDir(dir string) (bool, error) {
+	if isStandardDir(dir) {
+		return false, fmt.Errorf("%w: %s", ErrProtectedDir, dir)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	if len(entries) > 0 {
+		return false, nil
+	}
+
+	if err := os.Remove(dir); err != nil {
+		return false, fmt.Errorf("failed to remove %s: %w", dir, err)
+	}
+
+	return true, nil
+}
+
+// standardDirs returns the Go and home directories PruneEmptyDir must keep.
+func standardDirs() []string {
+	home, _ := os.UserHomeDir()
+	defaultGopath := ""
+
+	if home != "" {
+		defaultGopath = filepath.Join(home, "go")
+	}
+
+	dirs := []string{home, os.Getenv("GOBIN"), defaultGopath}
+
+	for _, root := range append(filepath.SplitList(os.Getenv("GOPATH")), os.Getenv("GOROOT"), defaultGopath) {
+		if root != "" {
+			dirs = append(dirs, root, filepath.Join(root, "bin"))
+		}
+	}
+
+	return dirs
+}
+
+// isStandardDir reports whether dir is the filesystem root or one of standardDirs,
+// comparing absolute paths with symlinks resolved where possible.
+func isStandardDir(dir string) bool {
+	target := canonicalDir(dir)
+	if target == filepath.Dir(target) {
+		return true // Filesystem or volume root
+	}
+
+	for _, standard := range standardDirs() {
+		if standard != "" && canonicalDir(standard) == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+// goRoots returns the directories Go installs binaries into or under: GOROOT,
+// each GOPATH entry, GOBIN, and ~/go. A root that is the home directory or the
+// filesystem root is dropped, since everything would fall inside it.
+func goRoots() []string {
+	home, _ := os.UserHomeDir()
+
+	candidates := append(filepath.SplitList(os.Getenv("GOPATH")), os.Getenv("GOROOT"), os.Getenv("GOBIN"))
+	if home != "" {
+		candidates = append(candidates, filepath.Join(home, "go"))
+	}
+
+	var roots []string
+
+	for _, candidate := range candidates {
+		if candidate == "" {
+			continue
+		}
+
+		root := canonicalDir(candidate)
+		if root == filepath.Dir(root) || (home != "" && root == canonicalDir(home)) {
+			continue
+		}
+
+		roots = append(roots, root)
+	}
+
+	return roots
+}
+
+// InGoRoots reports whether path is inside, or is, one of GOROOT, a GOPATH
+// entry, GOBIN, or ~/go. Paths are compared absolute and with symlinks
+// resolved, so "..", relative paths, and links cannot escape a root.
+func InGoRoots(path string) bool {
+	target := canonicalDir(path)
+
+	for _, root := range goRoots() {
+		if target == root || strings.HasPrefix(target, root+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// canonicalDir returns the absolute, symlink-resolved form of dir, falling back
+// to the cleaned path when it cannot be resolved.
+func canonicalDir(dir string) string {
+	if abs, err := filepath.Abs(dir); err == nil {
+		dir = abs
+	}
+
+	if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+		dir = resolved
+	}
+
+	return filepath.Clean(dir)
+}
+
+// ResolveFilePath converts path to an absolute path and verifies it names a regular file.
+// Directories, symlinks, and other special files are rejected.
+func (r *RealFS) ResolveFilePath(path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", path, err)
+	}
+
+	info, err := os.Lstat(absPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("%w: %s", ErrBinaryNotFound, absPath)
+		}
+
+		return "", fmt.Errorf("failed to stat %s: %w", absPath, err)
+	}
+
+	if !info.Mode().IsRegular() {
+		return "", fmt.Errorf("%w: %s", ErrNotRegularFile, absPath)
+	}
+
+	return absPath, nil
 }
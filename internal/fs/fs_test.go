@@ -6,13 +6,18 @@ SPDX-License-Identifier: AGPL-3.0-or-later
 package fs
 
 import (
+	"errors"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"runtime"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 
@@ -102,6 +107,58 @@ func TestRealFS_DetermineBinDir(t *testing.T) {
 			want:    filepath.Join(os.Getenv("HOME"), "go", "bin"),
 			wantErr: false,
 		},
+		{
+			name:    "go env GOBIN overrides the process environment",
+			r:       &RealFS{goEnv: staticGoEnv(filepath.FromSlash("/toolchain/bin"), filepath.FromSlash("/gopath"), "")},
+			args:    args{useGoroot: false},
+			env:     map[string]string{"GOBIN": filepath.FromSlash("/custom/bin")},
+			want:    filepath.FromSlash("/toolchain/bin"),
+			wantErr: false,
+		},
+		{
+			name:    "go env GOPATH when go env GOBIN unset",
+			r:       &RealFS{goEnv: staticGoEnv("", filepath.FromSlash("/sdk/gopath"), "")},
+			args:    args{useGoroot: false},
+			env:     map[string]string{"GOPATH": filepath.FromSlash("/gopath")},
+			want:    filepath.FromSlash("/sdk/gopath/bin"),
+			wantErr: false,
+		},
+		{
+			name:    "go env GOROOT",
+			r:       &RealFS{goEnv: staticGoEnv("", "", filepath.FromSlash("/sdk/go1.26"))},
+			args:    args{useGoroot: true},
+			env:     map[string]string{"GOROOT": ""},
+			want:    filepath.FromSlash("/sdk/go1.26/bin"),
+			wantErr: false,
+		},
+		{
+			name: "go env failure falls back to the process environment",
+			r: &RealFS{goEnv: func(...string) ([]string, error) {
+				return nil, errors.New("go: command not found")
+			}},
+			args:    args{useGoroot: false},
+			env:     map[string]string{"GOBIN": filepath.FromSlash("/custom/bin")},
+			want:    filepath.FromSlash("/custom/bin"),
+			wantErr: false,
+		},
+		{
+			name: "go env failure for a requested version is an error",
+			r: &RealFS{requireGoEnv: true, goEnv: func(...string) ([]string, error) {
+				return nil, errors.New("go1.22.3: command not found")
+			}},
+			args:    args{useGoroot: false},
+			env:     map[string]string{"GOBIN": filepath.FromSlash("/custom/bin")},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "unexpected go env output falls back to the process environment",
+			r:       &RealFS{goEnv: staticGoEnv(filepath.FromSlash("/toolchain/bin"))},
+			args:    args{useGoroot: false},
+			env:     map[string]string{"GOBIN": filepath.FromSlash("/custom/bin")},
+			want:    filepath.FromSlash("/custom/bin"),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -140,6 +197,69 @@ func TestRealFS_DetermineBinDir(t *testing.T) {
 	}
 }
 
+// TestRealFS_DetermineBinDir_GopathList verifies a GOPATH list uses the bin
+// directory of its first entry, with the list separated the platform's way.
+func TestRealFS_DetermineBinDir_GopathList(t *testing.T) {
+	tests := []struct {
+		name   string
+		sep    rune
+		gopath string
+		want   string
+	}{
+		{
+			name:   "unix list",
+			sep:    ':',
+			gopath: "/home/user/go:/opt/gopath",
+			want:   "/home/user/go/bin",
+		},
+		{
+			name:   "unix list with empty first entry",
+			sep:    ':',
+			gopath: ":/opt/gopath",
+			want:   "/opt/gopath/bin",
+		},
+		{
+			name:   "windows list",
+			sep:    ';',
+			gopath: `C:\Users\me\go;D:\gopath`,
+			want:   `C:\Users\me\go\bin`,
+		},
+		{
+			name:   "windows list with empty first entry",
+			sep:    ';',
+			gopath: `;D:\gopath`,
+			want:   `D:\gopath\bin`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.sep != os.PathListSeparator {
+				t.Skipf("list separator is %q on this platform", os.PathListSeparator)
+			}
+
+			t.Setenv("GOBIN", "")
+			t.Setenv("GOPATH", tt.gopath)
+
+			got, err := (&RealFS{}).DetermineBinDir(false)
+			if err != nil {
+				t.Fatalf("DetermineBinDir() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("DetermineBinDir() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// staticGoEnv returns a GoEnvFunc reporting values for the requested keys.
+func staticGoEnv(values ...string) GoEnvFunc {
+	return func(...string) ([]string, error) {
+		return values, nil
+	}
+}
+
 // TestRealFS_AdjustBinaryPath verifies the AdjustBinaryPath method's path construction.
 func TestRealFS_AdjustBinaryPath(t *testing.T) {
 	type args struct {
@@ -171,6 +291,18 @@ func TestRealFS_AdjustBinaryPath(t *testing.T) {
 			args: args{dir: filepath.FromSlash("/bin"), binary: ""},
 			want: filepath.FromSlash("/bin"),
 		},
+		{
+			name: "name with spaces",
+			r:    &RealFS{},
+			args: args{dir: filepath.FromSlash("/my bin"), binary: "my tool"},
+			want: filepath.FromSlash("/my bin/my tool") + func() string {
+				if runtime.GOOS == windowsOS {
+					return windowsExt
+				}
+
+				return ""
+			}(),
+		},
 	}
 	if runtime.GOOS == windowsOS {
 		tests = append(tests, struct {
@@ -389,7 +521,7 @@ func TestRealFS_ListBinaries(t *testing.T) {
 				tt.args.dir = tt.setup()
 			}
 
-			got := tt.r.ListBinaries(tt.args.dir)
+			got := tt.r.ListBinaries(tt.args.dir, ListOptions{})
 
 			if tt.name == "list binaries" {
 				sortedGot := make([]string, len(got))
@@ -415,3 +547,743 @@ func TestRealFS_ListBinaries(t *testing.T) {
 		})
 	}
 }
+
+// TestRealFS_ResolveFilePath verifies path resolution and the regular-file safety check.
+func TestRealFS_ResolveFilePath(t *testing.T) {
+	tmpDir := t.TempDir()
+	binPath := filepath.Join(tmpDir, "tool")
+
+	if err := os.WriteFile(binPath, []byte("test"), 0o755); err != nil {
+		t.Fatalf("failed to create test binary: %v", err)
+	}
+
+	if err := os.Mkdir(filepath.Join(tmpDir, "dir"), 0o755); err != nil {
+		t.Fatalf("failed to create test directory: %v", err)
+	}
+
+	// Resolve relative inputs against the temporary directory.
+	t.Chdir(tmpDir)
+
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr error
+	}{
+		{name: "absolute path", path: binPath, want: binPath},
+		{name: "relative path", path: "tool", want: binPath},
+		{name: "dot-relative path", path: filepath.Join(".", "dir", "..", "tool"), want: binPath},
+		{name: "directory", path: "dir", wantErr: ErrNotRegularFile},
+		{name: "missing file", path: "missing", wantErr: ErrBinaryNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&RealFS{}).ResolveFilePath(tt.path)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ResolveFilePath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("ResolveFilePath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRealFS_ListBinaries_Hidden verifies dot-prefixed files are hidden unless requested.
+func TestRealFS_ListBinaries_Hidden(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	ext := ""
+	if runtime.GOOS == windowsOS {
+		ext = windowsExt
+	}
+
+	for _, name := range []string{"tool" + ext, ".hidden" + ext} {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte("test"), 0o755); err != nil {
+			t.Fatalf("failed to create %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		opts ListOptions
+		want []string
+	}{
+		{name: "hidden by default", opts: ListOptions{}, want: []string{"tool" + ext}},
+		{name: "show hidden", opts: ListOptions{ShowHidden: true}, want: []string{".hidden" + ext, "tool" + ext}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := (&RealFS{}).ListBinaries(tmpDir, tt.opts)
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListBinaries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRealFS_ListBinaries_Bundles verifies app bundle directories are listed only when requested.
+func TestRealFS_ListBinaries_Bundles(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	ext := ""
+	if runtime.GOOS == windowsOS {
+		ext = windowsExt
+	}
+
+	if err := os.WriteFile(filepath.Join(tmpDir, "tool"+ext), []byte("test"), 0o755); err != nil {
+		t.Fatalf("failed to create tool: %v", err)
+	}
+
+	for _, dir := range []string{"Viewer.app", "plugins"} {
+		if err := os.Mkdir(filepath.Join(tmpDir, dir), 0o755); err != nil {
+			t.Fatalf("failed to create %s: %v", dir, err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		opts ListOptions
+		want []string
+	}{
+		{name: "bundles excluded by default", opts: ListOptions{}, want: []string{"tool" + ext}},
+		{
+			name: "include bundles",
+			opts: ListOptions{IncludeBundles: true},
+			want: []string{"Viewer.app", "tool" + ext},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := (&RealFS{}).ListBinaries(tmpDir, tt.opts)
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListBinaries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRealFS_ListBinaries_NonExecutable verifies files without an execute bit
+// are listed only when requested.
+func TestRealFS_ListBinaries_NonExecutable(t *testing.T) {
+	if runtime.GOOS == windowsOS {
+		t.Skip("execute permission bits are not used on Windows")
+	}
+
+	tmpDir := t.TempDir()
+
+	for name, perm := range map[string]os.FileMode{"tool": 0o755, "notes.txt": 0o644} {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte("test"), perm); err != nil {
+			t.Fatalf("failed to create %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		opts ListOptions
+		want []string
+	}{
+		{name: "data files excluded by default", opts: ListOptions{}, want: []string{"tool"}},
+		{
+			name: "include non-executable",
+			opts: ListOptions{IncludeNonExecutable: true},
+			want: []string{"notes.txt", "tool"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := (&RealFS{}).ListBinaries(tmpDir, tt.opts)
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListBinaries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRealFS_ListBinaries_Match verifies anchored and unanchored expressions
+// limit the listing, and that unmatched names are reported as skipped.
+func TestRealFS_ListBinaries_Match(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	for _, name := range []string{"gopls", "goimports", "staticcheck"} {
+		if runtime.GOOS == windowsOS {
+			name += windowsExt
+		}
+
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte("test"), 0o755); err != nil {
+			t.Fatalf("failed to create %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    []string
+	}{
+		{name: "anchored", pattern: "^go", want: []string{"goimports", "gopls"}},
+		{name: "unanchored", pattern: "check", want: []string{"staticcheck"}},
+		{name: "anchored at both ends", pattern: "^gopls(\\.exe)?$", want: []string{"gopls"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := ListOptions{Match: regexp.MustCompile(tt.pattern)}
+
+			got := (&RealFS{}).ListBinaries(tmpDir, opts)
+			for i, name := range got {
+				got[i] = strings.TrimSuffix(name, windowsExt)
+			}
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListBinaries() = %v, want %v", got, tt.want)
+			}
+
+			for _, file := range (&RealFS{}).ListSkipped(tmpDir, opts) {
+				if file.Reason != SkipNoMatch {
+					t.Errorf("ListSkipped() reason for %s = %q, want %q", file.Name, file.Reason, SkipNoMatch)
+				}
+			}
+		})
+	}
+}
+
+// TestRealFS_ListBinaries_Size verifies size bounds are inclusive and combine
+// with a name expression, so only entries passing every filter are listed.
+func TestRealFS_ListBinaries_Size(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	sizes := map[string]int{"tiny": 100, "gopls": 1000, "golangci-lint": 5000}
+	for name, size := range sizes {
+		if runtime.GOOS == windowsOS {
+			name += windowsExt
+		}
+
+		if err := os.WriteFile(filepath.Join(tmpDir, name), make([]byte, size), 0o755); err != nil {
+			t.Fatalf("failed to create %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		opts ListOptions
+		want []string
+	}{
+		{name: "minimum is inclusive", opts: ListOptions{MinSize: 1000}, want: []string{"golangci-lint", "gopls"}},
+		{name: "maximum is inclusive", opts: ListOptions{MaxSize: 1000}, want: []string{"gopls", "tiny"}},
+		{name: "equal bounds", opts: ListOptions{MinSize: 1000, MaxSize: 1000}, want: []string{"gopls"}},
+		{
+			name: "combined with a name match",
+			opts: ListOptions{MinSize: 500, Match: regexp.MustCompile("lint")},
+			want: []string{"golangci-lint"},
+		},
+		{name: "nothing in range", opts: ListOptions{MinSize: 10_000}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, name := range (&RealFS{}).ListBinaries(tmpDir, tt.opts) {
+				got = append(got, strings.TrimSuffix(name, windowsExt))
+			}
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListBinaries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRealFS_ListBinaries_Age verifies --newer-than alone keeps recent
+// binaries, and that together with --older-than it forms a window that can
+// hold binaries or be empty.
+func TestRealFS_ListBinaries_Age(t *testing.T) {
+	tmpDir := t.TempDir()
+	now := time.Now()
+
+	ages := map[string]time.Duration{"fresh": time.Hour, "gopls": 10 * 24 * time.Hour, "stale": 400 * 24 * time.Hour}
+	for name, age := range ages {
+		if runtime.GOOS == windowsOS {
+			name += windowsExt
+		}
+
+		path := filepath.Join(tmpDir, name)
+		if err := os.WriteFile(path, []byte("binary"), 0o755); err != nil {
+			t.Fatalf("failed to create %s: %v", name, err)
+		}
+
+		if err := os.Chtimes(path, now.Add(-age), now.Add(-age)); err != nil {
+			t.Fatalf("failed to age %s: %v", name, err)
+		}
+	}
+
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name string
+		opts ListOptions
+		want []string
+	}{
+		{name: "newer than", opts: ListOptions{NewerThan: 30 * day}, want: []string{"fresh", "gopls"}},
+		{name: "older than", opts: ListOptions{OlderThan: day}, want: []string{"gopls", "stale"}},
+		{name: "window", opts: ListOptions{OlderThan: day, NewerThan: 30 * day}, want: []string{"gopls"}},
+		{name: "empty window", opts: ListOptions{OlderThan: 20 * day, NewerThan: 300 * day}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, name := range (&RealFS{}).ListBinaries(tmpDir, tt.opts) {
+				got = append(got, strings.TrimSuffix(name, windowsExt))
+			}
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListBinaries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRealFS_ListBinariesWithInfo verifies metadata is gathered for regular
+// files and symlinks, and that an unreadable directory is reported.
+func TestRealFS_ListBinariesWithInfo(t *testing.T) {
+	if runtime.GOOS == windowsOS {
+		t.Skip("symlinks require elevated privileges on Windows")
+	}
+
+	tmpDir := t.TempDir()
+	toolPath := filepath.Join(tmpDir, "tool")
+	modTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	if err := os.WriteFile(toolPath, []byte("test"), 0o755); err != nil {
+		t.Fatalf("failed to create tool: %v", err)
+	}
+
+	if err := os.Chtimes(toolPath, modTime, modTime); err != nil {
+		t.Fatalf("failed to set tool times: %v", err)
+	}
+
+	if err := os.Symlink(toolPath, filepath.Join(tmpDir, "link")); err != nil {
+		t.Fatalf("failed to create link: %v", err)
+	}
+
+	got, err := (&RealFS{}).ListBinariesWithInfo(tmpDir, ListOptions{})
+	if err != nil {
+		t.Fatalf("ListBinariesWithInfo() error = %v", err)
+	}
+
+	sort.Slice(got, func(i, j int) bool { return got[i].Name < got[j].Name })
+
+	for i := range got {
+		got[i].ModTime = got[i].ModTime.UTC()
+	}
+
+	want := []BinaryInfo{
+		{Name: "link", Path: filepath.Join(tmpDir, "link"), Size: 4, ModTime: modTime, IsSymlink: true},
+		{Name: "tool", Path: toolPath, Size: 4, ModTime: modTime},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListBinariesWithInfo() = %v, want %v", got, want)
+	}
+
+	if _, err := (&RealFS{}).ListBinariesWithInfo(filepath.Join(tmpDir, "missing"), ListOptions{}); err == nil {
+		t.Error("ListBinariesWithInfo() error = nil for a missing directory")
+	}
+}
+
+// TestRealFS_RemoveBinary_Directories verifies app bundles are removed recursively
+// and any other directory is left untouched.
+func TestRealFS_RemoveBinary_Directories(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	for _, dir := range []string{
+		filepath.Join("Viewer.app", "Contents", "MacOS"),
+		filepath.Join("plugins", "nested"),
+	} {
+		if err := os.MkdirAll(filepath.Join(tmpDir, dir), 0o755); err != nil {
+			t.Fatalf("failed to create %s: %v", dir, err)
+		}
+	}
+
+	r := &RealFS{}
+	log := nopLogger(t)
+
+	bundlePath := filepath.Join(tmpDir, "Viewer.app")
+	if err := r.RemoveBinary(bundlePath, "Viewer.app", false, log); err != nil {
+		t.Fatalf("RemoveBinary() bundle error = %v", err)
+	}
+
+	if _, err := os.Stat(bundlePath); !os.IsNotExist(err) {
+		t.Errorf("RemoveBinary() left bundle in place, stat error = %v", err)
+	}
+
+	dirPath := filepath.Join(tmpDir, "plugins")
+	if err := r.RemoveBinary(dirPath, "plugins", false, log); !errors.Is(err, ErrNotBundle) {
+		t.Errorf("RemoveBinary() directory error = %v, want %v", err, ErrNotBundle)
+	}
+
+	if _, err := os.Stat(filepath.Join(dirPath, "nested")); err != nil {
+		t.Errorf("RemoveBinary() touched non-bundle directory: %v", err)
+	}
+}
+
+// TestRealFS_RemoveBinary_NotWritable verifies a binary in a read-only
+// directory is refused as not writable, with or without the pre-check.
+func TestRealFS_RemoveBinary_NotWritable(t *testing.T) {
+	if runtime.GOOS == windowsOS || os.Geteuid() == 0 {
+		t.Skip("directory permissions are not enforced for this user")
+	}
+
+	for _, r := range []*RealFS{{}, {assumeWritable: true}} {
+		tmpDir := t.TempDir()
+		tmpFile := filepath.Join(tmpDir, "testbin")
+
+		if err := os.WriteFile(tmpFile, []byte("test"), 0o755); err != nil {
+			t.Fatalf("failed to create test file: %v", err)
+		}
+
+		if err := os.Chmod(tmpDir, 0o555); err != nil {
+			t.Fatalf("failed to make directory read-only: %v", err)
+		}
+
+		t.Cleanup(func() { os.Chmod(tmpDir, 0o755) })
+
+		err := r.RemoveBinary(tmpFile, "testbin", false, nopLogger(t))
+		if !errors.Is(err, ErrNotWritable) {
+			t.Errorf("RemoveBinary() assumeWritable=%v error = %v, want %v", r.assumeWritable, err, ErrNotWritable)
+		}
+
+		if _, err := os.Stat(tmpFile); err != nil {
+			t.Errorf("RemoveBinary() removed the binary: %v", err)
+		}
+	}
+}
+
+// Test_notWritable verifies a permission error is reported as ErrNotWritable
+// while keeping the underlying cause.
+func Test_notWritable(t *testing.T) {
+	cause := &os.PathError{Op: "remove", Path: "/bin/testbin", Err: os.ErrPermission}
+
+	err := notWritable("/bin/testbin", "testbin", cause)
+	if !errors.Is(err, ErrNotWritable) || !errors.Is(err, os.ErrPermission) {
+		t.Errorf("notWritable() = %v, want %v wrapping %v", err, ErrNotWritable, os.ErrPermission)
+	}
+
+	if want := "cannot remove testbin: directory is not writable: /bin: "; !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("notWritable() = %q, want prefix %q", err.Error(), want)
+	}
+}
+
+// TestAssumeWritable verifies the pre-check is only turned off for a RealFS.
+func TestAssumeWritable(t *testing.T) {
+	r := &RealFS{}
+
+	if got := AssumeWritable(r); got != r || !r.assumeWritable {
+		t.Errorf("AssumeWritable() = %+v, want the same RealFS with the pre-check off", got)
+	}
+}
+
+// TestRealFS_Symlinks verifies links are listed with absolute targets and
+// marked dangling once their target is gone, while regular files are ignored.
+func TestRealFS_Symlinks(t *testing.T) {
+	if runtime.GOOS == windowsOS {
+		t.Skip("creating symlinks needs extra privileges on Windows")
+	}
+
+	dir := t.TempDir()
+	target := filepath.Join(dir, "tool")
+
+	if err := os.WriteFile(target, []byte("test"), 0o755); err != nil {
+		t.Fatalf("failed to create target: %v", err)
+	}
+
+	if err := os.Symlink("tool", filepath.Join(dir, "alias")); err != nil {
+		t.Fatalf("failed to create link: %v", err)
+	}
+
+	if err := os.Symlink(filepath.Join(dir, "gone"), filepath.Join(dir, "stale")); err != nil {
+		t.Fatalf("failed to create link: %v", err)
+	}
+
+	got, err := (&RealFS{}).Symlinks(dir)
+	if err != nil {
+		t.Fatalf("Symlinks() error = %v", err)
+	}
+
+	want := []Symlink{
+		{Path: filepath.Join(dir, "alias"), Target: target},
+		{Path: filepath.Join(dir, "stale"), Target: filepath.Join(dir, "gone"), Dangling: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Symlinks() = %+v, want %+v", got, want)
+	}
+}
+
+// TestRealFS_UnusualNames verifies names with spaces and shell-special
+// characters are listed, resolved, and removed as single path elements.
+func TestRealFS_UnusualNames(t *testing.T) {
+	tmpDir := t.TempDir()
+	r := &RealFS{}
+	names := []string{"my tool", "it's$(tool)", "tool;rm -rf"}
+
+	for _, name := range names {
+		if err := os.WriteFile(r.AdjustBinaryPath(tmpDir, name), []byte("test"), 0o755); err != nil {
+			t.Fatalf("failed to create %q: %v", name, err)
+		}
+	}
+
+	got := r.ListBinaries(tmpDir, ListOptions{})
+	if want := len(names); len(got) != want {
+		t.Fatalf("ListBinaries() = %q, want %d entries", got, want)
+	}
+
+	log := nopLogger(t)
+
+	for _, name := range names {
+		path := r.AdjustBinaryPath(tmpDir, name)
+		if filepath.Dir(path) != tmpDir {
+			t.Errorf("AdjustBinaryPath(%q) = %q, want a file directly in %q", name, path, tmpDir)
+		}
+
+		if err := r.RemoveBinary(path, name, false, log); err != nil {
+			t.Errorf("RemoveBinary(%q) error = %v", name, err)
+		}
+
+		if _, err := os.Lstat(path); !os.IsNotExist(err) {
+			t.Errorf("RemoveBinary(%q) left file in place, stat error = %v", name, err)
+		}
+	}
+}
+
+// TestRealFS_ListSkipped verifies excluded entries are reported with the reason they were skipped.
+func TestRealFS_ListSkipped(t *testing.T) {
+	if runtime.GOOS == windowsOS {
+		t.Skip("execute permission bits are not used on Windows")
+	}
+
+	tmpDir := t.TempDir()
+
+	files := map[string]os.FileMode{
+		"tool":      0o755,
+		"notes.txt": 0o644,
+		".envrc":    0o755,
+	}
+
+	for name, perm := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte("test"), perm); err != nil {
+			t.Fatalf("failed to create %s: %v", name, err)
+		}
+	}
+
+	if err := os.Mkdir(filepath.Join(tmpDir, "cache"), 0o755); err != nil {
+		t.Fatalf("failed to create cache: %v", err)
+	}
+
+	r := &RealFS{}
+
+	if got, want := r.ListBinaries(tmpDir, ListOptions{}), []string{"tool"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ListBinaries() = %v, want %v", got, want)
+	}
+
+	got := r.ListSkipped(tmpDir, ListOptions{})
+	sort.Slice(got, func(i, j int) bool { return got[i].Name < got[j].Name })
+
+	want := []SkippedFile{
+		{Name: ".envrc", Reason: SkipHidden},
+		{Name: "cache", Reason: SkipDirectory},
+		{Name: "notes.txt", Reason: SkipNotExecutable},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListSkipped() = %v, want %v", got, want)
+	}
+}
+
+// TestRealFS_Checksum verifies the SHA-256 digest of a file and the missing-file error.
+func TestRealFS_Checksum(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tool")
+	if err := os.WriteFile(path, []byte("hello\n"), 0o755); err != nil {
+		t.Fatalf("failed to create tool: %v", err)
+	}
+
+	r := &RealFS{}
+
+	got, err := r.Checksum(path)
+	if err != nil {
+		t.Fatalf("Checksum() error = %v", err)
+	}
+
+	// sha256sum of "hello\n".
+	want := "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03"
+	if got != want {
+		t.Errorf("Checksum() = %q, want %q", got, want)
+	}
+
+	if _, err := r.Checksum(path + ".missing"); !errors.Is(err, ErrBinaryNotFound) {
+		t.Errorf("Checksum() missing file error = %v, want %v", err, ErrBinaryNotFound)
+	}
+}
+
+// TestRealFS_PruneEmptyDir verifies empty scratch directories are removed while
+// non-empty and standard Go directories are kept.
+func TestRealFS_PruneEmptyDir(t *testing.T) {
+	base := t.TempDir()
+	gobin := filepath.Join(base, "gobin")
+	gopath := filepath.Join(base, "gopath")
+
+	t.Setenv("GOBIN", gobin)
+	t.Setenv("GOPATH", gopath)
+	t.Setenv("GOROOT", filepath.Join(base, "goroot"))
+
+	tests := []struct {
+		name        string
+		dir         string
+		files       []string
+		wantRemoved bool
+		wantErr     error
+	}{
+		{name: "empty scratch directory", dir: filepath.Join(base, "scratch"), wantRemoved: true},
+		{name: "non-empty directory", dir: filepath.Join(base, "tools"), files: []string{"vhs"}},
+		{name: "GOBIN", dir: gobin, wantErr: ErrProtectedDir},
+		{name: "GOPATH bin", dir: filepath.Join(gopath, "bin"), wantErr: ErrProtectedDir},
+		{name: "GOPATH", dir: gopath, wantErr: ErrProtectedDir},
+		{name: "GOROOT bin", dir: filepath.Join(base, "goroot", "bin"), wantErr: ErrProtectedDir},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := os.MkdirAll(tt.dir, 0o755); err != nil {
+				t.Fatalf("failed to create %s: %v", tt.dir, err)
+			}
+
+			for _, name := range tt.files {
+				if err := os.WriteFile(filepath.Join(tt.dir, name), nil, 0o755); err != nil {
+					t.Fatalf("failed to create %s: %v", name, err)
+				}
+			}
+
+			r := &RealFS{}
+
+			removed, err := r.PruneEmptyDir(tt.dir)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PruneEmptyDir() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if removed != tt.wantRemoved {
+				t.Errorf("PruneEmptyDir() = %v, want %v", removed, tt.wantRemoved)
+			}
+
+			if _, err := os.Stat(tt.dir); os.IsNotExist(err) != tt.wantRemoved {
+				t.Errorf("PruneEmptyDir() left exists = %v, want %v", !os.IsNotExist(err), !tt.wantRemoved)
+			}
+		})
+	}
+}
+
+// TestInGoRoots verifies paths are contained only by the known Go roots, and
+// that a root pointing at the filesystem root does not contain everything.
+func TestInGoRoots(t *testing.T) {
+	base := t.TempDir()
+	home := filepath.Join(base, "home")
+	gopath := filepath.Join(base, "gopath")
+
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("GOPATH", gopath)
+	t.Setenv("GOROOT", filepath.Join(base, "goroot"))
+	t.Setenv("GOBIN", string(filepath.Separator))
+
+	tests := []struct {
+		name string
+		path string
+		want bool
+	}{
+		{name: "GOPATH bin", path: filepath.Join(gopath, "bin"), want: true},
+		{name: "GOROOT bin", path: filepath.Join(base, "goroot", "bin"), want: true},
+		{name: "default GOPATH", path: filepath.Join(home, "go", "bin"), want: true},
+		{name: "escape with dot-dot", path: filepath.Join(gopath, "bin", "..", "..", "other")},
+		{name: "sibling with shared prefix", path: gopath + "-old"},
+		{name: "home directory", path: home},
+		{name: "filesystem root", path: string(filepath.Separator)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InGoRoots(tt.path); got != tt.want {
+				t.Errorf("InGoRoots(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeFileInfo is a synthetic os.FileInfo carrying only a name and mode.
+type fakeFileInfo struct {
+	name string
+	mode os.FileMode
+}
+
+func (f fakeFileInfo) Name() string       { return f.name }
+func (f fakeFileInfo) Size() int64        { return 0 }
+func (f fakeFileInfo) Mode() os.FileMode  { return f.mode }
+func (f fakeFileInfo) ModTime() time.Time { return time.Time{} }
+func (f fakeFileInfo) IsDir() bool        { return f.mode.IsDir() }
+func (f fakeFileInfo) Sys() any           { return nil }
+
+// Test_isExecutable verifies each platform's executable rules against
+// synthetic file info, independent of the host platform.
+func Test_isExecutable(t *testing.T) {
+	tests := []struct {
+		name string
+		goos string
+		info fakeFileInfo
+		want bool
+	}{
+		{name: "windows exe", goos: windowsOS, info: fakeFileInfo{name: "tool.exe", mode: 0o644}, want: true},
+		{name: "windows batch file", goos: windowsOS, info: fakeFileInfo{name: "tool.bat", mode: 0o755}},
+		{name: "windows no extension", goos: windowsOS, info: fakeFileInfo{name: "tool", mode: 0o755}},
+		{name: "linux executable", goos: "linux", info: fakeFileInfo{name: "tool", mode: 0o755}, want: true},
+		{name: "linux owner-only execute", goos: "linux", info: fakeFileInfo{name: "tool", mode: 0o700}, want: true},
+		{name: "linux not executable", goos: "linux", info: fakeFileInfo{name: "tool", mode: 0o644}},
+		{name: "linux exe without execute bit", goos: "linux", info: fakeFileInfo{name: "tool.exe", mode: 0o644}},
+		{name: "darwin symlink", goos: "darwin", info: fakeFileInfo{name: "tool", mode: os.ModeSymlink | 0o644}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isExecutable(tt.goos, tt.info, tt.info.name); got != tt.want {
+				t.Errorf("isExecutable(%q, %q) = %v, want %v", tt.goos, tt.info.name, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_runGoEnv verifies one value is returned per requested setting.
+func Test_runGoEnv(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not available")
+	}
+
+	got, err := runGoEnv(goEnvKeys...)
+	if err != nil {
+		t.Fatalf("runGoEnv() error = %v", err)
+	}
+
+	if len(got) != len(goEnvKeys) {
+		t.Errorf("runGoEnv() returned %d values, want %d: %q", len(got), len(goEnvKeys), got)
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package fs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Errors returned while locating a specific Go toolchain.
+var (
+	// ErrInvalidGoVersion indicates a --go-version value that is not a Go release number.
+	ErrInvalidGoVersion = errors.New("invalid Go version")
+
+	// ErrGoVersionNotInstalled indicates no go command was found for the requested version.
+	ErrGoVersionNotInstalled = errors.New("go version not installed")
+
+	// ErrGoNotFound indicates there is no go command on PATH.
+	ErrGoNotFound = errors.New("go command not found on PATH")
+)
+
+// goVersionPattern matches release numbers such as 1.22, 1.22.3, and 1.23rc1.
+var goVersionPattern = regexp.MustCompile(`^[0-9]+\.[0-9]+(\.[0-9]+)?((rc|beta)[0-9]+)?$`)
+
+// LookPathFunc finds the file that runs for a command name, as exec.LookPath does.
+type LookPathFunc func(file string) (string, error)
+
+// goCommand finds the go command on PATH, searching only on its first call.
+var goCommand = findGoCommand(exec.LookPath)
+
+// GoCommand returns the path of the go command on PATH.
+//
+// Every feature that runs the toolchain looks it up here, so PATH is searched
+// once per run and a missing toolchain is reported the same way everywhere:
+// as an error wrapping ErrGoNotFound.
+func GoCommand() (string, error) {
+	return goCommand()
+}
+
+// findGoCommand returns a lookup of the go command that runs lookPath once and
+// remembers the result.
+func findGoCommand(lookPath LookPathFunc) func() (string, error) {
+	return sync.OnceValues(func() (string, error) {
+		path, err := lookPath("go")
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", ErrGoNotFound, err)
+		}
+
+		return path, nil
+	})
+}
+
+// NewRealFSForGoVersion creates a RealFS that determines the binary directory
+// from `go env` of the toolchain for version, such as "1.22.3" or "go1.22.3".
+//
+// Parameters:
+//   - version: Go release whose toolchain should be queried
+//
+// Returns:
+//   - A filesystem reading the binary directory from that toolchain
+//   - An error if the version is malformed or no toolchain for it is installed
+func NewRealFSForGoVersion(version string) (FS, error) {
+	home, _ := os.UserHomeDir() // Without a home directory only PATH is searched
+
+	goCmd, err := FindGoVersion(version, exec.LookPath, home)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RealFS{goEnv: goEnvFor(goCmd), requireGoEnv: true}, nil
+}
+
+// FindGoVersion locates the go command for version.
+//
+// It looks for a golang.org/dl wrapper (go1.22.3) on PATH, then for the
+// toolchain under ~/sdk, gvm (~/.gvm/gos), and asdf (~/.asdf/installs/golang).
+//
+// Parameters:
+//   - version: Go release to find, with or without a "go" prefix
+//   - lookPath: Resolver for commands on PATH
+//   - home: Home directory holding version manager installs; empty skips them
+//
+// Returns:
+//   - The path of the version's go command
+//   - An error wrapping ErrInvalidGoVersion or ErrGoVersionNotInstalled
+func FindGoVersion(version string, lookPath LookPathFunc, home string) (string, error) {
+	number := strings.TrimPrefix(version, "go")
+	if !goVersionPattern.MatchString(number) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidGoVersion, version)
+	}
+
+	if path, err := lookPath("go" + number); err == nil {
+		return path, nil
+	}
+
+	goExe := "go"
+	if runtime.GOOS == windowsOS {
+		goExe += windowsExt
+	}
+
+	if home != "" {
+		for _, candidate := range []string{
+			filepath.Join(home, "sdk", "go"+number, "bin", goExe),
+			filepath.Join(home, ".gvm", "gos", "go"+number, "bin", goExe),
+			filepath.Join(home, ".asdf", "installs", "golang", number, "go", "bin", goExe),
+		} {
+			if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+				return candidate, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf(
+		"%w: go%s (looked for go%s on PATH and in ~/sdk, gvm, and asdf installs)",
+		ErrGoVersionNotInstalled,
+		number,
+		number,
+	)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package fs
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// TestFindGoVersion verifies wrappers on PATH win, version manager installs
+// are found under home, and missing or malformed versions are reported.
+func TestFindGoVersion(t *testing.T) {
+	home := t.TempDir()
+
+	goExe := "go"
+	if runtime.GOOS == windowsOS {
+		goExe += windowsExt
+	}
+
+	installs := map[string]string{
+		"sdk":  filepath.Join(home, "sdk", "go1.21.0", "bin", goExe),
+		"gvm":  filepath.Join(home, ".gvm", "gos", "go1.20.14", "bin", goExe),
+		"asdf": filepath.Join(home, ".asdf", "installs", "golang", "1.19.13", "go", "bin", goExe),
+	}
+
+	for _, path := range installs {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create %s: %v", filepath.Dir(path), err)
+		}
+
+		if err := os.WriteFile(path, []byte("test"), 0o755); err != nil {
+			t.Fatalf("failed to create %s: %v", path, err)
+		}
+	}
+
+	wrapper := filepath.FromSlash("/usr/local/bin/go1.22.3")
+
+	lookPath := func(file string) (string, error) {
+		if file == "go1.22.3" {
+			return wrapper, nil
+		}
+
+		return "", exec.ErrNotFound
+	}
+
+	tests := []struct {
+		name    string
+		version string
+		want    string
+		wantErr error
+	}{
+		{name: "golang.org/dl wrapper on PATH", version: "1.22.3", want: wrapper},
+		{name: "go prefix", version: "go1.22.3", want: wrapper},
+		{name: "sdk download", version: "1.21.0", want: installs["sdk"]},
+		{name: "gvm", version: "go1.20.14", want: installs["gvm"]},
+		{name: "asdf", version: "1.19.13", want: installs["asdf"]},
+		{name: "not installed", version: "1.18.10", wantErr: ErrGoVersionNotInstalled},
+		{name: "malformed", version: "../../bin", wantErr: ErrInvalidGoVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FindGoVersion(tt.version, lookPath, home)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FindGoVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("FindGoVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewRealFSForGoVersion verifies the binary directory comes from the
+// version's own go env output.
+func TestNewRealFSForGoVersion(t *testing.T) {
+	if runtime.GOOS == windowsOS {
+		t.Skip("the fake toolchain is a shell script")
+	}
+
+	pathDir := t.TempDir()
+	script := "#!/bin/sh\necho /toolchain/bin\necho /toolchain/gopath\necho /toolchain/goroot\n"
+
+	if err := os.WriteFile(filepath.Join(pathDir, "go1.22.3"), []byte(script), 0o755); err != nil {
+		t.Fatalf("failed to create fake toolchain: %v", err)
+	}
+
+	t.Setenv("PATH", pathDir)
+	t.Setenv("GOBIN", "/env/bin")
+
+	filesystem, err := NewRealFSForGoVersion("1.22.3")
+	if err != nil {
+		t.Fatalf("NewRealFSForGoVersion() error = %v", err)
+	}
+
+	got, err := filesystem.DetermineBinDir(false)
+	if err != nil || got != "/toolchain/bin" {
+		t.Errorf("DetermineBinDir() = %q, %v, want %q", got, err, "/toolchain/bin")
+	}
+}
+
+// Test_findGoCommand verifies a missing go command wraps ErrGoNotFound and
+// PATH is searched only once.
+func Test_findGoCommand(t *testing.T) {
+	calls := 0
+
+	lookup := findGoCommand(func(string) (string, error) {
+		calls++
+
+		return "", &exec.Error{Name: "go", Err: exec.ErrNotFound}
+	})
+
+	for range 2 {
+		if _, err := lookup(); !errors.Is(err, ErrGoNotFound) || !errors.Is(err, exec.ErrNotFound) {
+			t.Errorf("lookup() error = %v, want %v wrapping %v", err, ErrGoNotFound, exec.ErrNotFound)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("lookPath called %d times, want 1", calls)
+	}
+}
+
+// TestRealFS_DetermineBinDir_GoMissing verifies reading go env without a go
+// command falls back to the process environment instead of failing.
+func TestRealFS_DetermineBinDir_GoMissing(t *testing.T) {
+	original := goCommand
+	goCommand = findGoCommand(func(string) (string, error) { return "", exec.ErrNotFound })
+
+	t.Cleanup(func() { goCommand = original })
+
+	binDir := t.TempDir()
+	t.Setenv("GOBIN", binDir)
+
+	got, err := NewRealFSFromGoEnv().DetermineBinDir(false)
+	if err != nil {
+		t.Fatalf("DetermineBinDir() error = %v", err)
+	}
+
+	if got != binDir {
+		t.Errorf("DetermineBinDir() = %q, want %q", got, binDir)
+	}
+}
@@ -10,6 +10,9 @@ SPDX-License-Identifier: AGPL-3.0-or-later
 package mocks
 
 import (
+	"os"
+
+	"github.com/nicholas-fedor/go-remove/internal/fs"
 	"github.com/nicholas-fedor/go-remove/internal/logger"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -98,6 +101,246 @@ func (_c *MockFS_AdjustBinaryPath_Call) RunAndReturn(run func(dir string, binary
 	return _c
 }
 
+// BinarySize provides a mock function for the type MockFS
+func (_mock *MockFS) BinarySize(binaryPath string) (int64, error) {
+	ret := _mock.Called(binaryPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BinarySize")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return returnFunc(binaryPath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = returnFunc(binaryPath)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(binaryPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFS_BinarySize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BinarySize'
+type MockFS_BinarySize_Call struct {
+	*mock.Call
+}
+
+// BinarySize is a helper method to define mock.On call
+//   - binaryPath string
+func (_e *MockFS_Expecter) BinarySize(binaryPath interface{}) *MockFS_BinarySize_Call {
+	return &MockFS_BinarySize_Call{Call: _e.mock.On("BinarySize", binaryPath)}
+}
+
+func (_c *MockFS_BinarySize_Call) Run(run func(binaryPath string)) *MockFS_BinarySize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFS_BinarySize_Call) Return(n int64, err error) *MockFS_BinarySize_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockFS_BinarySize_Call) RunAndReturn(run func(binaryPath string) (int64, error)) *MockFS_BinarySize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Checksum provides a mock function for the type MockFS
+func (_mock *MockFS) Checksum(path string) (string, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Checksum")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFS_Checksum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Checksum'
+type MockFS_Checksum_Call struct {
+	*mock.Call
+}
+
+// Checksum is a helper method to define mock.On call
+//   - path string
+func (_e *MockFS_Expecter) Checksum(path interface{}) *MockFS_Checksum_Call {
+	return &MockFS_Checksum_Call{Call: _e.mock.On("Checksum", path)}
+}
+
+func (_c *MockFS_Checksum_Call) Run(run func(path string)) *MockFS_Checksum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFS_Checksum_Call) Return(s string, err error) *MockFS_Checksum_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockFS_Checksum_Call) RunAndReturn(run func(path string) (string, error)) *MockFS_Checksum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CorruptReason provides a mock function for the type MockFS
+func (_mock *MockFS) CorruptReason(path string) (string, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CorruptReason")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFS_CorruptReason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CorruptReason'
+type MockFS_CorruptReason_Call struct {
+	*mock.Call
+}
+
+// CorruptReason is a helper method to define mock.On call
+//   - path string
+func (_e *MockFS_Expecter) CorruptReason(path interface{}) *MockFS_CorruptReason_Call {
+	return &MockFS_CorruptReason_Call{Call: _e.mock.On("CorruptReason", path)}
+}
+
+func (_c *MockFS_CorruptReason_Call) Run(run func(path string)) *MockFS_CorruptReason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFS_CorruptReason_Call) Return(r0 string, err error) *MockFS_CorruptReason_Call {
+	_c.Call.Return(r0, err)
+	return _c
+}
+
+func (_c *MockFS_CorruptReason_Call) RunAndReturn(run func(path string) (string, error)) *MockFS_CorruptReason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DescribeBinary provides a mock function for the type MockFS
+func (_mock *MockFS) DescribeBinary(path string) (string, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DescribeBinary")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFS_DescribeBinary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeBinary'
+type MockFS_DescribeBinary_Call struct {
+	*mock.Call
+}
+
+// DescribeBinary is a helper method to define mock.On call
+//   - path string
+func (_e *MockFS_Expecter) DescribeBinary(path interface{}) *MockFS_DescribeBinary_Call {
+	return &MockFS_DescribeBinary_Call{Call: _e.mock.On("DescribeBinary", path)}
+}
+
+func (_c *MockFS_DescribeBinary_Call) Run(run func(path string)) *MockFS_DescribeBinary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFS_DescribeBinary_Call) Return(r0 string, err error) *MockFS_DescribeBinary_Call {
+	_c.Call.Return(r0, err)
+	return _c
+}
+
+func (_c *MockFS_DescribeBinary_Call) RunAndReturn(run func(path string) (string, error)) *MockFS_DescribeBinary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DetermineBinDir provides a mock function for the type MockFS
 func (_mock *MockFS) DetermineBinDir(useGoroot bool) (string, error) {
 	ret := _mock.Called(useGoroot)
@@ -158,17 +401,74 @@ func (_c *MockFS_DetermineBinDir_Call) RunAndReturn(run func(useGoroot bool) (st
 	return _c
 }
 
+// IsExecutable provides a mock function for the type MockFS
+func (_mock *MockFS) IsExecutable(info os.FileInfo, name string) bool {
+	ret := _mock.Called(info, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsExecutable")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(os.FileInfo, string) bool); ok {
+		r0 = returnFunc(info, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockFS_IsExecutable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsExecutable'
+type MockFS_IsExecutable_Call struct {
+	*mock.Call
+}
+
+// IsExecutable is a helper method to define mock.On call
+//   - info os.FileInfo
+//   - name string
+func (_e *MockFS_Expecter) IsExecutable(info interface{}, name interface{}) *MockFS_IsExecutable_Call {
+	return &MockFS_IsExecutable_Call{Call: _e.mock.On("IsExecutable", info, name)}
+}
+
+func (_c *MockFS_IsExecutable_Call) Run(run func(info os.FileInfo, name string)) *MockFS_IsExecutable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 os.FileInfo
+		if args[0] != nil {
+			arg0 = args[0].(os.FileInfo)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFS_IsExecutable_Call) Return(r0 bool) *MockFS_IsExecutable_Call {
+	_c.Call.Return(r0)
+	return _c
+}
+
+func (_c *MockFS_IsExecutable_Call) RunAndReturn(run func(info os.FileInfo, name string) bool) *MockFS_IsExecutable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListBinaries provides a mock function for the type MockFS
-func (_mock *MockFS) ListBinaries(dir string) []string {
-	ret := _mock.Called(dir)
+func (_mock *MockFS) ListBinaries(dir string, opts fs.ListOptions) []string {
+	ret := _mock.Called(dir, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListBinaries")
 	}
 
 	var r0 []string
-	if returnFunc, ok := ret.Get(0).(func(string) []string); ok {
-		r0 = returnFunc(dir)
+	if returnFunc, ok := ret.Get(0).(func(string, fs.ListOptions) []string); ok {
+		r0 = returnFunc(dir, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -184,18 +484,24 @@ type MockFS_ListBinaries_Call struct {
 
 // ListBinaries is a helper method to define mock.On call
 //   - dir string
-func (_e *MockFS_Expecter) ListBinaries(dir interface{}) *MockFS_ListBinaries_Call {
-	return &MockFS_ListBinaries_Call{Call: _e.mock.On("ListBinaries", dir)}
+//   - opts fs.ListOptions
+func (_e *MockFS_Expecter) ListBinaries(dir interface{}, opts interface{}) *MockFS_ListBinaries_Call {
+	return &MockFS_ListBinaries_Call{Call: _e.mock.On("ListBinaries", dir, opts)}
 }
 
-func (_c *MockFS_ListBinaries_Call) Run(run func(dir string)) *MockFS_ListBinaries_Call {
+func (_c *MockFS_ListBinaries_Call) Run(run func(dir string, opts fs.ListOptions)) *MockFS_ListBinaries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
+		var arg1 fs.ListOptions
+		if args[1] != nil {
+			arg1 = args[1].(fs.ListOptions)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -206,7 +512,194 @@ func (_c *MockFS_ListBinaries_Call) Return(strings []string) *MockFS_ListBinarie
 	return _c
 }
 
-func (_c *MockFS_ListBinaries_Call) RunAndReturn(run func(dir string) []string) *MockFS_ListBinaries_Call {
+func (_c *MockFS_ListBinaries_Call) RunAndReturn(run func(dir string, opts fs.ListOptions) []string) *MockFS_ListBinaries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBinariesWithInfo provides a mock function for the type MockFS
+func (_mock *MockFS) ListBinariesWithInfo(dir string, opts fs.ListOptions) ([]fs.BinaryInfo, error) {
+	ret := _mock.Called(dir, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBinariesWithInfo")
+	}
+
+	var r0 []fs.BinaryInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, fs.ListOptions) ([]fs.BinaryInfo, error)); ok {
+		return returnFunc(dir, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, fs.ListOptions) []fs.BinaryInfo); ok {
+		r0 = returnFunc(dir, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]fs.BinaryInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, fs.ListOptions) error); ok {
+		r1 = returnFunc(dir, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFS_ListBinariesWithInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBinariesWithInfo'
+type MockFS_ListBinariesWithInfo_Call struct {
+	*mock.Call
+}
+
+// ListBinariesWithInfo is a helper method to define mock.On call
+//   - dir string
+//   - opts fs.ListOptions
+func (_e *MockFS_Expecter) ListBinariesWithInfo(dir interface{}, opts interface{}) *MockFS_ListBinariesWithInfo_Call {
+	return &MockFS_ListBinariesWithInfo_Call{Call: _e.mock.On("ListBinariesWithInfo", dir, opts)}
+}
+
+func (_c *MockFS_ListBinariesWithInfo_Call) Run(run func(dir string, opts fs.ListOptions)) *MockFS_ListBinariesWithInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 fs.ListOptions
+		if args[1] != nil {
+			arg1 = args[1].(fs.ListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFS_ListBinariesWithInfo_Call) Return(binaryInfos []fs.BinaryInfo, err error) *MockFS_ListBinariesWithInfo_Call {
+	_c.Call.Return(binaryInfos, err)
+	return _c
+}
+
+func (_c *MockFS_ListBinariesWithInfo_Call) RunAndReturn(run func(dir string, opts fs.ListOptions) ([]fs.BinaryInfo, error)) *MockFS_ListBinariesWithInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSkipped provides a mock function for the type MockFS
+func (_mock *MockFS) ListSkipped(dir string, opts fs.ListOptions) []fs.SkippedFile {
+	ret := _mock.Called(dir, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSkipped")
+	}
+
+	var r0 []fs.SkippedFile
+	if returnFunc, ok := ret.Get(0).(func(string, fs.ListOptions) []fs.SkippedFile); ok {
+		r0 = returnFunc(dir, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]fs.SkippedFile)
+		}
+	}
+	return r0
+}
+
+// MockFS_ListSkipped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSkipped'
+type MockFS_ListSkipped_Call struct {
+	*mock.Call
+}
+
+// ListSkipped is a helper method to define mock.On call
+//   - dir string
+//   - opts fs.ListOptions
+func (_e *MockFS_Expecter) ListSkipped(dir interface{}, opts interface{}) *MockFS_ListSkipped_Call {
+	return &MockFS_ListSkipped_Call{Call: _e.mock.On("ListSkipped", dir, opts)}
+}
+
+func (_c *MockFS_ListSkipped_Call) Run(run func(dir string, opts fs.ListOptions)) *MockFS_ListSkipped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 fs.ListOptions
+		if args[1] != nil {
+			arg1 = args[1].(fs.ListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFS_ListSkipped_Call) Return(skippedFiles []fs.SkippedFile) *MockFS_ListSkipped_Call {
+	_c.Call.Return(skippedFiles)
+	return _c
+}
+
+func (_c *MockFS_ListSkipped_Call) RunAndReturn(run func(dir string, opts fs.ListOptions) []fs.SkippedFile) *MockFS_ListSkipped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PruneEmptyDir provides a mock function for the type MockFS
+func (_mock *MockFS) PruneEmptyDir(dir string) (bool, error) {
+	ret := _mock.Called(dir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneEmptyDir")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return returnFunc(dir)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = returnFunc(dir)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(dir)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFS_PruneEmptyDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneEmptyDir'
+type MockFS_PruneEmptyDir_Call struct {
+	*mock.Call
+}
+
+// PruneEmptyDir is a helper method to define mock.On call
+//   - dir string
+func (_e *MockFS_Expecter) PruneEmptyDir(dir interface{}) *MockFS_PruneEmptyDir_Call {
+	return &MockFS_PruneEmptyDir_Call{Call: _e.mock.On("PruneEmptyDir", dir)}
+}
+
+func (_c *MockFS_PruneEmptyDir_Call) Run(run func(dir string)) *MockFS_PruneEmptyDir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFS_PruneEmptyDir_Call) Return(b bool, err error) *MockFS_PruneEmptyDir_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockFS_PruneEmptyDir_Call) RunAndReturn(run func(dir string) (bool, error)) *MockFS_PruneEmptyDir_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -279,3 +772,125 @@ func (_c *MockFS_RemoveBinary_Call) RunAndReturn(run func(binaryPath string, nam
 	_c.Call.Return(run)
 	return _c
 }
+
+// ResolveFilePath provides a mock function for the type MockFS
+func (_mock *MockFS) ResolveFilePath(path string) (string, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveFilePath")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFS_ResolveFilePath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveFilePath'
+type MockFS_ResolveFilePath_Call struct {
+	*mock.Call
+}
+
+// ResolveFilePath is a helper method to define mock.On call
+//   - path string
+func (_e *MockFS_Expecter) ResolveFilePath(path interface{}) *MockFS_ResolveFilePath_Call {
+	return &MockFS_ResolveFilePath_Call{Call: _e.mock.On("ResolveFilePath", path)}
+}
+
+func (_c *MockFS_ResolveFilePath_Call) Run(run func(path string)) *MockFS_ResolveFilePath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFS_ResolveFilePath_Call) Return(s string, err error) *MockFS_ResolveFilePath_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockFS_ResolveFilePath_Call) RunAndReturn(run func(path string) (string, error)) *MockFS_ResolveFilePath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Symlinks provides a mock function for the type MockFS
+func (_mock *MockFS) Symlinks(dir string) ([]fs.Symlink, error) {
+	ret := _mock.Called(dir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Symlinks")
+	}
+
+	var r0 []fs.Symlink
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]fs.Symlink, error)); ok {
+		return returnFunc(dir)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []fs.Symlink); ok {
+		r0 = returnFunc(dir)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]fs.Symlink)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(dir)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFS_Symlinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Symlinks'
+type MockFS_Symlinks_Call struct {
+	*mock.Call
+}
+
+// Symlinks is a helper method to define mock.On call
+//   - dir string
+func (_e *MockFS_Expecter) Symlinks(dir interface{}) *MockFS_Symlinks_Call {
+	return &MockFS_Symlinks_Call{Call: _e.mock.On("Symlinks", dir)}
+}
+
+func (_c *MockFS_Symlinks_Call) Run(run func(dir string)) *MockFS_Symlinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFS_Symlinks_Call) Return(symlinks []fs.Symlink, err error) *MockFS_Symlinks_Call {
+	_c.Call.Return(symlinks, err)
+	return _c
+}
+
+func (_c *MockFS_Symlinks_Call) RunAndReturn(run func(dir string) ([]fs.Symlink, error)) *MockFS_Symlinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
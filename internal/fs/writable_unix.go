@@ -0,0 +1,18 @@
+//go:build unix
+
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package fs
+
+import "syscall"
+
+// accessWrite is the access(2) mode asking whether a path may be written.
+const accessWrite = 0x2
+
+// checkWritable reports whether entries can be removed from dir.
+func checkWritable(dir string) error {
+	return syscall.Access(dir, accessWrite)
+}
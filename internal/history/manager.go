@@ -99,6 +99,18 @@ type Manager interface {
 	//   - An error if the operation fails
 	GetHistory(ctx context.Context, limit int) ([]*HistoryEntry, error)
 
+	// PurgeTrash permanently deletes the trashed binaries policy no longer
+	// keeps, leaving their history entries in place.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation
+	//   - policy: The age and size limits to enforce
+	//
+	// Returns:
+	//   - The purged binaries, oldest first
+	//   - An error if the operation fails
+	PurgeTrash(ctx context.Context, policy PurgePolicy) ([]PurgedEntry, error)
+
 	// DeletePermanently removes a binary from trash and deletes the history entry.
 	//
 	// Parameters:
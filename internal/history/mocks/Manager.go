@@ -332,6 +332,74 @@ func (_c *MockManager_GetHistory_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// PurgeTrash provides a mock function for the type MockManager
+func (_mock *MockManager) PurgeTrash(ctx context.Context, policy history.PurgePolicy) ([]history.PurgedEntry, error) {
+	ret := _mock.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTrash")
+	}
+
+	var r0 []history.PurgedEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, history.PurgePolicy) ([]history.PurgedEntry, error)); ok {
+		return returnFunc(ctx, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, history.PurgePolicy) []history.PurgedEntry); ok {
+		r0 = returnFunc(ctx, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]history.PurgedEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, history.PurgePolicy) error); ok {
+		r1 = returnFunc(ctx, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockManager_PurgeTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTrash'
+type MockManager_PurgeTrash_Call struct {
+	*mock.Call
+}
+
+// PurgeTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy history.PurgePolicy
+func (_e *MockManager_Expecter) PurgeTrash(ctx interface{}, policy interface{}) *MockManager_PurgeTrash_Call {
+	return &MockManager_PurgeTrash_Call{Call: _e.mock.On("PurgeTrash", ctx, policy)}
+}
+
+func (_c *MockManager_PurgeTrash_Call) Run(run func(ctx context.Context, policy history.PurgePolicy)) *MockManager_PurgeTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 history.PurgePolicy
+		if args[1] != nil {
+			arg1 = args[1].(history.PurgePolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockManager_PurgeTrash_Call) Return(purgedEntrys []history.PurgedEntry, err error) *MockManager_PurgeTrash_Call {
+	_c.Call.Return(purgedEntrys, err)
+	return _c
+}
+
+func (_c *MockManager_PurgeTrash_Call) RunAndReturn(run func(ctx context.Context, policy history.PurgePolicy) ([]history.PurgedEntry, error)) *MockManager_PurgeTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RecordDeletion provides a mock function for the type MockManager
 func (_mock *MockManager) RecordDeletion(ctx context.Context, binaryPath string) (*history.HistoryEntry, error) {
 	ret := _mock.Called(ctx, binaryPath)
@@ -0,0 +1,175 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/nicholas-fedor/go-remove/internal/storage"
+	"github.com/nicholas-fedor/go-remove/internal/trash"
+)
+
+// Reasons a trashed binary is purged.
+const (
+	PurgeReasonExpired = "expired"       // Trashed longer ago than PurgePolicy.MaxAge
+	PurgeReasonOverCap = "over size cap" // Among the oldest binaries while the trash exceeds PurgePolicy.MaxSize
+)
+
+// PurgePolicy bounds how long and how much of go-remove's deleted binaries
+// the trash keeps.
+type PurgePolicy struct {
+	// MaxAge purges binaries trashed longer ago than this; 0 keeps them
+	// regardless of age.
+	MaxAge time.Duration
+
+	// MaxSize purges the oldest binaries until the rest take up at most this
+	// many bytes; 0 keeps them regardless of size.
+	MaxSize int64
+
+	// DryRun reports what would be purged without deleting anything.
+	DryRun bool
+}
+
+// PurgedEntry describes a binary permanently deleted from the trash.
+type PurgedEntry struct {
+	// Entry is the history entry of the purged binary. The entry is kept,
+	// with its module path and version, but can no longer be restored.
+	Entry *HistoryEntry
+
+	// Size is the size of the purged file in bytes; 0 if it could not be read.
+	Size int64
+
+	// Reason is why the binary was purged, one of the PurgeReason* constants.
+	Reason string
+}
+
+// trashedRecord is a history record whose binary is still in the trash.
+type trashedRecord struct {
+	record storage.HistoryRecord
+	size   int64
+}
+
+// PurgeTrash permanently deletes the trashed binaries policy no longer keeps.
+//
+// Binaries trashed longer ago than policy.MaxAge are purged first; then, while
+// the rest exceed policy.MaxSize, the oldest are purged. The history entries
+// are kept and marked as no longer in the trash.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - policy: The age and size limits to enforce
+//
+// Returns:
+//   - The purged binaries, oldest first
+//   - An error if the history cannot be read or a binary cannot be purged
+func (m *HistoryManager) PurgeTrash(ctx context.Context, policy PurgePolicy) ([]PurgedEntry, error) {
+	m.logger.Debug().
+		Dur("max_age", policy.MaxAge).
+		Int64("max_size", policy.MaxSize).
+		Bool("dry_run", policy.DryRun).
+		Msg("Purging trash")
+
+	records, err := m.storer.ListRecords(ctx, storage.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing records: %w", err)
+	}
+
+	var trashed []trashedRecord
+
+	byID := make(map[string]storage.HistoryRecord)
+
+	for i := range records {
+		if !records[i].TrashAvailable || !m.trasher.IsInTrash(records[i].TrashPath) {
+			continue
+		}
+
+		var size int64
+		if info, err := os.Stat(records[i].TrashPath); err == nil {
+			size = info.Size()
+		}
+
+		trashed = append(trashed, trashedRecord{record: records[i], size: size})
+		byID[GenerateKey(records[i].Timestamp, records[i].BinaryName)] = records[i]
+	}
+
+	purged := selectPurge(trashed, time.Now(), policy)
+	if policy.DryRun {
+		return purged, nil
+	}
+
+	for i, entry := range purged {
+		if err := m.purgeRecord(ctx, byID[entry.Entry.ID]); err != nil {
+			return purged[:i], err
+		}
+	}
+
+	return purged, nil
+}
+
+// purgeRecord deletes record's binary from the trash and marks the record as
+// no longer in the trash.
+func (m *HistoryManager) purgeRecord(ctx context.Context, record storage.HistoryRecord) error {
+	if err := m.trasher.DeletePermanently(ctx, record.TrashPath); err != nil &&
+		!errors.Is(err, trash.ErrFileNotInTrash) {
+		return fmt.Errorf("purging %s from trash: %w", record.BinaryName, err)
+	}
+
+	record.TrashAvailable = false
+
+	if err := m.storer.UpdateRecord(ctx, &record); err != nil {
+		m.logger.Warn().
+			Err(err).
+			Msg("Failed to update record after purge")
+	}
+
+	m.logger.Info().
+		Str(logFieldBinary, record.BinaryName).
+		Str(logFieldTrash, record.TrashPath).
+		Msg("Binary purged from trash")
+
+	return nil
+}
+
+// selectPurge returns the trashed binaries policy no longer keeps at now,
+// oldest first: every binary past policy.MaxAge, then the oldest of the rest
+// until they fit within policy.MaxSize.
+func selectPurge(trashed []trashedRecord, now time.Time, policy PurgePolicy) []PurgedEntry {
+	sorted := make([]trashedRecord, len(trashed))
+	copy(sorted, trashed)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].record.Timestamp < sorted[j].record.Timestamp })
+
+	var total int64
+	for _, t := range sorted {
+		total += t.size
+	}
+
+	var purged []PurgedEntry
+
+	for _, t := range sorted {
+		deleted := time.Unix(t.record.Timestamp, 0)
+
+		var reason string
+
+		switch {
+		case policy.MaxAge > 0 && now.Sub(deleted) > policy.MaxAge:
+			reason = PurgeReasonExpired
+		case policy.MaxSize > 0 && total > policy.MaxSize:
+			reason = PurgeReasonOverCap
+		default:
+			continue
+		}
+
+		total -= t.size
+		purged = append(purged, PurgedEntry{Entry: entryFromRecord(&t.record), Size: t.size, Reason: reason})
+	}
+
+	return purged
+}
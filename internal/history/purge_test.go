@@ -0,0 +1,126 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package history
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/go-remove/internal/storage"
+)
+
+func TestSelectPurge(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	// Listed newest first, as storage returns them.
+	trashed := []trashedRecord{
+		{record: storage.HistoryRecord{BinaryName: "vhs", Timestamp: now.Add(-1 * day).Unix()}, size: 300},
+		{record: storage.HistoryRecord{BinaryName: "gopls", Timestamp: now.Add(-10 * day).Unix()}, size: 200},
+		{record: storage.HistoryRecord{BinaryName: "age", Timestamp: now.Add(-40 * day).Unix()}, size: 100},
+	}
+
+	tests := []struct {
+		name   string
+		policy PurgePolicy
+		want   []string
+	}{
+		{name: "age limit", policy: PurgePolicy{MaxAge: 30 * day}, want: []string{"age: expired"}},
+		{
+			name:   "size cap trims oldest first",
+			policy: PurgePolicy{MaxSize: 300},
+			want:   []string{"age: over size cap", "gopls: over size cap"},
+		},
+		{
+			name:   "age then size",
+			policy: PurgePolicy{MaxAge: 30 * day, MaxSize: 450},
+			want:   []string{"age: expired", "gopls: over size cap"},
+		},
+		{name: "within limits", policy: PurgePolicy{MaxAge: 60 * day, MaxSize: 600}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, entry := range selectPurge(trashed, now, tt.policy) {
+				got = append(got, entry.Entry.BinaryName+": "+entry.Reason)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHistoryManager_PurgeTrash(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	oldPath := filepath.Join(dir, "age")
+	newPath := filepath.Join(dir, "vhs")
+	require.NoError(t, os.WriteFile(oldPath, make([]byte, 100), 0o600))
+	require.NoError(t, os.WriteFile(newPath, make([]byte, 300), 0o600))
+
+	records := []storage.HistoryRecord{
+		{BinaryName: "vhs", Timestamp: time.Now().Unix(), TrashPath: newPath, TrashAvailable: true},
+		{BinaryName: "age", Timestamp: time.Now().Add(-48 * time.Hour).Unix(), TrashPath: oldPath, TrashAvailable: true},
+		{BinaryName: "restored", Timestamp: time.Now().Add(-72 * time.Hour).Unix()},
+	}
+
+	t.Run("purges and keeps history", func(t *testing.T) {
+		t.Parallel()
+
+		manager, mockTrasher, mockStorer, _ := setupManagerTest(t)
+
+		mockStorer.EXPECT().ListRecords(ctx, storage.ListOptions{}).Return(records, nil)
+		mockTrasher.EXPECT().IsInTrash(newPath).Return(true)
+		mockTrasher.EXPECT().IsInTrash(oldPath).Return(true)
+		mockTrasher.EXPECT().DeletePermanently(ctx, oldPath).Return(nil)
+		mockStorer.EXPECT().
+			UpdateRecord(ctx, mock.MatchedBy(func(record *storage.HistoryRecord) bool {
+				return record.BinaryName == "age" && !record.TrashAvailable
+			})).
+			Return(nil)
+
+		purged, err := manager.PurgeTrash(ctx, PurgePolicy{MaxAge: 24 * time.Hour})
+
+		require.NoError(t, err)
+		require.Len(t, purged, 1)
+		assert.Equal(t, "age", purged[0].Entry.BinaryName)
+		assert.Equal(t, int64(100), purged[0].Size)
+		assert.Equal(t, PurgeReasonExpired, purged[0].Reason)
+	})
+
+	t.Run("dry run deletes nothing", func(t *testing.T) {
+		t.Parallel()
+
+		manager, mockTrasher, mockStorer, _ := setupManagerTest(t)
+
+		mockStorer.EXPECT().ListRecords(ctx, storage.ListOptions{}).Return(records, nil)
+		mockTrasher.EXPECT().IsInTrash(newPath).Return(true)
+		mockTrasher.EXPECT().IsInTrash(oldPath).Return(true)
+
+		purged, err := manager.PurgeTrash(ctx, PurgePolicy{MaxSize: 300, DryRun: true})
+
+		require.NoError(t, err)
+		require.Len(t, purged, 1)
+		assert.Equal(t, "age", purged[0].Entry.BinaryName)
+		assert.Equal(t, PurgeReasonOverCap, purged[0].Reason)
+		mockTrasher.AssertNotCalled(t, "DeletePermanently", mock.Anything, mock.Anything)
+	})
+}
@@ -179,19 +179,41 @@ func (w *captureWriter) SetupCaptureBridge(bridge func(level, msg string)) {
 	w.captureFunc = bridge
 }
 
+// quietWriter writes to an underlying writer and discards any write error.
+// Logging must never fail the command being logged, so a closed or
+// unwritable stderr silently drops log output instead.
+type quietWriter struct {
+	out io.Writer
+}
+
+// Write forwards p to the underlying writer and always reports success.
+func (q quietWriter) Write(p []byte) (int, error) {
+	_, _ = q.out.Write(p)
+
+	return len(p), nil
+}
+
 // NewLogger creates a new zerolog-based logger with console output.
 //
 // The logger is configured with:
-//   - ConsoleWriter output to os.Stderr
+//   - ConsoleWriter output to os.Stderr (write errors are ignored)
 //   - RFC3339 timestamp format
 //   - Info level as default
 func NewLogger() (Logger, error) {
-	output := zerolog.ConsoleWriter{
-		Out:        os.Stderr,
+	return newZerologLogger(newConsoleWriter()), nil
+}
+
+// newConsoleWriter returns the human-readable stderr writer used by NewLogger.
+func newConsoleWriter() zerolog.ConsoleWriter {
+	return zerolog.ConsoleWriter{
+		Out:        quietWriter{out: os.Stderr},
 		TimeFormat: time.RFC3339,
 		NoColor:    false,
 	}
+}
 
+// newZerologLogger creates an info-level logger with timestamps that writes to output.
+func newZerologLogger(output io.Writer) *ZerologLogger {
 	// Create the base logger with info level.
 	zerologLogger := zerolog.New(output).
 		With().
@@ -202,7 +224,7 @@ func NewLogger() (Logger, error) {
 	return &ZerologLogger{
 		logger: zerologLogger,
 		output: output,
-	}, nil
+	}
 }
 
 // NewLoggerWithCapture creates a new zerolog-based logger that supports log capture.
@@ -214,7 +236,7 @@ func NewLoggerWithCapture() (Logger, *captureWriter, error) {
 	// Create a captureWriter that wraps stderr.
 	// captureFunc and captureEnabled are left as zero values (nil and false).
 	captureWriter := &captureWriter{
-		output: os.Stderr,
+		output: quietWriter{out: os.Stderr},
 	}
 
 	// Create a ConsoleWriter that writes to the captureWriter.
@@ -8,6 +8,7 @@ package logger
 import (
 	"bytes"
 	"io"
+	"os"
 	"strings"
 	"sync"
 	"testing"
@@ -398,3 +399,49 @@ func TestZerologLogger_ConcurrentAccess(t *testing.T) {
 	assert.Positive(t, strings.Count(outputStr, "warn message"))
 	assert.Positive(t, strings.Count(outputStr, "error message"))
 }
+
+// TestQuietWriter_ClosedOutput verifies logging to a closed stderr neither fails nor panics.
+func TestQuietWriter_ClosedOutput(t *testing.T) {
+	closed, err := os.CreateTemp(t.TempDir(), "stderr")
+	require.NoError(t, err)
+	require.NoError(t, closed.Close())
+
+	n, err := quietWriter{out: closed}.Write([]byte("message"))
+	require.NoError(t, err)
+	assert.Equal(t, len("message"), n)
+
+	log := zerolog.New(zerolog.ConsoleWriter{Out: quietWriter{out: closed}})
+	assert.NotPanics(t, func() { log.Error().Msg("dropped") })
+}
+
+// TestNewLoggerWithSink verifies sink selection and graceful fallback when syslog is unavailable.
+func TestNewLoggerWithSink(t *testing.T) {
+	tests := []struct {
+		name    string
+		sink    string
+		wantErr error
+	}{
+		{name: "default", sink: ""},
+		{name: "stderr", sink: SinkStderr},
+		{name: "syslog", sink: SinkSyslog}, // Falls back to stderr if no system log is reachable
+		{name: "both", sink: SinkBoth},
+		{name: "unknown", sink: "kafka", wantErr: ErrUnknownSink},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, err := NewLoggerWithSink(tt.sink)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, log)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, log)
+			assert.NotPanics(t, func() { log.Debug().Msg("sink test") })
+			assert.NoError(t, log.Sync())
+		})
+	}
+}
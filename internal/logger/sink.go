@@ -0,0 +1,68 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/rs/zerolog"
+)
+
+// Log sinks accepted by NewLoggerWithSink.
+const (
+	SinkStderr = "stderr" // Human-readable output on stderr (the default)
+	SinkSyslog = "syslog" // Structured events sent to the system log only
+	SinkBoth   = "both"   // Stderr output plus the system log
+)
+
+// syslogTag identifies go-remove entries in the system log.
+const syslogTag = "go-remove"
+
+// ErrUnknownSink indicates an unrecognized log sink name.
+var ErrUnknownSink = errors.New("unknown log sink")
+
+// ErrSyslogUnsupported indicates the platform has no system log to write to.
+var ErrSyslogUnsupported = errors.New("syslog is not supported on this platform")
+
+// NewLoggerWithSink creates a logger that writes to the named sink.
+//
+// An empty sink selects SinkStderr. If the system log is unavailable, either
+// because the platform lacks one (Windows) or the connection fails, the logger
+// falls back to stderr and logs a warning rather than failing the command.
+// System log writes are unbuffered, so Sync has nothing extra to flush.
+//
+// Parameters:
+//   - sink: One of SinkStderr, SinkSyslog, or SinkBoth
+//
+// Returns:
+//   - The configured logger
+//   - An error if the sink name is not recognized
+func NewLoggerWithSink(sink string) (Logger, error) {
+	switch sink {
+	case "", SinkStderr:
+		return NewLogger()
+	case SinkSyslog, SinkBoth:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSink, sink)
+	}
+
+	syslogOutput, err := newSyslogWriter()
+	if err != nil {
+		log := newZerologLogger(newConsoleWriter())
+		log.Warn().Err(err).Msg("System log unavailable, logging to stderr only")
+
+		return log, nil
+	}
+
+	var output io.Writer = syslogOutput
+	if sink == SinkBoth {
+		output = zerolog.MultiLevelWriter(newConsoleWriter(), syslogOutput)
+	}
+
+	return newZerologLogger(output), nil
+}
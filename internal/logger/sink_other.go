@@ -0,0 +1,15 @@
+//go:build windows || plan9
+
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package logger
+
+import "github.com/rs/zerolog"
+
+// newSyslogWriter reports that no system log is available on this platform.
+func newSyslogWriter() (zerolog.LevelWriter, error) {
+	return nil, ErrSyslogUnsupported
+}
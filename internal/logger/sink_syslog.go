@@ -0,0 +1,25 @@
+//go:build !windows && !plan9
+
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package logger
+
+import (
+	"fmt"
+	"log/syslog"
+
+	"github.com/rs/zerolog"
+)
+
+// newSyslogWriter connects to the local system log, mapping zerolog levels to syslog priorities.
+func newSyslogWriter() (zerolog.LevelWriter, error) {
+	writer, err := syslog.New(syslog.LOG_INFO|syslog.LOG_USER, syslogTag)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to syslog: %w", err)
+	}
+
+	return zerolog.SyslogLevelWriter(writer), nil
+}
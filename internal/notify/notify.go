@@ -0,0 +1,107 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+// Package notify sends desktop notifications using the platform's own tools:
+// notify-send on Linux and BSD, osascript on macOS, and PowerShell on Windows.
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrUnsupportedPlatform indicates no notification mechanism is known for the OS.
+var ErrUnsupportedPlatform = errors.New("desktop notifications are not supported on this platform")
+
+// Notifier delivers a desktop notification.
+type Notifier interface {
+	Notify(title, message string) error
+}
+
+// CommandNotifier sends notifications by running a platform helper command.
+type CommandNotifier struct {
+	goos string
+	run  func(name string, args ...string) error
+}
+
+// NewNotifier creates a notifier for the current operating system.
+func NewNotifier() Notifier {
+	return &CommandNotifier{goos: runtime.GOOS, run: runCommand}
+}
+
+// Notify shows a notification with the given title and message.
+func (n *CommandNotifier) Notify(title, message string) error {
+	name, args, err := command(n.goos, title, message)
+	if err != nil {
+		return err
+	}
+
+	if err := n.run(name, args...); err != nil {
+		return fmt.Errorf("failed to send notification with %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// command returns the program and arguments that display a notification on goos.
+func command(goos, title, message string) (string, []string, error) {
+	switch goos {
+	case "darwin":
+		script := fmt.Sprintf(
+			"display notification %s with title %s",
+			appleScriptString(message),
+			appleScriptString(title),
+		)
+
+		return "osascript", []string{"-e", script}, nil
+	case "windows":
+		script := fmt.Sprintf(
+			"Add-Type -AssemblyName System.Windows.Forms; "+
+				"$n = New-Object System.Windows.Forms.NotifyIcon; "+
+				"$n.Icon = [System.Drawing.SystemIcons]::Information; "+
+				"$n.Visible = $true; "+
+				"$n.ShowBalloonTip(5000, %s, %s, 'Info'); "+
+				"Start-Sleep -Seconds 5; $n.Dispose()",
+			powerShellString(title),
+			powerShellString(message),
+		)
+
+		return "powershell", []string{"-NoProfile", "-NonInteractive", "-Command", script}, nil
+	case "linux", "freebsd", "openbsd", "netbsd", "dragonfly":
+		return "notify-send", []string{"--app-name=go-remove", title, message}, nil
+	default:
+		return "", nil, fmt.Errorf("%w: %s", ErrUnsupportedPlatform, goos)
+	}
+}
+
+// appleScriptString quotes s as an AppleScript string literal.
+func appleScriptString(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+	return `"` + replacer.Replace(s) + `"`
+}
+
+// powerShellString quotes s as a single-quoted PowerShell string literal.
+func powerShellString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// runCommand runs name with args and waits for it to finish, including the
+// command's output in the error when it fails.
+func runCommand(name string, args ...string) error {
+	output, err := exec.Command(name, args...).CombinedOutput() //nolint:gosec // Fixed helper programs chosen by command
+	if err == nil {
+		return nil
+	}
+
+	if detail := strings.TrimSpace(string(output)); detail != "" {
+		return fmt.Errorf("%w: %s", err, detail)
+	}
+
+	return fmt.Errorf("%w", err)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package notify
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// Test_command verifies each platform gets its helper program and quoted arguments.
+func Test_command(t *testing.T) {
+	tests := []struct {
+		name     string
+		goos     string
+		wantName string
+		wantArgs []string
+		wantErr  error
+	}{
+		{
+			name:     "linux",
+			goos:     "linux",
+			wantName: "notify-send",
+			wantArgs: []string{"--app-name=go-remove", "go-remove", `removed "age" & o'brien`},
+		},
+		{
+			name:     "macOS",
+			goos:     "darwin",
+			wantName: "osascript",
+			wantArgs: []string{"-e", `display notification "removed \"age\" & o'brien" with title "go-remove"`},
+		},
+		{
+			name:    "unsupported",
+			goos:    "plan9",
+			wantErr: ErrUnsupportedPlatform,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotName, gotArgs, err := command(tt.goos, "go-remove", `removed "age" & o'brien`)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("command() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if gotName != tt.wantName || !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("command() = %q %q, want %q %q", gotName, gotArgs, tt.wantName, tt.wantArgs)
+			}
+		})
+	}
+}
+
+// Test_powerShellString verifies single quotes are doubled inside the literal.
+func Test_powerShellString(t *testing.T) {
+	if got, want := powerShellString("o'brien"), "'o''brien'"; got != want {
+		t.Errorf("powerShellString() = %q, want %q", got, want)
+	}
+}
+
+// TestCommandNotifier_Notify verifies the helper is run and its failure reported.
+func TestCommandNotifier_Notify(t *testing.T) {
+	var gotName string
+
+	notifier := &CommandNotifier{
+		goos: "linux",
+		run: func(name string, _ ...string) error {
+			gotName = name
+
+			return errors.New("no notification daemon")
+		},
+	}
+
+	err := notifier.Notify("go-remove", "removed 2 binaries")
+	if err == nil || gotName != "notify-send" {
+		t.Errorf("Notify() error = %v, ran %q; want failure from notify-send", err, gotName)
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+// Package proc finds the executables of running processes, including the
+// parent of the current process: by reading /proc on Linux, with ps on macOS
+// and BSD, and with PowerShell on Windows.
+package proc
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrUnsupportedPlatform indicates no process listing mechanism is known for the OS.
+	ErrUnsupportedPlatform = errors.New("listing running processes is not supported on this platform")
+
+	// ErrParentUnknown indicates the parent process's executable could not be determined.
+	ErrParentUnknown = errors.New("parent process executable is unknown")
+)
+
+// Finder reports the executables that currently have running processes.
+type Finder interface {
+	RunningExecutables() ([]string, error)
+	ParentExecutable() (string, error)
+}
+
+// SystemFinder lists running executables using the operating system's own
+// process table.
+type SystemFinder struct {
+	goos    string
+	procDir string
+	ppid    int
+	output  func(name string, args ...string) ([]byte, error)
+}
+
+// NewFinder creates a finder for the current operating system.
+func NewFinder() Finder {
+	return &SystemFinder{goos: runtime.GOOS, procDir: "/proc", ppid: os.Getppid(), output: commandOutput}
+}
+
+// RunningExecutables returns the absolute paths of the executables of all
+// running processes the current user can inspect. Processes whose executable
+// can't be determined, e.g. those owned by other users, are left out.
+func (f *SystemFinder) RunningExecutables() ([]string, error) {
+	switch f.goos {
+	case "linux":
+		return procExecutables(f.procDir)
+	case "darwin", "freebsd", "openbsd", "netbsd", "dragonfly":
+		output, err := f.output("ps", "-axo", "comm=")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list processes with ps: %w", err)
+		}
+
+		return absoluteLines(output), nil
+	case "windows":
+		output, err := f.output("powershell", "-NoProfile", "-NonInteractive", "-Command",
+			"Get-Process | ForEach-Object { $_.Path }")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list processes with PowerShell: %w", err)
+		}
+
+		return absoluteLines(output), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedPlatform, f.goos)
+	}
+}
+
+// ParentExecutable returns the absolute path of the executable that started
+// the current process, such as a wrapper script's interpreter or a tool that
+// shells out to go-remove.
+func (f *SystemFinder) ParentExecutable() (string, error) {
+	// A parent of 1 or less means the original parent exited or there is none.
+	if f.ppid <= 1 {
+		return "", ErrParentUnknown
+	}
+
+	pid := strconv.Itoa(f.ppid)
+
+	var output []byte
+
+	switch f.goos {
+	case "linux":
+		path, err := os.Readlink(filepath.Join(f.procDir, pid, "exe"))
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", ErrParentUnknown, err)
+		}
+
+		return strings.TrimSuffix(path, " (deleted)"), nil
+	case "darwin", "freebsd", "openbsd", "netbsd", "dragonfly":
+		out, err := f.output("ps", "-o", "comm=", "-p", pid)
+		if err != nil {
+			return "", fmt.Errorf("failed to inspect parent process with ps: %w", err)
+		}
+
+		output = out
+	case "windows":
+		out, err := f.output("powershell", "-NoProfile", "-NonInteractive", "-Command",
+			"(Get-Process -Id "+pid+").Path")
+		if err != nil {
+			return "", fmt.Errorf("failed to inspect parent process with PowerShell: %w", err)
+		}
+
+		output = out
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedPlatform, f.goos)
+	}
+
+	paths := absoluteLines(output)
+	if len(paths) == 0 {
+		return "", ErrParentUnknown
+	}
+
+	return paths[0], nil
+}
+
+// procExecutables resolves the exe link of every process directory in procDir.
+func procExecutables(procDir string) ([]string, error) {
+	entries, err := os.ReadDir(procDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", procDir, err)
+	}
+
+	var paths []string
+
+	for _, entry := range entries {
+		if !isPID(entry.Name()) {
+			continue
+		}
+
+		// Unreadable links belong to other users' processes or to processes
+		// that exited while listing.
+		path, err := os.Readlink(filepath.Join(procDir, entry.Name(), "exe"))
+		if err != nil {
+			continue
+		}
+
+		// A replaced or removed executable is reported with this suffix.
+		paths = append(paths, strings.TrimSuffix(path, " (deleted)"))
+	}
+
+	return paths, nil
+}
+
+// isPID reports whether name is a process directory name in /proc.
+func isPID(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, r := range name {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// absoluteLines returns the absolute paths among the lines of output. Kernel
+// threads and processes listed by name only are skipped.
+func absoluteLines(output []byte) []string {
+	var paths []string
+
+	for line := range strings.Lines(string(output)) {
+		path := strings.TrimSpace(line)
+		if filepath.IsAbs(path) {
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
+
+// commandOutput runs name with args and returns its standard output.
+func commandOutput(name string, args ...string) ([]byte, error) {
+	output, err := exec.Command(name, args...).Output() //nolint:gosec // Fixed helper programs chosen by RunningExecutables
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return output, nil
+}
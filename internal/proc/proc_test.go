@@ -0,0 +1,159 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package proc
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+)
+
+// TestSystemFinder_RunningExecutables_Linux verifies exe links are read from
+// numeric process directories, skipping unreadable ones.
+func TestSystemFinder_RunningExecutables_Linux(t *testing.T) {
+	procDir := t.TempDir()
+
+	for pid, exe := range map[string]string{
+		"1":    "/usr/lib/systemd/systemd",
+		"42":   "/home/user/go/bin/gopls",
+		"77":   "/home/user/go/bin/age (deleted)",
+		"self": "/usr/bin/ignored",
+	} {
+		if err := os.Mkdir(filepath.Join(procDir, pid), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.Symlink(exe, filepath.Join(procDir, pid, "exe")); err != nil {
+			t.Skipf("symlinks unavailable: %v", err)
+		}
+	}
+
+	// A kernel thread has no exe link.
+	if err := os.Mkdir(filepath.Join(procDir, "2"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	finder := &SystemFinder{goos: "linux", procDir: procDir}
+
+	got, err := finder.RunningExecutables()
+	if err != nil {
+		t.Fatalf("RunningExecutables() error = %v", err)
+	}
+
+	slices.Sort(got)
+
+	want := []string{"/home/user/go/bin/age", "/home/user/go/bin/gopls", "/usr/lib/systemd/systemd"}
+	if !slices.Equal(got, want) {
+		t.Errorf("RunningExecutables() = %q, want %q", got, want)
+	}
+}
+
+// TestSystemFinder_RunningExecutables_Command verifies helper command output
+// is reduced to absolute paths and failures are reported.
+func TestSystemFinder_RunningExecutables_Command(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sample output uses Unix paths")
+	}
+
+	output := func(string, ...string) ([]byte, error) {
+		return []byte("/sbin/launchd\n(kernel_task)\n\n/Users/me/go/bin/vhs\r\nzsh\n"), nil
+	}
+
+	finder := &SystemFinder{goos: "darwin", output: output}
+
+	got, err := finder.RunningExecutables()
+	if err != nil {
+		t.Fatalf("RunningExecutables() error = %v", err)
+	}
+
+	if want := []string{"/sbin/launchd", "/Users/me/go/bin/vhs"}; !slices.Equal(got, want) {
+		t.Errorf("RunningExecutables() = %q, want %q", got, want)
+	}
+
+	failing := &SystemFinder{goos: "freebsd", output: func(string, ...string) ([]byte, error) {
+		return nil, errors.New("ps: not found")
+	}}
+	if _, err := failing.RunningExecutables(); err == nil {
+		t.Error("RunningExecutables() error = nil, want the ps failure")
+	}
+
+	unsupported := &SystemFinder{goos: "plan9"}
+	if _, err := unsupported.RunningExecutables(); !errors.Is(err, ErrUnsupportedPlatform) {
+		t.Errorf("RunningExecutables() error = %v, want %v", err, ErrUnsupportedPlatform)
+	}
+}
+
+// TestSystemFinder_ParentExecutable verifies the parent's executable is read
+// from /proc on Linux and from helper output elsewhere, and that missing or
+// unsupported lookups are reported.
+func TestSystemFinder_ParentExecutable(t *testing.T) {
+	procDir := t.TempDir()
+
+	if err := os.Mkdir(filepath.Join(procDir, "42"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Symlink("/home/user/go/bin/wrapper (deleted)", filepath.Join(procDir, "42", "exe")); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+
+	psOutput := func(name string, args ...string) ([]byte, error) {
+		if name != "ps" || !slices.Contains(args, "42") {
+			return nil, errors.New("unexpected command")
+		}
+
+		return []byte("/Users/me/go/bin/wrapper\n"), nil
+	}
+
+	tests := []struct {
+		name    string
+		finder  *SystemFinder
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "linux",
+			finder: &SystemFinder{goos: "linux", procDir: procDir, ppid: 42},
+			want:   "/home/user/go/bin/wrapper",
+		},
+		{
+			name:    "linux parent gone",
+			finder:  &SystemFinder{goos: "linux", procDir: procDir, ppid: 43},
+			wantErr: ErrParentUnknown,
+		},
+		{
+			name:   "darwin",
+			finder: &SystemFinder{goos: "darwin", ppid: 42, output: psOutput},
+			want:   "/Users/me/go/bin/wrapper",
+		},
+		{
+			name:    "orphaned",
+			finder:  &SystemFinder{goos: "linux", procDir: procDir, ppid: 1},
+			wantErr: ErrParentUnknown,
+		},
+		{
+			name:    "unsupported",
+			finder:  &SystemFinder{goos: "plan9", ppid: 42},
+			wantErr: ErrUnsupportedPlatform,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.finder.ParentExecutable()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParentExecutable() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("ParentExecutable() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+// Package userconfig loads go-remove's optional user configuration file.
+//
+// The file is YAML and lives at go-remove/config.yaml under the platform's
+// user configuration directory (for example ~/.config on Linux). A missing
+// file is not an error; every setting simply keeps its default.
+package userconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPath names the environment variable that overrides the config file location.
+const EnvPath = "GO_REMOVE_CONFIG"
+
+// ErrInvalidAllowPattern indicates the allow_pattern setting is not a valid regular expression.
+var ErrInvalidAllowPattern = errors.New("invalid allow_pattern")
+
+// Settings holds the options read from the config file.
+type Settings struct {
+	// SafeMode makes dry runs the default; real removals then require --apply.
+	SafeMode bool `yaml:"safe_mode"`
+
+	// Symbols names the glyph set marking removal results: "unicode" or "ascii".
+	// Empty leaves results unmarked; --symbols overrides it.
+	Symbols string `yaml:"symbols"`
+
+	// AllowPattern is a regular expression every removed binary's name must
+	// match, constraining what scripts may remove. Empty allows every name;
+	// --allow-pattern can narrow it further, and only --force lifts it.
+	AllowPattern string `yaml:"allow_pattern"`
+
+	// TrashMaxAge is how long `trash --clean` keeps deleted binaries in the
+	// trash, e.g. "30d". Empty keeps them regardless of age.
+	TrashMaxAge string `yaml:"trash_max_age"`
+
+	// TrashMaxSize caps the space deleted binaries may take up in the trash,
+	// e.g. "1GB"; `trash --clean` purges the oldest beyond it. Empty sets no cap.
+	TrashMaxSize string `yaml:"trash_max_size"`
+}
+
+// Path returns the location of the config file, honoring GO_REMOVE_CONFIG.
+func Path() (string, error) {
+	if path := os.Getenv(EnvPath); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locating user config directory: %w", err)
+	}
+
+	return filepath.Join(dir, "go-remove", "config.yaml"), nil
+}
+
+// Load reads settings from the file at path.
+//
+// Parameters:
+//   - path: Location of the YAML config file
+//
+// Returns:
+//   - The parsed settings, or zero settings if the file does not exist
+//   - An error if the file cannot be read or parsed
+func Load(path string) (Settings, error) {
+	var settings Settings
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return settings, nil
+	}
+
+	if err != nil {
+		return settings, fmt.Errorf("reading config file: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, &settings); err != nil {
+		return settings, fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+
+	// Reject a broken allowlist up front rather than at the first removal.
+	if _, err := regexp.Compile(settings.AllowPattern); err != nil {
+		return Settings{}, fmt.Errorf("%w in %s: %w", ErrInvalidAllowPattern, path, err)
+	}
+
+	return settings, nil
+}
+
+// LoadDefault reads settings from the file returned by Path.
+func LoadDefault() (Settings, error) {
+	path, err := Path()
+	if err != nil {
+		return Settings{}, err
+	}
+
+	return Load(path)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package userconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestLoad verifies settings are parsed and a missing file yields defaults.
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		content *string
+		want    Settings
+		wantErr bool
+	}{
+		{
+			name: "missing file",
+			want: Settings{},
+		},
+		{
+			name:    "safe mode enabled",
+			content: ptr("safe_mode: true\n"),
+			want:    Settings{SafeMode: true},
+		},
+		{
+			name:    "symbols",
+			content: ptr("symbols: ascii\n"),
+			want:    Settings{Symbols: "ascii"},
+		},
+		{
+			name:    "allow pattern",
+			content: ptr("allow_pattern: ^(gopls|dlv)$\n"),
+			want:    Settings{AllowPattern: "^(gopls|dlv)$"},
+		},
+		{
+			name:    "invalid allow pattern",
+			content: ptr("allow_pattern: \"(gopls\"\n"),
+			wantErr: true,
+		},
+		{
+			name:    "empty file",
+			content: ptr(""),
+			want:    Settings{},
+		},
+		{
+			name:    "invalid yaml",
+			content: ptr("safe_mode: [\n"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			if tt.content != nil {
+				if err := os.WriteFile(path, []byte(*tt.content), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := Load(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("Load() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPath_Env verifies GO_REMOVE_CONFIG overrides the default location.
+func TestPath_Env(t *testing.T) {
+	t.Setenv(EnvPath, "/tmp/custom.yaml")
+
+	got, err := Path()
+	if err != nil {
+		t.Fatalf("Path() error = %v", err)
+	}
+
+	if got != "/tmp/custom.yaml" {
+		t.Errorf("Path() = %q, want %q", got, "/tmp/custom.yaml")
+	}
+}
+
+// ptr returns a pointer to s.
+func ptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,113 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package userconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvStatePath names the environment variable that overrides the TUI state file location.
+const EnvStatePath = "GO_REMOVE_STATE"
+
+// stateDirMode and stateFileMode are the permissions for a newly created state
+// directory and file.
+const (
+	stateDirMode  = 0o755
+	stateFileMode = 0o644
+)
+
+// TUIState holds the TUI view settings remembered between sessions with
+// --remember-state. The zero value is the TUI's default view.
+type TUIState struct {
+	// SortDescending sorts binaries Z to A instead of A to Z.
+	SortDescending bool `yaml:"sort_descending"`
+
+	// ShowLogs shows the log panel, with verbose logging.
+	ShowLogs bool `yaml:"show_logs"`
+
+	// ShowHidden shows hidden (dot-prefixed) files.
+	ShowHidden bool `yaml:"show_hidden"`
+
+	// ShowRemoved shows the panel of binaries removed during the session.
+	ShowRemoved bool `yaml:"show_removed"`
+
+	// ShowKey shows the line explaining grid markers.
+	ShowKey bool `yaml:"show_key"`
+
+	// SingleColumn lists binaries one per line instead of in a grid.
+	SingleColumn bool `yaml:"single_column"`
+}
+
+// StatePath returns the location of the TUI state file, honoring GO_REMOVE_STATE.
+func StatePath() (string, error) {
+	if path := os.Getenv(EnvStatePath); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locating user config directory: %w", err)
+	}
+
+	return filepath.Join(dir, "go-remove", "tui-state.yaml"), nil
+}
+
+// LoadState reads the TUI state from the file at path.
+//
+// Parameters:
+//   - path: Location of the YAML state file
+//
+// Returns:
+//   - The saved state, or the default view if the file does not exist
+//   - An error if the file cannot be read or parsed
+func LoadState(path string) (TUIState, error) {
+	var state TUIState
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+
+	if err != nil {
+		return state, fmt.Errorf("reading state file: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, &state); err != nil {
+		return state, fmt.Errorf("parsing state file %s: %w", path, err)
+	}
+
+	return state, nil
+}
+
+// SaveState writes state to the file at path, creating its directory if needed.
+//
+// Parameters:
+//   - path: Location of the YAML state file
+//   - state: The TUI view settings to remember
+//
+// Returns:
+//   - An error if the directory or file cannot be written
+func SaveState(path string, state TUIState) error {
+	data, err := yaml.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("encoding state: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), stateDirMode); err != nil {
+		return fmt.Errorf("creating state directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, stateFileMode); err != nil {
+		return fmt.Errorf("writing state file %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+/*
+Copyright © 2026 Nicholas Fedor <nick@nickfedor.com>
+SPDX-License-Identifier: AGPL-3.0-or-later
+*/
+
+package userconfig
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// TestLoadState_Missing verifies a missing state file yields the default view.
+func TestLoadState_Missing(t *testing.T) {
+	got, err := LoadState(filepath.Join(t.TempDir(), "tui-state.yaml"))
+	if err != nil {
+		t.Fatalf("LoadState() error = %v", err)
+	}
+
+	if got != (TUIState{}) {
+		t.Errorf("LoadState() = %+v, want the zero state", got)
+	}
+}
+
+// TestSaveState_RoundTrip verifies a saved state loads back unchanged,
+// creating the state directory on first save.
+func TestSaveState_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "go-remove", "tui-state.yaml")
+	want := TUIState{SortDescending: true, ShowLogs: true, SingleColumn: true}
+
+	if err := SaveState(path, want); err != nil {
+		t.Fatalf("SaveState() error = %v", err)
+	}
+
+	got, err := LoadState(path)
+	if err != nil {
+		t.Fatalf("LoadState() error = %v", err)
+	}
+
+	if got != want {
+		t.Errorf("LoadState() = %+v, want %+v", got, want)
+	}
+}
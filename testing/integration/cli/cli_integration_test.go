@@ -147,10 +147,18 @@ func (s *CLIIntegrationTestSuite) TestRunDirectRemovalSuccess() {
 		DetermineBinDir(false).
 		Return(testBinDir, nil)
 
+	s.fsMock.EXPECT().
+		ListBinaries(testBinDir, mock.Anything).
+		Return([]string{testBinaryName})
+
 	s.fsMock.EXPECT().
 		AdjustBinaryPath(testBinDir, testBinaryName).
 		Return(testBinaryPath)
 
+	s.fsMock.EXPECT().
+		BinarySize(testBinaryPath).
+		Return(int64(0), nil)
+
 	s.fsMock.EXPECT().
 		RemoveBinary(testBinaryPath, testBinaryName, false, s.loggerMock).
 		Return(nil)
@@ -200,10 +208,18 @@ func (s *CLIIntegrationTestSuite) TestRunDirectRemovalWithHistory() {
 		DetermineBinDir(false).
 		Return(testBinDir, nil)
 
+	s.fsMock.EXPECT().
+		ListBinaries(testBinDir, mock.Anything).
+		Return([]string{testBinaryName})
+
 	s.fsMock.EXPECT().
 		AdjustBinaryPath(testBinDir, testBinaryName).
 		Return(testBinaryPath)
 
+	s.fsMock.EXPECT().
+		BinarySize(testBinaryPath).
+		Return(int64(0), nil)
+
 	// History should be recorded (which moves binary to trash internally)
 	s.historyMock.EXPECT().
 		RecordDeletion(mock.Anything, testBinaryPath).
@@ -250,14 +266,26 @@ func (s *CLIIntegrationTestSuite) TestRunDirectRemovalVerboseMode() {
 		DetermineBinDir(false).
 		Return(testBinDir, nil)
 
+	s.fsMock.EXPECT().
+		ListBinaries(testBinDir, mock.Anything).
+		Return([]string{testBinaryName})
+
 	s.fsMock.EXPECT().
 		AdjustBinaryPath(testBinDir, testBinaryName).
 		Return(testBinaryPath)
 
+	s.fsMock.EXPECT().
+		BinarySize(testBinaryPath).
+		Return(int64(0), nil)
+
 	s.fsMock.EXPECT().
 		RemoveBinary(testBinaryPath, testBinaryName, true, s.loggerMock).
 		Return(nil)
 
+	s.fsMock.EXPECT().
+		Checksum(testBinaryPath).
+		Return("abc123", nil)
+
 	s.loggerMock.EXPECT().Sync().Return(nil)
 
 	// Execute
@@ -297,10 +325,18 @@ func (s *CLIIntegrationTestSuite) TestRunDirectRemovalWithGoroot() {
 		DetermineBinDir(true).
 		Return(gorootBinDir, nil)
 
+	s.fsMock.EXPECT().
+		ListBinaries(gorootBinDir, mock.Anything).
+		Return([]string{testBinaryName})
+
 	s.fsMock.EXPECT().
 		AdjustBinaryPath(gorootBinDir, testBinaryName).
 		Return(gorootBinaryPath)
 
+	s.fsMock.EXPECT().
+		BinarySize(gorootBinaryPath).
+		Return(int64(0), nil)
+
 	s.fsMock.EXPECT().
 		RemoveBinary(gorootBinaryPath, testBinaryName, false, s.loggerMock).
 		Return(nil)
@@ -376,10 +412,18 @@ func (s *CLIIntegrationTestSuite) TestRunRemoveBinaryError() {
 		DetermineBinDir(false).
 		Return(testBinDir, nil)
 
+	s.fsMock.EXPECT().
+		ListBinaries(testBinDir, mock.Anything).
+		Return([]string{testBinaryName})
+
 	s.fsMock.EXPECT().
 		AdjustBinaryPath(testBinDir, testBinaryName).
 		Return(testBinaryPath)
 
+	s.fsMock.EXPECT().
+		BinarySize(testBinaryPath).
+		Return(int64(0), nil)
+
 	s.fsMock.EXPECT().
 		RemoveBinary(testBinaryPath, testBinaryName, false, s.loggerMock).
 		Return(removeError)
@@ -421,10 +465,18 @@ func (s *CLIIntegrationTestSuite) TestRunHistoryRecordError() {
 		DetermineBinDir(false).
 		Return(testBinDir, nil)
 
+	s.fsMock.EXPECT().
+		ListBinaries(testBinDir, mock.Anything).
+		Return([]string{testBinaryName})
+
 	s.fsMock.EXPECT().
 		AdjustBinaryPath(testBinDir, testBinaryName).
 		Return(testBinaryPath)
 
+	s.fsMock.EXPECT().
+		BinarySize(testBinaryPath).
+		Return(int64(0), nil)
+
 	s.historyMock.EXPECT().
 		RecordDeletion(mock.Anything, testBinaryPath).
 		Return(nil, recordError)
@@ -530,14 +582,25 @@ func (s *CLIIntegrationTestSuite) TestRunConfigPropagation() {
 				DetermineBinDir(tt.expectGoroot).
 				Return(testBinDir, nil)
 
+			fsMock.EXPECT().
+				ListBinaries(testBinDir, mock.Anything).
+				Return([]string{testBinaryName})
+
 			fsMock.EXPECT().
 				AdjustBinaryPath(testBinDir, testBinaryName).
 				Return(testBinaryPath)
 
+			fsMock.EXPECT().
+				BinarySize(testBinaryPath).
+				Return(int64(0), nil)
+
 			fsMock.EXPECT().
 				RemoveBinary(testBinaryPath, testBinaryName, tt.expectVerbose, loggerMock).
 				Return(nil)
 
+			// Verbose removals log the binary's checksum first.
+			fsMock.EXPECT().Checksum(testBinaryPath).Return("abc123", nil).Maybe()
+
 			loggerMock.EXPECT().Sync().Return(nil)
 
 			// Execute
@@ -582,10 +645,18 @@ func (s *CLIIntegrationTestSuite) TestRunDependenciesIntegration() {
 		DetermineBinDir(false).
 		Return(testBinDir, nil)
 
+	s.fsMock.EXPECT().
+		ListBinaries(testBinDir, mock.Anything).
+		Return([]string{testBinaryName})
+
 	s.fsMock.EXPECT().
 		AdjustBinaryPath(testBinDir, testBinaryName).
 		Return(testBinaryPath)
 
+	s.fsMock.EXPECT().
+		BinarySize(testBinaryPath).
+		Return(int64(0), nil)
+
 	// History records the deletion (which moves binary to trash internally)
 	s.historyMock.EXPECT().
 		RecordDeletion(mock.Anything, testBinaryPath).
@@ -630,10 +701,18 @@ func (s *CLIIntegrationTestSuite) TestRunDirectRemovalWithoutHistoryManager() {
 		DetermineBinDir(false).
 		Return(testBinDir, nil)
 
+	s.fsMock.EXPECT().
+		ListBinaries(testBinDir, mock.Anything).
+		Return([]string{testBinaryName})
+
 	s.fsMock.EXPECT().
 		AdjustBinaryPath(testBinDir, testBinaryName).
 		Return(testBinaryPath)
 
+	s.fsMock.EXPECT().
+		BinarySize(testBinaryPath).
+		Return(int64(0), nil)
+
 	s.fsMock.EXPECT().
 		RemoveBinary(testBinaryPath, testBinaryName, false, s.loggerMock).
 		Return(nil)
@@ -671,10 +750,18 @@ func (s *CLIIntegrationTestSuite) TestRunBinaryNotFoundError() {
 		DetermineBinDir(false).
 		Return(testBinDir, nil)
 
+	s.fsMock.EXPECT().
+		ListBinaries(testBinDir, mock.Anything).
+		Return([]string{})
+
 	s.fsMock.EXPECT().
 		AdjustBinaryPath(testBinDir, testBinaryName).
 		Return(testBinaryPath)
 
+	s.fsMock.EXPECT().
+		BinarySize(testBinaryPath).
+		Return(int64(0), nil)
+
 	removeError := errors.New("binary not found at path")
 
 	s.fsMock.EXPECT().
@@ -880,10 +967,18 @@ func (s *CLIIntegrationTestSuite) TestMultipleBinaryRemovals() {
 			DetermineBinDir(false).
 			Return(testBinDir, nil)
 
+		s.fsMock.EXPECT().
+			ListBinaries(testBinDir, mock.Anything).
+			Return(binaries)
+
 		s.fsMock.EXPECT().
 			AdjustBinaryPath(testBinDir, binary).
 			Return(binaryPath)
 
+		s.fsMock.EXPECT().
+			BinarySize(binaryPath).
+			Return(int64(0), nil)
+
 		s.fsMock.EXPECT().
 			RemoveBinary(binaryPath, binary, false, s.loggerMock).
 			Return(nil)
@@ -485,11 +485,11 @@ func (s *FSIntegrationTestSuite) TestRemoveBinaryVariousScenarios() {
 // return an empty slice.
 func (s *FSIntegrationTestSuite) TestListBinariesEmpty() {
 	s.mockFS.EXPECT().
-		ListBinaries(testBinDir).
+		ListBinaries(testBinDir, fs.ListOptions{}).
 		Return([]string{}).
 		Once()
 
-	result := s.mockFS.ListBinaries(testBinDir)
+	result := s.mockFS.ListBinaries(testBinDir, fs.ListOptions{})
 
 	s.Empty(result)
 }
@@ -502,11 +502,11 @@ func (s *FSIntegrationTestSuite) TestListBinariesWithBinaries() {
 	expectedBinaries := []string{"binary1", "binary2", "binary3"}
 
 	s.mockFS.EXPECT().
-		ListBinaries(testBinDir).
+		ListBinaries(testBinDir, fs.ListOptions{}).
 		Return(expectedBinaries).
 		Once()
 
-	result := s.mockFS.ListBinaries(testBinDir)
+	result := s.mockFS.ListBinaries(testBinDir, fs.ListOptions{})
 
 	s.Equal(expectedBinaries, result)
 }
@@ -518,11 +518,11 @@ func (s *FSIntegrationTestSuite) TestListBinariesNonExistentDir() {
 	nonExistentDir := "/nonexistent/directory"
 
 	s.mockFS.EXPECT().
-		ListBinaries(nonExistentDir).
+		ListBinaries(nonExistentDir, fs.ListOptions{}).
 		Return([]string{}).
 		Once()
 
-	result := s.mockFS.ListBinaries(nonExistentDir)
+	result := s.mockFS.ListBinaries(nonExistentDir, fs.ListOptions{})
 
 	s.Empty(result)
 }
@@ -570,11 +570,11 @@ func (s *FSIntegrationTestSuite) TestListBinariesVariousContents() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			s.mockFS.EXPECT().
-				ListBinaries(tt.dir).
+				ListBinaries(tt.dir, fs.ListOptions{}).
 				Return(tt.expected).
 				Once()
 
-			result := s.mockFS.ListBinaries(tt.dir)
+			result := s.mockFS.ListBinaries(tt.dir, fs.ListOptions{})
 
 			s.Equal(tt.expected, result)
 		})
@@ -621,11 +621,11 @@ func (s *FSIntegrationTestSuite) TestFullBinaryRemovalWorkflow() {
 
 	// Step 4: List binaries (should not include removed binary)
 	s.mockFS.EXPECT().
-		ListBinaries(binDir).
+		ListBinaries(binDir, fs.ListOptions{}).
 		Return([]string{"other-binary"}).
 		Once()
 
-	binaries := s.mockFS.ListBinaries(binDir)
+	binaries := s.mockFS.ListBinaries(binDir, fs.ListOptions{})
 	s.NotContains(binaries, testBinaryName)
 }
 
@@ -676,11 +676,11 @@ func (s *FSIntegrationTestSuite) TestMultipleBinaryOperations() {
 	// List initial binaries
 	initialBinaries := []string{"binary1", "binary2", "binary3"}
 	s.mockFS.EXPECT().
-		ListBinaries(testBinDir).
+		ListBinaries(testBinDir, fs.ListOptions{}).
 		Return(initialBinaries).
 		Once()
 
-	binaries := s.mockFS.ListBinaries(testBinDir)
+	binaries := s.mockFS.ListBinaries(testBinDir, fs.ListOptions{})
 	s.Len(binaries, 3)
 
 	// Remove first binary
@@ -695,11 +695,11 @@ func (s *FSIntegrationTestSuite) TestMultipleBinaryOperations() {
 
 	// List again (binary1 removed)
 	s.mockFS.EXPECT().
-		ListBinaries(testBinDir).
+		ListBinaries(testBinDir, fs.ListOptions{}).
 		Return([]string{"binary2", "binary3"}).
 		Once()
 
-	binaries = s.mockFS.ListBinaries(testBinDir)
+	binaries = s.mockFS.ListBinaries(testBinDir, fs.ListOptions{})
 	s.Len(binaries, 2)
 	s.NotContains(binaries, "binary1")
 
@@ -905,11 +905,11 @@ func (s *FSIntegrationTestSuite) TestListBinariesFiltering() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			s.mockFS.EXPECT().
-				ListBinaries(tt.dir).
+				ListBinaries(tt.dir, fs.ListOptions{}).
 				Return(tt.expected).
 				Once()
 
-			result := s.mockFS.ListBinaries(tt.dir)
+			result := s.mockFS.ListBinaries(tt.dir, fs.ListOptions{})
 			s.Equal(tt.expected, result)
 		})
 	}
@@ -929,7 +929,7 @@ func (s *FSIntegrationTestSuite) TestConcurrentFSOperations() {
 		Once()
 
 	s.mockFS.EXPECT().
-		ListBinaries(testBinDir).
+		ListBinaries(testBinDir, fs.ListOptions{}).
 		Return([]string{testBinaryName, testBinaryName2}).
 		Once()
 
@@ -938,7 +938,7 @@ func (s *FSIntegrationTestSuite) TestConcurrentFSOperations() {
 	s.Require().NoError(err)
 	s.Equal(testBinDir, binDir)
 
-	binaries := s.mockFS.ListBinaries(testBinDir)
+	binaries := s.mockFS.ListBinaries(testBinDir, fs.ListOptions{})
 	s.Len(binaries, 2)
 
 	// Verify both paths would be adjusted correctly